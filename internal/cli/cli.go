@@ -0,0 +1,477 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package cli implements the subcommands of the flowkit command line, which inspect and call the
+// functions in-process and print JSON so that they can be scripted from the shell and CI.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/grpcserver"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Exit codes of the commands
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitUsage = 2
+)
+
+// Usage is the help of the command line
+const Usage = `Usage: flowkit [--dump-schemas] <command> [arguments]
+
+Commands:
+  serve                       start the gRPC server (default)
+  list [--category name]      list the function definitions
+  describe <name>             print the definition, documentation and JSON Schemas of a function
+  call <name> [flags]         run a function and print its outputs
+  stream <name> [flags]       run a function and print its stream frames, one JSON object per line
+
+Flags of call and stream:
+  --input name=value          input of the function, encoded like the inputs of RunFunction
+  --input name=@file          input read from a file, for example a JSON value
+  --timeout duration          cancel the call after the duration, for example 30s
+  --progress                  stream only: send the progress of the function, see x-progress
+  --format legacy|events      stream only: format of the stream messages, see x-stream-format
+`
+
+// Run runs a command of the command line
+// The functions must be registered in internalstates.AvailableFunctions before.
+//
+// Parameters:
+//   - ctx: the context of the command, cancelled to interrupt it
+//   - args: the command and its arguments
+//   - stdout: receives the JSON output
+//   - stderr: receives the errors, as JSON for the errors of the functions
+//
+// Returns:
+//   - int: the exit code
+func Run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, Usage)
+		return ExitUsage
+	}
+
+	var err error
+	switch args[0] {
+	case "list":
+		err = list(args[1:], stdout)
+	case "describe":
+		err = describe(args[1:], stdout)
+	case "call":
+		err = call(ctx, args[1:], stdout)
+	case "stream":
+		err = stream(ctx, args[1:], stdout)
+	case "help", "-h", "--help":
+		fmt.Fprint(stdout, Usage)
+		return ExitOK
+	default:
+		err = usageError{fmt.Sprintf("unknown command %q", args[0])}
+	}
+
+	var usage usageError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, flag.ErrHelp):
+		fmt.Fprint(stderr, Usage)
+		return ExitUsage
+	case errors.As(err, &usage):
+		fmt.Fprintf(stderr, "flowkit: %s\n\n%s", usage.message, Usage)
+		return ExitUsage
+	default:
+		writeJSON(stderr, errorOutput(err), true)
+		return ExitError
+	}
+}
+
+// usageError is an invalid command line
+type usageError struct {
+	message string
+}
+
+func (err usageError) Error() string {
+	return err.message
+}
+
+// functionSummary is a function printed by list
+type functionSummary struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Category    string `json:"category"`
+	Description string `json:"description,omitempty"`
+}
+
+// functionDescription is a function printed by describe
+type functionDescription struct {
+	functionSummary
+	Inputs     []parameterDescription            `json:"inputs"`
+	Outputs    []parameterDescription            `json:"outputs"`
+	Deprecated string                            `json:"deprecated,omitempty"`
+	Since      string                            `json:"since,omitempty"`
+	Tags       []string                          `json:"tags,omitempty"`
+	Schemas    externalfunctions.FunctionSchemas `json:"schemas"`
+}
+
+// parameterDescription is an input or output printed by describe
+type parameterDescription struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	GoType      string   `json:"goType"`
+	Options     []string `json:"options,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Required    bool     `json:"required,omitempty"`
+	Default     string   `json:"default,omitempty"`
+	Example     string   `json:"example,omitempty"`
+}
+
+// output is an output of a function printed by call and stream
+type output struct {
+	Name   string `json:"name"`
+	GoType string `json:"goType"`
+	Value  string `json:"value"`
+}
+
+// frame is a stream frame printed by stream
+type frame struct {
+	Counter int32  `json:"counter"`
+	IsLast  bool   `json:"isLast,omitempty"`
+	Value   string `json:"value"`
+}
+
+// list prints the definitions of the functions, sorted by name
+//
+// Parameters:
+//   - args: the arguments of the command
+//   - stdout: receives the functions
+//
+// Returns:
+//   - error: an error if the arguments are invalid
+func list(args []string, stdout io.Writer) error {
+	flags := newFlagSet("list")
+	category := flags.String("category", "", "only list the functions of this category")
+	err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return usageError{"list takes no arguments"}
+	}
+
+	functions := []functionSummary{}
+	for _, name := range slices.Sorted(maps.Keys(internalstates.AvailableFunctions)) {
+		definition := internalstates.AvailableFunctions[name]
+		if *category != "" && definition.Category != *category {
+			continue
+		}
+		functions = append(functions, summary(definition))
+	}
+	return writeJSON(stdout, functions, true)
+}
+
+// describe prints the definition, documentation and JSON Schemas of a function
+//
+// Parameters:
+//   - args: the arguments of the command
+//   - stdout: receives the function
+//
+// Returns:
+//   - error: an error if the function does not exist
+func describe(args []string, stdout io.Writer) error {
+	if len(args) != 1 {
+		return usageError{"describe takes the name of a function"}
+	}
+	definition, ok := internalstates.AvailableFunctions[args[0]]
+	if !ok {
+		return externalfunctions.NewNotFoundError("", "function with name %s not found", args[0]).WithFunction(args[0])
+	}
+	metadata := externalfunctions.FunctionsMetadata[definition.Name]
+
+	description := functionDescription{
+		functionSummary: summary(definition),
+		Inputs:          []parameterDescription{},
+		Outputs:         []parameterDescription{},
+		Deprecated:      metadata.Deprecated,
+		Since:           metadata.Since,
+		Tags:            metadata.Tags,
+		Schemas:         externalfunctions.FunctionSchema(definition.Name),
+	}
+	for i, input := range definition.Input {
+		parameter := parameterDescription{Name: input.Name, Type: input.Type, GoType: input.GoType, Options: input.Options}
+		if i < len(metadata.Inputs) {
+			parameter.Description = metadata.Inputs[i].Description
+			parameter.Required = metadata.Inputs[i].Required
+			parameter.Default = metadata.Inputs[i].Default
+			parameter.Example = metadata.Inputs[i].Example
+		}
+		description.Inputs = append(description.Inputs, parameter)
+	}
+	for i, output := range definition.Output {
+		parameter := parameterDescription{Name: output.Name, Type: output.Type, GoType: output.GoType}
+		if i < len(metadata.Outputs) {
+			parameter.Description = metadata.Outputs[i].Description
+		}
+		description.Outputs = append(description.Outputs, parameter)
+	}
+	return writeJSON(stdout, description, true)
+}
+
+// call runs a function like RunFunction and prints its outputs
+//
+// Parameters:
+//   - ctx: the context of the command
+//   - args: the arguments of the command
+//   - stdout: receives the outputs
+//
+// Returns:
+//   - error: an error if the arguments are invalid or the function fails
+func call(ctx context.Context, args []string, stdout io.Writer) error {
+	ctx, req, cancel, err := parseCall(ctx, "call", args)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer grpcserver.CloseClients()
+
+	result, err := grpcserver.RunLocal(ctx, req)
+	if err != nil {
+		return err
+	}
+	outputs := []output{}
+	for _, functionOutput := range result.Outputs {
+		outputs = append(outputs, output{Name: functionOutput.Name, GoType: functionOutput.GoType, Value: functionOutput.Value})
+	}
+	return writeJSON(stdout, map[string]any{"name": result.Name, "outputs": outputs}, true)
+}
+
+// stream runs a function like StreamFunction and prints every frame as a line of JSON
+//
+// Parameters:
+//   - ctx: the context of the command
+//   - args: the arguments of the command
+//   - stdout: receives the frames
+//
+// Returns:
+//   - error: an error if the arguments are invalid or the function fails
+func stream(ctx context.Context, args []string, stdout io.Writer) error {
+	ctx, req, cancel, err := parseCall(ctx, "stream", args)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer grpcserver.CloseClients()
+
+	return grpcserver.StreamLocal(ctx, req, func(streamOutput *aaliflowkitgrpc.StreamOutput) error {
+		return writeJSON(stdout, frame{Counter: streamOutput.MessageCounter, IsLast: streamOutput.IsLast, Value: streamOutput.Value}, false)
+	})
+}
+
+// parseCall parses the arguments of call and stream
+//
+// Parameters:
+//   - ctx: the context of the command
+//   - command: the name of the command
+//   - args: the arguments of the command, the name of the function first
+//
+// Returns:
+//   - context.Context: the context of the call, with its timeout and metadata
+//   - *aaliflowkitgrpc.FunctionInputs: the request
+//   - context.CancelFunc: releases the context
+//   - error: an error if the arguments are invalid
+func parseCall(ctx context.Context, command string, args []string) (context.Context, *aaliflowkitgrpc.FunctionInputs, context.CancelFunc, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return nil, nil, nil, usageError{command + " takes the name of a function first"}
+	}
+	req := &aaliflowkitgrpc.FunctionInputs{Name: args[0]}
+
+	flags := newFlagSet(command)
+	flags.Func("input", "input of the function, name=value or name=@file", func(value string) error {
+		input, err := parseInput(value)
+		if err != nil {
+			return err
+		}
+		req.Inputs = append(req.Inputs, input)
+		return nil
+	})
+	timeout := flags.Duration("timeout", 0, "cancel the call after the duration")
+	progress := false
+	format := ""
+	if command == "stream" {
+		flags.BoolVar(&progress, "progress", false, "send the progress of the function")
+		flags.StringVar(&format, "format", "", "format of the stream messages, legacy or events")
+	}
+	err := parseFlags(flags, args[1:])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, nil, nil, usageError{fmt.Sprintf("unexpected argument %q", flags.Arg(0))}
+	}
+
+	// the metadata of the request are read from the incoming context, like on the server
+	md := metadata.MD{}
+	if progress {
+		md.Set("x-progress", "true")
+	}
+	if format != "" {
+		md.Set("x-stream-format", format)
+	}
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	cancel := context.CancelFunc(func() {})
+	if *timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+	}
+	return ctx, req, cancel, nil
+}
+
+// parseInput parses an --input flag
+// A value starting with "@" is read from the file it names.
+//
+// Parameters:
+//   - value: the value of the flag, name=value or name=@file
+//
+// Returns:
+//   - *aaliflowkitgrpc.FunctionInput: the input
+//   - error: an error if the flag is invalid or the file cannot be read
+func parseInput(value string) (*aaliflowkitgrpc.FunctionInput, error) {
+	name, inputValue, found := strings.Cut(value, "=")
+	if !found || name == "" {
+		return nil, fmt.Errorf("input %q is not name=value", value)
+	}
+	if file, ok := strings.CutPrefix(inputValue, "@"); ok {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading input %s: %w", name, err)
+		}
+		inputValue = string(content)
+	}
+	return &aaliflowkitgrpc.FunctionInput{Name: name, Value: inputValue}, nil
+}
+
+// newFlagSet creates the flag set of a command, reporting errors instead of exiting
+//
+// Parameters:
+//   - command: the name of the command
+//
+// Returns:
+//   - *flag.FlagSet: the flag set
+func newFlagSet(command string) *flag.FlagSet {
+	flags := flag.NewFlagSet(command, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	return flags
+}
+
+// parseFlags parses the flags of a command
+//
+// Parameters:
+//   - flags: the flag set of the command
+//   - args: the arguments of the command
+//
+// Returns:
+//   - error: a usage error if the flags are invalid
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	err := flags.Parse(args)
+	if err != nil && !errors.Is(err, flag.ErrHelp) {
+		return usageError{err.Error()}
+	}
+	return err
+}
+
+// summary returns the summary of a function definition
+//
+// Parameters:
+//   - definition: the function definition
+//
+// Returns:
+//   - functionSummary: the summary
+func summary(definition *aaliflowkitgrpc.FunctionDefinition) functionSummary {
+	return functionSummary{
+		Name:        definition.Name,
+		DisplayName: definition.DisplayName,
+		Category:    definition.Category,
+		Description: definition.Description,
+	}
+}
+
+// errorOutput returns the JSON output of an error
+// Function errors keep their gRPC code, reason and invalid inputs.
+//
+// Parameters:
+//   - err: the error
+//
+// Returns:
+//   - map[string]any: the output
+func errorOutput(err error) map[string]any {
+	output := map[string]any{
+		"code":    status.Code(err).String(),
+		"message": status.Convert(err).Message(),
+	}
+	var functionError *externalfunctions.FunctionError
+	if errors.As(err, &functionError) {
+		output["message"] = functionError.Message
+		if functionError.Reason != "" {
+			output["reason"] = functionError.Reason
+		}
+		violations := []map[string]string{}
+		for _, violation := range functionError.Violations {
+			violations = append(violations, map[string]string{"input": violation.Input, "description": violation.Description})
+		}
+		if len(violations) > 0 {
+			output["violations"] = violations
+		}
+	}
+	return map[string]any{"error": output}
+}
+
+// writeJSON writes a value as JSON followed by a newline
+//
+// Parameters:
+//   - w: the writer
+//   - value: the value
+//   - indent: whether to indent the JSON
+//
+// Returns:
+//   - error: an error if the value cannot be written
+func writeJSON(w io.Writer, value any, indent bool) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	if indent {
+		encoder.SetIndent("", "  ")
+	}
+	return encoder.Encode(value)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+)
+
+func run(t *testing.T, args ...string) (int, map[string]any, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := Run(context.Background(), args, &stdout, &stderr)
+	var output map[string]any
+	if code == ExitOK && stdout.Len() > 0 && stdout.Bytes()[0] == '{' {
+		err := json.Unmarshal(stdout.Bytes(), &output)
+		if err != nil {
+			t.Fatalf("invalid JSON output %q: %v", stdout.String(), err)
+		}
+	}
+	return code, output, stdout.String() + stderr.String()
+}
+
+func TestRun(t *testing.T) {
+	previousFunctions := internalstates.AvailableFunctions
+	internalstates.AvailableFunctions = maps.Clone(externalfunctions.FunctionDefinitions)
+	defer func() { internalstates.AvailableFunctions = previousFunctions }()
+
+	path := filepath.Join(t.TempDir(), "input.json")
+	err := os.WriteFile(path, []byte(`{"k":"v"}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// inputs are given as values or read from files
+	code, output, text := run(t, "call", "ExtractJSONStringField", "--input", "jsonStr=@"+path, "--input", "keyPath=k")
+	if code != ExitOK {
+		t.Fatalf("call exit code = %d: %s", code, text)
+	}
+	outputs := output["outputs"].([]any)
+	if len(outputs) != 1 || outputs[0].(map[string]any)["value"] != "v" {
+		t.Errorf("call outputs = %v", outputs)
+	}
+
+	// the errors of the functions are printed as JSON
+	code, _, text = run(t, "call", "AppendMessageHistory", "--input", "role=admin")
+	var functionError struct {
+		Error struct {
+			Code       string `json:"code"`
+			Violations []struct {
+				Input string `json:"input"`
+			} `json:"violations"`
+		} `json:"error"`
+	}
+	if code != ExitError || json.Unmarshal([]byte(text), &functionError) != nil || functionError.Error.Code != "InvalidArgument" {
+		t.Errorf("call with an invalid input = %d, %s", code, text)
+	}
+
+	code, output, text = run(t, "describe", "StringConcat")
+	if code != ExitOK || output["category"] != "generic" || len(output["inputs"].([]any)) != 3 {
+		t.Errorf("describe = %d, %s", code, text)
+	}
+
+	var stdout bytes.Buffer
+	code = Run(context.Background(), []string{"list", "--category", "generic"}, &stdout, &bytes.Buffer{})
+	var functions []functionSummary
+	if code != ExitOK || json.Unmarshal(stdout.Bytes(), &functions) != nil || len(functions) == 0 {
+		t.Fatalf("list = %d, %s", code, stdout.String())
+	}
+	for _, function := range functions {
+		if function.Category != "generic" {
+			t.Errorf("list --category generic returned %s of category %s", function.Name, function.Category)
+		}
+	}
+
+	for _, args := range [][]string{{"unknown"}, {"call"}, {"call", "StringConcat", "--input", "missing-equal"}, {"describe"}} {
+		if code, _, _ := run(t, args...); code != ExitUsage {
+			t.Errorf("%v exit code = %d, want %d", args, code, ExitUsage)
+		}
+	}
+}
@@ -0,0 +1,554 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+)
+
+// outFileName is the name of the generated file in pkg/externalfunctions
+const outFileName = "registry.go"
+
+type Registry struct {
+	Imports   []string
+	Functions []Function
+	Enums     []functiondefinitions.EnumDefinition
+}
+
+type Function struct {
+	Key         string
+	Function    string
+	DisplayName string
+	Description string
+	Category    string
+	Deprecated  string
+	Since       string
+	Tags        []string
+	Inputs      []Parameter
+	Outputs     []Parameter
+	Args        []string
+}
+
+type Parameter struct {
+	Name    string
+	Type    string
+	GoType  string
+	Options []string
+	Doc     functiondefinitions.ParameterDoc
+	// TypeExpr is the Go type expression of the parameter in the generated file
+	TypeExpr string
+}
+
+// packageInfo holds the parsed externalfunctions package
+type packageInfo struct {
+	fset      *token.FileSet
+	files     map[string]*ast.File
+	contents  map[string]string
+	functions map[string]*ast.FuncDecl
+	funcFiles map[string]string
+	// basicTypes maps the named types of the package to their basic underlying type
+	basicTypes map[string]string
+	// enums holds the string enumerations of the package, usedEnums those used by function inputs
+	enums     map[string]functiondefinitions.EnumDefinition
+	usedEnums map[string]bool
+}
+
+func main() {
+	_, thisFile, _, _ := runtime.Caller(0)
+	genDir := filepath.Dir(thisFile)
+	tmplFile := filepath.Join(genDir, "registry.gotmpl")
+	pkgDir := filepath.Join(genDir, "../../../pkg/externalfunctions")
+	outFile := filepath.Join(pkgDir, outFileName)
+
+	pkg, err := parsePackage(pkgDir)
+	if err != nil {
+		panic(fmt.Sprintf("unable to parse externalfunctions package: %v", err))
+	}
+
+	registered, err := stringMapLiteral(pkg, "ExternalFunctionsMap", true)
+	if err != nil {
+		panic(err.Error())
+	}
+	categories, err := stringMapLiteral(pkg, "functionCategories", false)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	// every exported function of the category files must be registered and vice versa
+	err = checkRegistration(pkg, registered, categories)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	// the documented parameters of the functions must match their signature
+	problems := []string{}
+	for fileName := range categories {
+		fileProblems, err := functiondefinitions.LintFunctionDocs(pkg.contents[fileName])
+		if err != nil {
+			panic(fmt.Sprintf("unable to lint %v: %v", fileName, err))
+		}
+		for _, problem := range fileProblems {
+			problems = append(problems, fmt.Sprintf("%v: %v", fileName, problem))
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		panic(fmt.Sprintf("docstrings do not match the function signatures:\n%v", strings.Join(problems, "\n")))
+	}
+
+	// the string enumerations may be declared in any file of the package
+	contents := make([]string, 0, len(pkg.contents))
+	for _, content := range pkg.contents {
+		contents = append(contents, content)
+	}
+	pkg.enums, err = functiondefinitions.ExtractEnumDefinitions(contents...)
+	if err != nil {
+		panic(fmt.Sprintf("unable to extract enumerations: %v", err))
+	}
+
+	// the function definitions are extracted from the category files with the function definitions package
+	internalstates.InitializeInternalStates()
+	for fileName, category := range categories {
+		err := functiondefinitions.ExtractFunctionDefinitionsWithEnums(pkg.contents[fileName], category, pkg.enums)
+		if err != nil {
+			panic(fmt.Sprintf("unable to extract function definitions from %v: %v", fileName, err))
+		}
+	}
+
+	data := Registry{}
+	imports := map[string]bool{"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc": true}
+	keys := make([]string, 0, len(registered))
+	for key := range registered {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		function, err := buildFunction(pkg, key, registered[key], imports)
+		if err != nil {
+			panic(fmt.Sprintf("unable to build registry entry for %v: %v", key, err))
+		}
+		data.Functions = append(data.Functions, function)
+	}
+	for name := range pkg.usedEnums {
+		data.Enums = append(data.Enums, pkg.enums[name])
+	}
+	sort.Slice(data.Enums, func(i, j int) bool { return data.Enums[i].Name < data.Enums[j].Name })
+	for path := range imports {
+		// context and reflect are always imported by the template
+		if path == "context" || path == "reflect" {
+			continue
+		}
+		data.Imports = append(data.Imports, path)
+	}
+	sort.Strings(data.Imports)
+
+	tmpl := template.Must(template.New("").ParseFiles(tmplFile))
+
+	// execute template w/ data
+	var buf bytes.Buffer
+	err = tmpl.ExecuteTemplate(&buf, "registry.gotmpl", data)
+	if err != nil {
+		panic(fmt.Sprintf("unable to execute template: %v", err))
+	}
+
+	// format the generated code
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(fmt.Sprintf("unable to format generated code: %v", err))
+	}
+
+	// write to file
+	err = os.WriteFile(outFile, formatted, 0644)
+	if err != nil {
+		panic(fmt.Sprintf("unable to write generated code to file: %v", err))
+	}
+}
+
+// parsePackage parses the non-test files of the externalfunctions package, except the generated adapters
+func parsePackage(pkgDir string) (*packageInfo, error) {
+	pkg := &packageInfo{
+		fset:       token.NewFileSet(),
+		files:      map[string]*ast.File{},
+		contents:   map[string]string{},
+		functions:  map[string]*ast.FuncDecl{},
+		funcFiles:  map[string]string{},
+		basicTypes: map[string]string{},
+		usedEnums:  map[string]bool{},
+	}
+
+	entries, err := os.ReadDir(pkgDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == outFileName {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(pkgDir, name))
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(pkg.fset, name, content, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		pkg.files[name] = file
+		pkg.contents[name] = string(content)
+
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil {
+					pkg.functions[d.Name.Name] = d
+					pkg.funcFiles[d.Name.Name] = name
+				}
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					if ident, isIdent := typeSpec.Type.(*ast.Ident); isIdent && typeSpec.Assign == 0 {
+						pkg.basicTypes[typeSpec.Name.Name] = ident.Name
+					}
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+// stringMapLiteral reads a package level map variable initialized with a literal with string keys,
+// its values are either string literals or, if functionValues is set, functions of the package
+func stringMapLiteral(pkg *packageInfo, varName string, functionValues bool) (map[string]string, error) {
+	for _, file := range pkg.files {
+		for _, decl := range file.Decls {
+			genDecl, isGenDecl := decl.(*ast.GenDecl)
+			if !isGenDecl || genDecl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				for i, name := range valueSpec.Names {
+					if name.Name != varName || i >= len(valueSpec.Values) {
+						continue
+					}
+					literal, isLiteral := valueSpec.Values[i].(*ast.CompositeLit)
+					if !isLiteral {
+						return nil, fmt.Errorf("%v must be initialized with a map literal", varName)
+					}
+					entries := map[string]string{}
+					for _, elt := range literal.Elts {
+						kv := elt.(*ast.KeyValueExpr)
+						key, err := stringLiteral(kv.Key)
+						if err != nil {
+							return nil, fmt.Errorf("keys of %v must be string literals", varName)
+						}
+						if functionValues {
+							function, isIdent := kv.Value.(*ast.Ident)
+							if !isIdent {
+								return nil, fmt.Errorf("value of %v[%q] must be a function of the package", varName, key)
+							}
+							entries[key] = function.Name
+						} else {
+							entries[key], err = stringLiteral(kv.Value)
+							if err != nil {
+								return nil, fmt.Errorf("values of %v must be string literals", varName)
+							}
+						}
+					}
+					// entries added by generated code
+					err := addAssignedEntries(pkg, varName, entries)
+					if err != nil {
+						return nil, err
+					}
+					return entries, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("variable %v not found", varName)
+}
+
+// addAssignedEntries adds the entries assigned to a map in init functions, as done by the generated cast functions
+func addAssignedEntries(pkg *packageInfo, varName string, entries map[string]string) error {
+	var inspectErr error
+	for _, file := range pkg.files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			assign, isAssign := node.(*ast.AssignStmt)
+			if !isAssign {
+				return true
+			}
+			for i, lhs := range assign.Lhs {
+				index, isIndex := lhs.(*ast.IndexExpr)
+				if !isIndex {
+					continue
+				}
+				if ident, isIdent := index.X.(*ast.Ident); !isIdent || ident.Name != varName {
+					continue
+				}
+				key, err := stringLiteral(index.Index)
+				if err != nil {
+					inspectErr = fmt.Errorf("keys of %v must be string literals", varName)
+					return false
+				}
+				function, isIdent := assign.Rhs[i].(*ast.Ident)
+				if !isIdent {
+					inspectErr = fmt.Errorf("value of %v[%q] must be a function of the package", varName, key)
+					return false
+				}
+				entries[key] = function.Name
+			}
+			return true
+		})
+		if inspectErr != nil {
+			return inspectErr
+		}
+	}
+	return nil
+}
+
+// stringLiteral returns the value of a string literal expression
+func stringLiteral(expr ast.Expr) (string, error) {
+	literal, isLiteral := expr.(*ast.BasicLit)
+	if !isLiteral || literal.Kind != token.STRING {
+		return "", fmt.Errorf("not a string literal")
+	}
+	return strconv.Unquote(literal.Value)
+}
+
+// checkRegistration checks that the exported functions of the category files and the registered functions match
+func checkRegistration(pkg *packageInfo, registered map[string]string, categories map[string]string) error {
+	problems := []string{}
+
+	for fileName := range categories {
+		if _, exists := pkg.files[fileName]; !exists {
+			problems = append(problems, fmt.Sprintf("category file %v does not exist", fileName))
+		}
+	}
+
+	registeredFunctions := map[string]bool{}
+	for key, function := range registered {
+		registeredFunctions[function] = true
+		fileName, exists := pkg.funcFiles[function]
+		if !exists {
+			problems = append(problems, fmt.Sprintf("function %v registered as %q does not exist", function, key))
+			continue
+		}
+		if _, isCategoryFile := categories[fileName]; !isCategoryFile {
+			problems = append(problems, fmt.Sprintf("function %v registered as %q is declared in %v, which has no category", function, key, fileName))
+		}
+	}
+
+	for function, fileName := range pkg.funcFiles {
+		if _, isCategoryFile := categories[fileName]; !isCategoryFile || !ast.IsExported(function) {
+			continue
+		}
+		if !registeredFunctions[function] {
+			problems = append(problems, fmt.Sprintf("exported function %v of %v is not registered in ExternalFunctionsMap", function, fileName))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("invalid function registration:\n  %v", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// buildFunction builds the definition and the adapter of a registered function
+func buildFunction(pkg *packageInfo, key string, functionName string, imports map[string]bool) (Function, error) {
+	fn := pkg.functions[functionName]
+	file := pkg.files[pkg.funcFiles[functionName]]
+	definition, exists := internalstates.AvailableFunctions[functionName]
+	if !exists {
+		return Function{}, fmt.Errorf("no function definition for %v", functionName)
+	}
+
+	function := Function{
+		Key:         key,
+		Function:    functionName,
+		DisplayName: definition.DisplayName,
+		Description: definition.Description,
+		Category:    definition.Category,
+	}
+	metadata := functiondefinitions.ExtractFunctionMetadata(definition.Description)
+	function.Deprecated = metadata.Deprecated
+	function.Since = metadata.Since
+	function.Tags = metadata.Tags
+	for _, input := range definition.Input {
+		function.Inputs = append(function.Inputs, Parameter{Name: input.Name, Type: input.Type, GoType: input.GoType, Options: input.Options, Doc: metadata.Inputs[input.Name]})
+	}
+	for _, output := range definition.Output {
+		function.Outputs = append(function.Outputs, Parameter{Name: output.Name, Type: output.Type, GoType: output.GoType, Doc: metadata.Outputs[output.Name]})
+	}
+
+	// build the call arguments, in the same order as the parameters of the function
+	inputIndex := 0
+	for _, param := range fn.Type.Params.List {
+		if _, isEllipsis := param.Type.(*ast.Ellipsis); isEllipsis {
+			return Function{}, fmt.Errorf("variadic parameters are not supported")
+		}
+		typeString, err := typeString(pkg.fset, file, param.Type, imports)
+		if err != nil {
+			return Function{}, err
+		}
+
+		names := []string{""}
+		if len(param.Names) > 0 {
+			names = nil
+			for _, name := range param.Names {
+				names = append(names, name.Name)
+			}
+		}
+		for _, name := range names {
+			switch {
+			case typeString == "context.Context":
+				function.Args = append(function.Args, "ctx")
+			case name == "llmHandlerEndpoint" || name == "knowledgeDbEndpoint":
+				// not an input of the function definition, the function uses the configured endpoint
+				function.Args = append(function.Args, fmt.Sprintf("*new(%v)", typeString))
+			default:
+				if inputIndex >= len(function.Inputs) {
+					return Function{}, fmt.Errorf("parameter %v has no input definition", name)
+				}
+				function.Args = append(function.Args, inputArg(pkg, param.Type, typeString, inputIndex, function.Inputs[inputIndex].Name))
+				function.Inputs[inputIndex].TypeExpr = typeString
+				inputIndex++
+			}
+		}
+	}
+	if inputIndex != len(function.Inputs) {
+		return Function{}, fmt.Errorf("function has %v inputs but its definition has %v", inputIndex, len(function.Inputs))
+	}
+
+	// the type expressions of the outputs, in the same order as the results of the function
+	outputIndex := 0
+	if fn.Type.Results != nil {
+		for _, result := range fn.Type.Results.List {
+			typeString, err := typeString(pkg.fset, file, result.Type, imports)
+			if err != nil {
+				return Function{}, err
+			}
+			for range max(len(result.Names), 1) {
+				if outputIndex >= len(function.Outputs) {
+					return Function{}, fmt.Errorf("result %v has no output definition", outputIndex)
+				}
+				function.Outputs[outputIndex].TypeExpr = typeString
+				outputIndex++
+			}
+		}
+	}
+	if outputIndex != len(function.Outputs) {
+		return Function{}, fmt.Errorf("function has %v results but its definition has %v", outputIndex, len(function.Outputs))
+	}
+	return function, nil
+}
+
+// inputArg returns the expression reading an input of the adapter
+func inputArg(pkg *packageInfo, typeExpr ast.Expr, typeString string, index int, name string) string {
+	// string enumerations are sent as strings and checked against the values of the enumeration
+	if ident, isIdent := typeExpr.(*ast.Ident); isIdent {
+		if _, isEnum := pkg.enums[ident.Name]; isEnum {
+			pkg.usedEnums[ident.Name] = true
+			return fmt.Sprintf("enumValue[%v](inputs, %d, %q, %q)", typeString, index, name, ident.Name)
+		}
+		// other named basic types are sent as their basic type and converted to the named type
+		if basic, isBasic := pkg.basicTypes[ident.Name]; isBasic {
+			return fmt.Sprintf("%v(inputValue[%v](inputs, %d, %q))", typeString, basic, index, name)
+		}
+	}
+	// missing maps are passed as empty maps so that functions can write to them
+	if _, isMap := typeExpr.(*ast.MapType); isMap {
+		return fmt.Sprintf("inputMap[%v](inputs, %d, %q)", typeString, index, name)
+	}
+	return fmt.Sprintf("inputValue[%v](inputs, %d, %q)", typeString, index, name)
+}
+
+// typeString prints a type expression and records the imports it uses
+func typeString(fset *token.FileSet, file *ast.File, expr ast.Expr, imports map[string]bool) (string, error) {
+	var inspectErr error
+	ast.Inspect(expr, func(node ast.Node) bool {
+		selector, isSelector := node.(*ast.SelectorExpr)
+		if !isSelector {
+			return true
+		}
+		pkgIdent, isIdent := selector.X.(*ast.Ident)
+		if !isIdent {
+			return true
+		}
+		path, err := importPath(file, pkgIdent.Name)
+		if err != nil {
+			inspectErr = err
+			return false
+		}
+		imports[path] = true
+		return false
+	})
+	if inspectErr != nil {
+		return "", inspectErr
+	}
+
+	var buf bytes.Buffer
+	err := format.Node(&buf, fset, expr)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// importPath returns the import path of a package name used in a file
+func importPath(file *ast.File, name string) (string, error) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return "", err
+		}
+		if spec.Name != nil {
+			if spec.Name.Name == name {
+				return "", fmt.Errorf("renamed import %v is not supported", path)
+			}
+			continue
+		}
+		if filepath.Base(path) == name {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("import of package %v not found", name)
+}
@@ -23,59 +23,31 @@
 package main
 
 import (
+	"context"
 	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 
-	"github.com/ansys/aali-flowkit/pkg/functiondefinitions"
+	"github.com/ansys/aali-flowkit/internal/cli"
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
 	"github.com/ansys/aali-flowkit/pkg/grpcserver"
 	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/macros"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
 )
 
 //go:embed VERSION
 var version string
 
-//go:embed pkg/externalfunctions/dataextraction.go
-var dataExtractionFile string
-
-//go:embed pkg/externalfunctions/qdrant.go
-var qdrantFile string
-
-//go:embed pkg/externalfunctions/generic.go
-var genericFile string
-
-//go:embed pkg/externalfunctions/cast.go
-var castFile string
-
-//go:embed pkg/externalfunctions/knowledgedb.go
-var knowledgeDBFile string
-
-//go:embed pkg/externalfunctions/llmhandler.go
-var llmHandlerFile string
-
-//go:embed pkg/externalfunctions/ansysgpt.go
-var ansysGPTFile string
-
-//go:embed pkg/externalfunctions/ansysmeshpilot.go
-var ansysMeshPilotFile string
-
-//go:embed pkg/externalfunctions/ansysmaterials.go
-var ansysMaterialsFile string
-
-//go:embed pkg/externalfunctions/auth.go
-var authFile string
-
-//go:embed pkg/externalfunctions/mcp.go
-var mcpFile string
-
-//go:embed pkg/externalfunctions/rhsc.go
-var rhscFile string
-
-//go:embed pkg/externalfunctions/fluent.go
-var fluentFile string
-
 func init() {
 	// initialize config
 	config.InitConfig([]string{}, map[string]interface{}{
@@ -96,35 +68,73 @@ func init() {
 }
 
 func main() {
+	dumpSchemas := flag.Bool("dump-schemas", false, "print the JSON Schemas of the inputs and outputs of all functions and exit")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), cli.Usage)
+	}
+	flag.Parse()
+
+	// Print the JSON Schemas of the functions instead of starting the server
+	if *dumpSchemas {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err := encoder.Encode(externalfunctions.Schemas())
+		if err != nil {
+			logging.Log.Fatalf(&logging.ContextMap{}, "Error writing function schemas: %v", err)
+		}
+		return
+	}
+
 	// Initialize internal states
 	internalstates.InitializeInternalStates()
 
-	// Create file list
-	files := map[string]string{
-		"data_extraction":  dataExtractionFile,
-		"generic":          genericFile,
-		"cast":             castFile,
-		"knowledge_db":     knowledgeDBFile,
-		"llm_handler":      llmHandlerFile,
-		"ansys_gpt":        ansysGPTFile,
-		"qdrant":           qdrantFile,
-		"ansys_mesh_pilot": ansysMeshPilotFile,
-		"ansys_materials":  ansysMaterialsFile,
-		"auth":             authFile,
-		"mcp":              mcpFile,
-		"rhsc":             rhscFile,
-		"fluent":           fluentFile,
+	// Check the generated function registry and load the function definitions
+	err := externalfunctions.CheckRegistry()
+	if err != nil {
+		logging.Log.Fatalf(&logging.ContextMap{}, "Error in function registry: %v", err)
+	}
+	for name, definition := range externalfunctions.FunctionDefinitions {
+		internalstates.AvailableFunctions[name] = definition
 	}
 
-	// Load function definitions
-	for category, file := range files {
-		err := functiondefinitions.ExtractFunctionDefinitionsFromPackage(file, category)
+	// Register the macro functions defined in the files of FLOWKIT_MACROS_DIR
+	if dir := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_MACROS_DIR"]; dir != "" {
+		names, err := macros.Load(dir)
 		if err != nil {
-			logging.Log.Fatalf(&logging.ContextMap{}, "Error extracting function definitions from package: %v", err)
+			logging.Log.Fatalf(&logging.ContextMap{}, "Error loading macro functions: %v", err)
 		}
+		logging.Log.Infof(&logging.ContextMap{}, "Registered %d macro functions: %s", len(names), strings.Join(names, ", "))
+	}
+
+	// Without command, or with the serve command, start the server
+	if flag.NArg() == 0 || flag.Arg(0) == "serve" {
+		serve()
+		return
 	}
 
-	// Start the gRPC server
+	// Run the other commands of the command line, interrupted by SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	code := cli.Run(ctx, flag.Args(), os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// serve starts the gRPC server and returns after its graceful shutdown
+func serve() {
+	// Set up the export of traces and metrics
+	shutdownTelemetry, err := telemetry.Init(context.Background())
+	if err != nil {
+		logging.Log.Fatalf(&logging.ContextMap{}, "Error setting up telemetry: %v", err)
+	}
+
+	// Start the gRPC server, it returns after a graceful shutdown
 	grpcserver.StartServer()
-	logging.Log.Fatalf(&logging.ContextMap{}, "Error in gRPC server. Exiting application.")
+
+	// Flush the remaining traces and metrics
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err = shutdownTelemetry(ctx)
+	if err != nil {
+		logging.Log.Errorf(&logging.ContextMap{}, "Error flushing telemetry: %v", err)
+	}
 }
@@ -24,6 +24,7 @@ package externalfunctions
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -189,13 +190,14 @@ func AnsysGPTExtractFieldsFromQuery(query string, fieldValues map[string][]strin
 //   - @displayName: Rephrase Request New
 //
 // Parameters:
+//   - ctx: the request context
 //   - template: the template for the rephrase request
 //   - query: the user query
 //   - history: the conversation history
 //
 // Returns:
 //   - rephrasedQuery: the rephrased query
-func AnsysGPTPerformLLMRephraseRequestNew(template string, query string, history []sharedtypes.HistoricMessage) (rephrasedQuery string) {
+func AnsysGPTPerformLLMRephraseRequestNew(ctx context.Context, template string, query string, history []sharedtypes.HistoricMessage) (rephrasedQuery string) {
 	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
 
 	historyMessages := ""
@@ -227,7 +229,7 @@ func AnsysGPTPerformLLMRephraseRequestNew(template string, query string, history
 	}
 
 	// Perform the general request
-	rephrasedQuery, _, err := performGeneralRequest(userTemplate, exampleHistory, false, "You are a query rephrasing assistant. You receive a 'previous user query' as well as a 'current user query' and rephrase the 'current user query' to include any relevant information from the 'previous user query'.", nil)
+	rephrasedQuery, _, err := performGeneralRequest(ctx, userTemplate, exampleHistory, false, "You are a query rephrasing assistant. You receive a 'previous user query' as well as a 'current user query' and rephrase the 'current user query' to include any relevant information from the 'previous user query'.", nil)
 	if err != nil {
 		panic(err)
 	}
@@ -243,13 +245,15 @@ func AnsysGPTPerformLLMRephraseRequestNew(template string, query string, history
 //   - @displayName: Rephrase Request
 //
 // Parameters:
-//   - template: the template for the rephrase request
+//   - ctx: the request context
+//   - userTemplate: the template for the rephrase request
 //   - query: the user query
 //   - history: the conversation history
+//   - systemPrompt: the system prompt of the rephrase request
 //
 // Returns:
 //   - rephrasedQuery: the rephrased query
-func AnsysGPTPerformLLMRephraseRequest(userTemplate string, query string, history []sharedtypes.HistoricMessage, systemPrompt string) (rephrasedQuery string) {
+func AnsysGPTPerformLLMRephraseRequest(ctx context.Context, userTemplate string, query string, history []sharedtypes.HistoricMessage, systemPrompt string) (rephrasedQuery string) {
 	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
 
 	historyMessages := ""
@@ -270,7 +274,7 @@ func AnsysGPTPerformLLMRephraseRequest(userTemplate string, query string, histor
 	logging.Log.Debugf(&logging.ContextMap{}, "User template for repharasing query: %v", userTemplate)
 
 	// Perform the general request
-	rephrasedQuery, _, err := performGeneralRequest(userTemplate, nil, false, systemPrompt, nil)
+	rephrasedQuery, _, err := performGeneralRequest(ctx, userTemplate, nil, false, systemPrompt, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -291,6 +295,8 @@ func AnsysGPTPerformLLMRephraseRequest(userTemplate string, query string, histor
 //
 // Returns:
 //   - finalQuery: the final query
+//   - errorResponse: the error response, set if no context was found
+//   - displayFixedMessageToUser: the flag to indicate whether the error response should be displayed to the user
 func AnsysGPTBuildFinalQuery(refrasedQuery string, context []sharedtypes.ACSSearchResponse) (finalQuery string, errorResponse string, displayFixedMessageToUser bool) {
 	logging.Log.Debugf(&logging.ContextMap{}, "Building final query for Ansys GPT with context of length: %v", len(context))
 
@@ -316,18 +322,21 @@ func AnsysGPTBuildFinalQuery(refrasedQuery string, context []sharedtypes.ACSSear
 //   - @displayName: LLM Request
 //
 // Parameters:
+//   - ctx: the request context
 //   - finalQuery: the final query
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
+//   - isStream: the flag to indicate whether the response should be streamed
 //
 // Returns:
+//   - message: the message from the llm, empty if the response is streamed
 //   - stream: the stream channel
-func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.HistoricMessage, systemPrompt string, isStream bool) (message string, stream *chan string) {
+func AnsysGPTPerformLLMRequest(ctx context.Context, finalQuery string, history []sharedtypes.HistoricMessage, systemPrompt string, isStream bool) (message string, stream *chan string) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(finalQuery, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil, nil)
+	responseChannel := sendChatRequest(ctx, finalQuery, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil, nil)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -335,7 +344,7 @@ func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.Historic
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(ctx, &responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
 
 		// Return the stream channel
 		return "", &streamChannel
@@ -346,7 +355,7 @@ func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.Historic
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			panic(errorFromLLMResponse(response.Error))
 		}
 
 		// Accumulate the responses
@@ -358,9 +367,6 @@ func AnsysGPTPerformLLMRequest(finalQuery string, history []sharedtypes.Historic
 		}
 	}
 
-	// Close the response channel
-	close(responseChannel)
-
 	// Return the response
 	return responseAsStr, nil
 }
@@ -409,22 +415,20 @@ func AnsysGPTReturnIndexList(indexGroups []string) (indexList []string) {
 //   - @displayName: ACS Semantic Hybrid Search
 //
 // Parameters:
+//   - ctx: the request context
+//   - acsEndpoint: the ACS endpoint
+//   - acsApiKey: the ACS API key
+//   - acsApiVersion: the ACS API version
 //   - query: the query string
 //   - embeddedQuery: the embedded query
 //   - indexList: the index list
-//   - typeOfAsset: the type of asset
-//   - physics: the physics
-//   - product: the product
-//   - productMain: the main product
 //   - filter: the filter
-//   - filterAfterVectorSearch: the flag to define the filter order
-//   - returnedProperties: the properties to be returned
 //   - topK: the number of results to be returned from vector search
-//   - searchedEmbeddedFields: the ACS fields to be searched
 //
 // Returns:
 //   - output: the search results
 func AnsysGPTACSSemanticHybridSearchs(
+	ctx context.Context,
 	acsEndpoint string,
 	acsApiKey string,
 	acsApiVersion string,
@@ -436,7 +440,7 @@ func AnsysGPTACSSemanticHybridSearchs(
 
 	output = make([]sharedtypes.ACSSearchResponse, 0)
 	for _, indexName := range indexList {
-		partOutput, err := ansysGPTACSSemanticHybridSearch(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexName, filter, topK, false, nil)
+		partOutput, err := ansysGPTACSSemanticHybridSearch(ctx, acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexName, filter, topK, false, nil)
 		if err != nil {
 			logging.Log.Errorf(&logging.ContextMap{}, "Error in semantic hybrid search: %v", err)
 			panic(err)
@@ -479,7 +483,9 @@ func AnsysGPTReorderSearchResponseAndReturnOnlyTopK(semanticSearchOutput []share
 //   - @displayName: Get System Prompt
 //
 // Parameters:
-//   - rephrasedQuery: the rephrased query
+//   - query: the user query
+//   - prohibitedWords: the words the answer must not contain
+//   - template: the template of the system prompt
 //
 // Returns:
 //   - systemPrompt: the system prompt
@@ -510,14 +516,18 @@ func AnsysGPTGetSystemPrompt(query string, prohibitedWords []string, template st
 //   - @displayName: AIS Rephrase Request
 //
 // Parameters:
+//   - ctx: the request context
 //   - systemTemplate: the system template for the rephrase request
 //   - userTemplate: the user template for the rephrase request
 //   - query: the user query
 //   - history: the conversation history
+//   - tokenCountModelName: the model name to use for token count
 //
 // Returns:
 //   - rephrasedQuery: the rephrased query
-func AisPerformLLMRephraseRequest(systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage, tokenCountModelName string) (rephrasedQuery string, inputTokenCount int, outputTokenCount int) {
+//   - inputTokenCount: the input token count
+//   - outputTokenCount: the output token count
+func AisPerformLLMRephraseRequest(ctx context.Context, systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage, tokenCountModelName string) (rephrasedQuery string, inputTokenCount int, outputTokenCount int) {
 	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
 
 	// create "chat_history" string
@@ -551,7 +561,7 @@ func AisPerformLLMRephraseRequest(systemTemplate string, userTemplate string, qu
 	}
 
 	// Perform the general request
-	rephrasedQuery, _, err := performGeneralRequest(userPrompt, nil, false, systemPrompt, options)
+	rephrasedQuery, _, err := performGeneralRequest(ctx, userPrompt, nil, false, systemPrompt, options)
 	if err != nil {
 		panic(err)
 	}
@@ -578,6 +588,8 @@ func AisPerformLLMRephraseRequest(systemTemplate string, userTemplate string, qu
 //
 // Parameters:
 //   - accessPoint: the access point
+//   - physics: the physics
+//   - version: the versions, used to select the indexes of the 25R1 and 25R2 releases
 //
 // Returns:
 //   - indexList: the index list
@@ -656,6 +668,10 @@ func AisReturnIndexList(accessPoint string, physics []string, version []string)
 //   - @displayName: AIS ACS Semantic Hybrid Search
 //
 // Parameters:
+//   - ctx: the request context
+//   - acsEndpoint: the ACS endpoint
+//   - acsApiKey: the ACS API key
+//   - acsApiVersion: the ACS API version
 //   - query: the query string
 //   - embeddedQuery: the embedded query
 //   - indexList: the index list
@@ -665,6 +681,7 @@ func AisReturnIndexList(accessPoint string, physics []string, version []string)
 // Returns:
 //   - output: the search results
 func AisAcsSemanticHybridSearchs(
+	ctx context.Context,
 	acsEndpoint string,
 	acsApiKey string,
 	acsApiVersion string,
@@ -692,7 +709,7 @@ func AisAcsSemanticHybridSearchs(
 			}()
 			defer wg.Done()
 			// Run the search for this index
-			result, err := ansysGPTACSSemanticHybridSearch(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, idx, nil, topK, true, physics)
+			result, err := ansysGPTACSSemanticHybridSearch(ctx, acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, idx, nil, topK, true, physics)
 			if err != nil {
 				logging.Log.Errorf(&logging.ContextMap{}, "Error in semantic hybrid search: %v", err)
 				return
@@ -764,10 +781,13 @@ func AisChangeAcsResponsesByFactor(factors map[string]float64, semanticSearchOut
 //   - @displayName: AEC Get Context from Retriever Module
 //
 // Parameters:
+//   - ctx: the request context
 //   - retrieverModuleEndpoint: the endpoint of the retriever module
 //   - userQuery: the user query
 //   - dataSources: the data sources
 //   - physics: the physics
+//   - version: the versions to filter the results by
+//   - product: the products to filter the results by
 //   - topK: the number of results to be returned
 //   - plattform: the platform
 //   - retrieverModuleKey: the key for the retriever module
@@ -775,6 +795,7 @@ func AisChangeAcsResponsesByFactor(factors map[string]float64, semanticSearchOut
 // Returns:
 //   - context: the context retrieved from the retriever module
 func AecGetContextFromRetrieverModule(
+	ctx context.Context,
 	retrieverModuleEndpoint string,
 	userQuery string,
 	dataSources []string,
@@ -819,13 +840,13 @@ func AecGetContextFromRetrieverModule(
 	// Marshal the request body to JSON
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
-		panic(fmt.Errorf("error marshalling request body: %v", err))
+		panic(NewInternalError(err, "error marshalling request body: %v", err))
 	}
 
 	// Create a new HTTP request
-	request, err := http.NewRequest("POST", retrieverModuleEndpoint, bytes.NewBuffer(jsonBody))
+	request, err := http.NewRequestWithContext(ctx, "POST", retrieverModuleEndpoint, bytes.NewBuffer(jsonBody))
 	if err != nil {
-		panic(fmt.Errorf("error creating request: %v", err))
+		panic(NewInternalError(err, "error creating request: %v", err))
 	}
 
 	// Set headers
@@ -835,20 +856,20 @@ func AecGetContextFromRetrieverModule(
 	// Create an HTTP client and make the request
 	resp, err := client.Do(request)
 	if err != nil {
-		panic(fmt.Errorf("error making request: %v", err))
+		panic(NewUpstreamUnavailableError(UpstreamHTTP, err, "error making request: %v", err))
 	}
 	defer resp.Body.Close()
 
 	// Check the response status
 	if resp.StatusCode != 200 {
-		panic(fmt.Errorf("error response from retriever module: %v", resp.Status))
+		panic(NewUpstreamHTTPError(UpstreamHTTP, resp.StatusCode, "error response from retriever module: %v", resp.Status))
 	}
 
 	// Parse the response
 	response := map[string]sharedtypes.AnsysGPTRetrieverModuleChunk{}
 	err = json.NewDecoder(resp.Body).Decode(&response)
 	if err != nil {
-		panic(fmt.Errorf("error decoding response: %v", err))
+		panic(NewInternalError(err, "error decoding response: %v", err))
 	}
 	logging.Log.Debugf(&logging.ContextMap{}, "Received response from retriever module: %v", response)
 
@@ -858,11 +879,11 @@ func AecGetContextFromRetrieverModule(
 		// Extract int from chunkNum
 		_, chunkNumstring, found := strings.Cut(chunkNum, "chunk ")
 		if !found {
-			panic(fmt.Errorf("error extracting chunk number from '%v'", chunkNum))
+			panic(NewInternalError(nil, "error extracting chunk number from '%v'", chunkNum))
 		}
 		chunkNumInt, err := strconv.Atoi(chunkNumstring)
 		if err != nil {
-			panic(fmt.Errorf("error converting chunk number to int: %v", err))
+			panic(NewInternalError(err, "error converting chunk number to int: %v", err))
 		}
 		// Store the chunk in the context slice
 		context[chunkNumInt-1] = chunk
@@ -877,17 +898,28 @@ func AecGetContextFromRetrieverModule(
 //   - @displayName: AEC Final Request
 //
 // Parameters:
+//   - ctx: the request context
 //   - systemTemplate: the system template for the final request
 //   - userTemplate: the user template for the final request
 //   - query: the user query
 //   - history: the conversation history
+//   - context: the context retrieved from the retriever module
 //   - prohibitedWords: the list of prohibited words
 //   - errorList1: the list of error words
 //   - errorList2: the list of error words
+//   - tokenCountEndpoint: the endpoint to send the token count to
+//   - previousInputTokenCount: the input token count of the previous requests
+//   - previousOutputTokenCount: the output token count of the previous requests
+//   - tokenCountModelName: the model name to use for token count
+//   - isStream: the stream flag
+//   - userEmail: the email of the user
+//   - jwtToken: the JWT token used to authenticate with the token count endpoint
+//   - dontSendTokenCount: the flag to indicate whether sending the token count should be skipped
 //
 // Returns:
+//   - message: the message from the llm, empty as the response is streamed
 //   - stream: the stream channel
-func AecPerformLLMFinalRequest(systemTemplate string,
+func AecPerformLLMFinalRequest(ctx context.Context, systemTemplate string,
 	userTemplate string,
 	query string,
 	history []sharedtypes.HistoricMessage,
@@ -981,7 +1013,7 @@ func AecPerformLLMFinalRequest(systemTemplate string,
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequest(userPrompt, "general", nil, 0, systemPrompt, llmHandlerEndpoint, nil, nil, options, nil)
+	responseChannel := sendChatRequest(ctx, userPrompt, "general", nil, 0, systemPrompt, llmHandlerEndpoint, nil, nil, options, nil)
 
 	// Create a stream channel
 	streamChannel := make(chan string, 400)
@@ -1000,7 +1032,7 @@ func AecPerformLLMFinalRequest(systemTemplate string,
 	}
 
 	// Start a goroutine to transfer the data from the response channel to the stream channel.
-	go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, sendTokenCount, tokenCountEndpoint, totalInputTokenCount, previousOutputTokenCount, tokenCountModelName, jwtToken, userEmail, true, contextString)
+	go transferDatafromResponseToStreamChannel(ctx, &responseChannel, &streamChannel, false, sendTokenCount, tokenCountEndpoint, totalInputTokenCount, previousOutputTokenCount, tokenCountModelName, jwtToken, userEmail, true, contextString)
 
 	return "", &streamChannel
 }
@@ -1012,6 +1044,7 @@ func AecPerformLLMFinalRequest(systemTemplate string,
 //
 // Parameters:
 //   - queryType: the query type
+//   - errorResponseMessage: the message returned for gated queries
 //
 // Returns:
 //   - isGreet: the flag indicating whether the query type is greet
@@ -1029,7 +1062,7 @@ func DataPluginCheckQueryType(queryType string, errorResponseMessage string) (is
 		return false, true, errorResponseMessage
 	default:
 		logging.Log.Errorf(&logging.ContextMap{}, "Invalid queryType: %v\n", queryType)
-		panic(fmt.Errorf("invalid queryType: %v", queryType))
+		panic(NewInvalidInputError("queryType", "invalid queryType: %v", queryType))
 	}
 }
 
@@ -1039,6 +1072,7 @@ func DataPluginCheckQueryType(queryType string, errorResponseMessage string) (is
 //   - @displayName: Data Plugin Rephrase Request
 //
 // Parameters:
+//   - ctx: the request context
 //   - systemTemplate: the system template for the rephrase request
 //   - userTemplate: the user template for the rephrase request
 //   - query: the user query
@@ -1046,7 +1080,7 @@ func DataPluginCheckQueryType(queryType string, errorResponseMessage string) (is
 //
 // Returns:
 //   - rephrasedQuery: the rephrased query
-func DataPluginPerformLLMRephraseRequest(systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage) (rephrasedQuery string) {
+func DataPluginPerformLLMRephraseRequest(ctx context.Context, systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage) (rephrasedQuery string) {
 	logging.Log.Debugf(&logging.ContextMap{}, "Performing LLM rephrase request")
 
 	// create "chat_history" string
@@ -1080,7 +1114,7 @@ func DataPluginPerformLLMRephraseRequest(systemTemplate string, userTemplate str
 	}
 
 	// Perform the general request
-	rephrasedQuery, _, err := performGeneralRequest(userPrompt, nil, false, systemPrompt, options)
+	rephrasedQuery, _, err := performGeneralRequest(ctx, userPrompt, nil, false, systemPrompt, options)
 	if err != nil {
 		panic(err)
 	}
@@ -1100,13 +1134,14 @@ func DataPluginPerformLLMRephraseRequest(systemTemplate string, userTemplate str
 //
 // Returns:
 //   - context: the context extracted from the citations
+//   - resetCitations: an empty string, used to reset the citations
 func DataPluginConvertCitationsToContext(citations string) (context []sharedtypes.AnsysGPTRetrieverModuleChunk, resetCitations string) {
 	// Unmarschal the citations string
 	citationsMap := map[string]sharedtypes.AnsysGPTRetrieverModuleChunk{}
 	err := json.Unmarshal([]byte(citations), &citationsMap)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error unmarshalling citations: %v", err)
-		panic(fmt.Errorf("error unmarshalling citations: %v", err))
+		panic(NewInvalidInputError("citations", "error unmarshalling citations: %v", err))
 	}
 
 	// Helper struct to hold chunk number and value for sorting
@@ -1124,12 +1159,12 @@ func DataPluginConvertCitationsToContext(citations string) (context []sharedtype
 		_, chunkNumstring, found := strings.Cut(chunkNum, "chunk ")
 		if !found {
 			logging.Log.Errorf(&logging.ContextMap{}, "Error extracting chunk number from '%v'", chunkNum)
-			panic(fmt.Errorf("error extracting chunk number from '%v'", chunkNum))
+			panic(NewInternalError(nil, "error extracting chunk number from '%v'", chunkNum))
 		}
 		chunkNumInt, err := strconv.Atoi(chunkNumstring)
 		if err != nil {
 			logging.Log.Errorf(&logging.ContextMap{}, "Error converting chunk number to int: %v", err)
-			panic(fmt.Errorf("error converting chunk number to int: %v", err))
+			panic(NewInternalError(err, "error converting chunk number to int: %v", err))
 		}
 
 		// Append to entries
@@ -1159,6 +1194,7 @@ func DataPluginConvertCitationsToContext(citations string) (context []sharedtype
 //   - @displayName: Data Plugin Get Context
 //
 // Parameters:
+//   - ctx: the request context
 //   - userQuery: the user query
 //   - apiUrl: the API URL of the data plugin
 //   - username: the username for authentication at the data plugin
@@ -1169,7 +1205,7 @@ func DataPluginConvertCitationsToContext(citations string) (context []sharedtype
 //
 // Returns:
 //   - context: the context retrieved from the data plugin
-func DataPluginGetContext(userQuery string, apiUrl string, username string, password string, topK int, physics []string, dataSource []string) (context []sharedtypes.AnsysGPTRetrieverModuleChunk) {
+func DataPluginGetContext(ctx context.Context, userQuery string, apiUrl string, username string, password string, topK int, physics []string, dataSource []string) (context []sharedtypes.AnsysGPTRetrieverModuleChunk) {
 	// Generate string of comma-separated physics and data sources
 	physicsString := strings.Join(physics, ", ")
 	dataSourceString := strings.Join(dataSource, ", ")
@@ -1188,9 +1224,9 @@ func DataPluginGetContext(userQuery string, apiUrl string, username string, pass
 	formData.Set("data_source", encodedDataSource)
 
 	// Create the request
-	req, err := http.NewRequest("POST", apiUrl, bytes.NewBufferString(formData.Encode()))
+	req, err := http.NewRequestWithContext(ctx, "POST", apiUrl, bytes.NewBufferString(formData.Encode()))
 	if err != nil {
-		panic(fmt.Errorf("error creating request: %v", err))
+		panic(NewInternalError(err, "error creating request: %v", err))
 	}
 
 	// Set content type for form data
@@ -1205,19 +1241,19 @@ func DataPluginGetContext(userQuery string, apiUrl string, username string, pass
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		panic(fmt.Errorf("error making request: %v", err))
+		panic(NewUpstreamUnavailableError(UpstreamHTTP, err, "error making request: %v", err))
 	}
 	defer resp.Body.Close()
 
 	// Read the response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		panic(fmt.Errorf("error reading response body: %v", err))
+		panic(NewInternalError(err, "error reading response body: %v", err))
 	}
 
 	// Check status code
 	if resp.StatusCode != 200 {
-		panic(fmt.Errorf("error response from data plugin: %v, body: %s", resp.Status, string(body)))
+		panic(NewUpstreamHTTPError(UpstreamHTTP, resp.StatusCode, "error response from data plugin: %v, body: %s", resp.Status, string(body)))
 	}
 
 	// The response is base64 encoded
@@ -1226,14 +1262,14 @@ func DataPluginGetContext(userQuery string, apiUrl string, username string, pass
 	// Decode the base64 response
 	decodedResponse, err := base64.StdEncoding.DecodeString(base64EncodedResponse)
 	if err != nil {
-		panic(fmt.Errorf("error decoding base64 response: %v", err))
+		panic(NewInternalError(err, "error decoding base64 response: %v", err))
 	}
 
 	// Unmarshal the response
 	response := map[string]sharedtypes.AnsysGPTRetrieverModuleChunk{}
 	err = json.Unmarshal(decodedResponse, &response)
 	if err != nil {
-		panic(fmt.Errorf("error unmarshalling response: %v", err))
+		panic(NewInternalError(err, "error unmarshalling response: %v", err))
 	}
 	logging.Log.Debugf(&logging.ContextMap{}, "Received response from retriever module: %v", response)
 
@@ -1243,11 +1279,11 @@ func DataPluginGetContext(userQuery string, apiUrl string, username string, pass
 		// Extract int from chunkNum
 		_, chunkNumstring, found := strings.Cut(chunkNum, "chunk ")
 		if !found {
-			panic(fmt.Errorf("error extracting chunk number from '%v'", chunkNum))
+			panic(NewInternalError(nil, "error extracting chunk number from '%v'", chunkNum))
 		}
 		chunkNumInt, err := strconv.Atoi(chunkNumstring)
 		if err != nil {
-			panic(fmt.Errorf("error converting chunk number to int: %v", err))
+			panic(NewInternalError(err, "error converting chunk number to int: %v", err))
 		}
 		// Store the chunk in the context slice
 		context[chunkNumInt-1] = chunk
@@ -1286,17 +1322,19 @@ func DataPluginGetFinalSystemPrompt(responseType string, classicTemplate string,
 //   - @displayName: Data Plugin Final Request
 //
 // Parameters:
+//   - ctx: the request context
 //   - systemTemplate: the system template for the final request
 //   - userTemplate: the user template for the final request
 //   - query: the user query
 //   - history: the conversation history
+//   - context: the context retrieved from the retriever module
 //   - prohibitedWords: the list of prohibited words
 //   - isStream: the flag to define if the response should be streamed
 //
 // Returns:
 //   - message: the message
 //   - stream: the stream channel
-func DataPluginPerformLLMFinalRequest(systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage, context []sharedtypes.AnsysGPTRetrieverModuleChunk, prohibitedWords []string, isStream bool) (message string, stream *chan string) {
+func DataPluginPerformLLMFinalRequest(ctx context.Context, systemTemplate string, userTemplate string, query string, history []sharedtypes.HistoricMessage, context []sharedtypes.AnsysGPTRetrieverModuleChunk, prohibitedWords []string, isStream bool) (message string, stream *chan string) {
 	// create "chat_history" string
 	historyMessages := ""
 	for _, message := range history {
@@ -1358,13 +1396,13 @@ func DataPluginPerformLLMFinalRequest(systemTemplate string, userTemplate string
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequest(userPrompt, "general", nil, 0, systemPrompt, llmHandlerEndpoint, nil, nil, options, nil)
+	responseChannel := sendChatRequest(ctx, userPrompt, "general", nil, 0, systemPrompt, llmHandlerEndpoint, nil, nil, options, nil)
 
 	// Create a stream channel
 	streamChannel := make(chan string, 400)
 
 	// Start a goroutine to transfer the data from the response channel to the stream channel.
-	go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", true, contextString)
+	go transferDatafromResponseToStreamChannel(ctx, &responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", true, contextString)
 
 	return "", &streamChannel
 }
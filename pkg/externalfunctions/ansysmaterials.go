@@ -23,6 +23,7 @@
 package externalfunctions
 
 import (
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -99,7 +100,7 @@ func generateSpanID() string {
 	return strconv.FormatUint(spanID, 10)
 }
 
-func CreateChildSpan(ctx *logging.ContextMap, traceID string, parentSpanID string) (childSpanID string) {
+func createChildSpan(ctx *logging.ContextMap, traceID string, parentSpanID string) (childSpanID string) {
 	// Generate a new span ID for the child
 	childSpanID = generateSpanID()
 
@@ -118,6 +119,7 @@ func CreateChildSpan(ctx *logging.ContextMap, traceID string, parentSpanID strin
 //   - @displayName: Serialize response for clients
 //
 // Parameters:
+//   - criteriaSuggestions: the suggested criteria to serialize
 //   - tokens: tokens consumed by the request
 //   - traceID: the trace ID in decimal format
 //   - spanID: the span ID in decimal format
@@ -127,14 +129,14 @@ func CreateChildSpan(ctx *logging.ContextMap, traceID string, parentSpanID strin
 //   - childSpanID: the child span ID created for this operation
 func SerializeResponse(criteriaSuggestions []sharedtypes.MaterialCriterionWithGuid, tokens int, traceID string, spanID string) (result string, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	response := Response{Criteria: criteriaSuggestions, Tokens: tokens}
 
 	responseJson, err := json.Marshal(response)
 	if err != nil {
 		logging.Log.Debugf(ctx, "Failed to serialize suggested criteria into json: %v", err)
-		panic("Failed to serialize suggested criteria into json")
+		panic(NewInternalError(err, "Failed to serialize suggested criteria into json"))
 	}
 
 	logging.Log.Debugf(ctx, "Serializing response with %d criteria. Response: %+v", len(criteriaSuggestions), response)
@@ -157,7 +159,7 @@ func SerializeResponse(criteriaSuggestions []sharedtypes.MaterialCriterionWithGu
 //   - childSpanID: the child span ID created for this operation
 func AddGuidsToAttributes(criteriaSuggestions []sharedtypes.MaterialLlmCriterion, availableAttributes []sharedtypes.MaterialAttribute, traceID string, spanID string) (criteriaWithGuids []sharedtypes.MaterialCriterionWithGuid, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	attributeMap := make(map[string]string)
 	for _, attr := range availableAttributes {
@@ -200,7 +202,7 @@ func AddGuidsToAttributes(criteriaSuggestions []sharedtypes.MaterialLlmCriterion
 //   - childSpanID: the child span ID created for this operation
 func FilterOutNonExistingAttributes(criteriaSuggestions []sharedtypes.MaterialCriterionWithGuid, availableSearchCriteria []string, traceID string, spanID string) (filtered []sharedtypes.MaterialCriterionWithGuid, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	attributeGuidMap := make(map[string]bool)
 	for _, attr := range availableSearchCriteria {
@@ -237,7 +239,7 @@ func FilterOutNonExistingAttributes(criteriaSuggestions []sharedtypes.MaterialCr
 //   - childSpanID: the child span ID created for this operation
 func FilterOutDuplicateAttributes(criteriaSuggestions []sharedtypes.MaterialLlmCriterion, traceID string, spanID string) (filtered []sharedtypes.MaterialLlmCriterion, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	seen := make(map[string]bool)
 
@@ -267,9 +269,9 @@ func FilterOutDuplicateAttributes(criteriaSuggestions []sharedtypes.MaterialLlmC
 //   - childSpanID: the child span ID created for this operation
 func ExtractCriteriaSuggestions(llmResponse string, traceID string, spanID string) (criteriaSuggestions []sharedtypes.MaterialLlmCriterion, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
-	criteriaText, _ := ExtractJson(llmResponse, traceID, spanID)
+	criteriaText, _ := extractJson(llmResponse, traceID, spanID)
 	if criteriaText == "" {
 		logging.Log.Debugf(ctx, "No valid JSON found in LLM response: %s", llmResponse)
 		return nil, childSpanID
@@ -300,6 +302,7 @@ func ExtractCriteriaSuggestions(llmResponse string, traceID string, spanID strin
 //   - @displayName: Multiple General LLM Requests (Specific Models, No Stream, Attribute Extraction, OpenAI Token Output)
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input string
 //   - history: the conversation history for context
 //   - systemPrompt: the system prompt to guide the LLM
@@ -309,15 +312,16 @@ func ExtractCriteriaSuggestions(llmResponse string, traceID string, spanID strin
 //   - temperature: the temperature setting for the LLM requests
 //   - traceID: the trace ID in decimal format
 //   - spanID: the span ID in decimal format
+//   - userID: the ID of the user, logged with the token usage
 //
 // Returns:
 //   - uniqueCriterion: a deduplicated list of extracted attributes (criteria) from all responses
 //   - tokenCount: the total token count (input tokens × n + combined output tokens)
 //   - childSpanID: the child span ID created for this operation
-func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(input string, history []sharedtypes.HistoricMessage,
+func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(ctx context.Context, input string, history []sharedtypes.HistoricMessage,
 	systemPrompt string, modelIds []string, tokenCountModelName string, n int, temperature float64, traceID string, spanID string, userID string) (uniqueCriterion []sharedtypes.MaterialLlmCriterion, tokenCount int, childSpanID string) {
-	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	logCtx := &logging.ContextMap{}
+	childSpanID = createChildSpan(logCtx, traceID, spanID)
 
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
@@ -329,13 +333,12 @@ func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(inp
 
 	// Helper function to send a request and get the response as string
 	sendRequest := func() string {
-		responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, modelOptions, nil)
-		defer close(responseChannel)
+		responseChannel := sendChatRequest(ctx, input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, modelOptions, nil)
 
 		var responseStr string
 		for response := range responseChannel {
 			if response.Type == "error" {
-				panic(response.Error)
+				panic(errorFromLLMResponse(response.Error))
 			}
 			responseStr += *(response.ChatData)
 			if *(response.IsLast) {
@@ -345,8 +348,8 @@ func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(inp
 		return responseStr
 	}
 
-	logging.Log.Debugf(ctx, "System prompt: %s", systemPrompt)
-	logging.Log.Debugf(ctx, "User prompt: %s", input)
+	logging.Log.Debugf(logCtx, "System prompt: %s", systemPrompt)
+	logging.Log.Debugf(logCtx, "User prompt: %s", input)
 
 	// Collect all responses with child span for parallel execution
 	allResponses, llmResponseTime := runRequestsInParallel(n, sendRequest, traceID, childSpanID)
@@ -370,13 +373,13 @@ func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(inp
 	outputTokenCount, _ := getTokenCount(tokenCountModelName, combinedResponseText, traceID, childSpanID)
 
 	var totalTokenCount = inputTokenCount + outputTokenCount
-	logging.Log.Debugf(ctx, "Input token count: %d", inputTokenCount)
-	logging.Log.Debugf(ctx, "Output token count: %d", outputTokenCount)
-	logging.Log.Debugf(ctx, "Total token count: %d", totalTokenCount)
+	logging.Log.Debugf(logCtx, "Input token count: %d", inputTokenCount)
+	logging.Log.Debugf(logCtx, "Output token count: %d", outputTokenCount)
+	logging.Log.Debugf(logCtx, "Total token count: %d", totalTokenCount)
 
 	// Set the token count and userID as tag to be able to pick them up from Datadog without requiring a pipeline
 	var tokenCounterContext = &logging.ContextMap{}
-	CreateChildSpan(tokenCounterContext, traceID, spanID)
+	createChildSpan(tokenCounterContext, traceID, spanID)
 	tokenCounterContext.Set(logging.ContextKey(userIDTag), userID)
 	tokenCounterContext.Set(logging.ContextKey(totalTokenUsageTag), totalTokenCount)
 	tokenCounterContext.Set(logging.ContextKey(inputTokenUsageTag), inputTokenCount)
@@ -385,7 +388,7 @@ func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(inp
 	logging.Log.Infof(tokenCounterContext, "Token usage for UserID %s - Input tokens: %d, Output tokens: %d, Total tokens: %d. Response time: %.2f seconds", userID, inputTokenCount, outputTokenCount, totalTokenCount, llmResponseTime)
 
 	if len(allCriteria) == 0 {
-		logging.Log.Infof(ctx, "No valid criteria found in any response")
+		logging.Log.Infof(logCtx, "No valid criteria found in any response")
 		return []sharedtypes.MaterialLlmCriterion{}, outputTokenCount, childSpanID
 	}
 
@@ -397,7 +400,7 @@ func PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(inp
 
 func runRequestsInParallel(n int, sendRequest func() string, traceID string, spanID string) ([]string, float64) {
 	ctx := &logging.ContextMap{}
-	_ = CreateChildSpan(ctx, traceID, spanID)
+	_ = createChildSpan(ctx, traceID, spanID)
 
 	startTime := time.Now()
 
@@ -447,7 +450,7 @@ func runRequestsInParallel(n int, sendRequest func() string, traceID string, spa
 //   - childSpanID: the child span ID created for this operation
 func getTokenCount(modelName, text string, traceID string, spanID string) (count int, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	logging.Log.Debugf(ctx, "Getting token count for model: %s", modelName)
 
@@ -455,16 +458,16 @@ func getTokenCount(modelName, text string, traceID string, spanID string) (count
 	if err != nil {
 		logging.Log.Errorf(ctx, "Error getting token count: %v", err)
 		errorMessage := fmt.Sprintf("Error getting output token count: %v", err)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	logging.Log.Debugf(ctx, "Token count: %d", tokenCount)
 	return tokenCount, childSpanID
 }
 
-func ExtractJson(text string, traceID string, spanID string) (json string, childSpanID string) {
+func extractJson(text string, traceID string, spanID string) (json string, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	re := regexp.MustCompile("{[\\s\\S]*}")
 	matches := re.FindStringSubmatch(text)
@@ -489,7 +492,7 @@ func ExtractJson(text string, traceID string, spanID string) (json string, child
 //   - childSpanID: the child span ID created for this operation
 func LogRequestSuccess(traceID string, spanID string) (childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	logging.Log.Infof(ctx, "Request successful")
 	return childSpanID
@@ -508,7 +511,7 @@ func LogRequestSuccess(traceID string, spanID string) (childSpanID string) {
 //   - childSpanID: the child span ID created for this operation
 func LogRequestFailed(traceID string, spanID string) (childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	logging.Log.Infof(ctx, "Request failed")
 	return childSpanID
@@ -529,7 +532,7 @@ func LogRequestFailed(traceID string, spanID string) (childSpanID string) {
 //   - childSpanID: the child span ID created for this operation
 func LogRequestFailedDebugWithMessage(msg1, msg2 string, traceID string, spanID string) (childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	logging.Log.Debugf(ctx, "Request failed:%s %s", msg1, msg2)
 	return childSpanID
@@ -541,6 +544,7 @@ func LogRequestFailedDebugWithMessage(msg1, msg2 string, traceID string, spanID
 //   - @displayName: Verify API Key
 //
 // Parameters:
+//   - ctx: the request context
 //   - kvdbEndpoint: the KVDB endpoint
 //   - apiKey: The API key to check
 //   - traceID: the trace ID in decimal format
@@ -549,24 +553,25 @@ func LogRequestFailedDebugWithMessage(msg1, msg2 string, traceID string, spanID
 // Returns:
 //   - isAuthenticated: true if the API key is authenticated, false otherwise
 //   - childSpanID: the child span ID created for this operation
-func CheckApiKeyAuthKvDb(kvdbEndpoint string, apiKey string, traceID string, spanID string) (isAuthenticated bool, childSpanID string, userID string) {
-	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+//   - userID: the ID of the user the API key belongs to, empty if the API key is not authenticated
+func CheckApiKeyAuthKvDb(ctx context.Context, kvdbEndpoint string, apiKey string, traceID string, spanID string) (isAuthenticated bool, childSpanID string, userID string) {
+	logCtx := &logging.ContextMap{}
+	childSpanID = createChildSpan(logCtx, traceID, spanID)
 
 	// Check if the API key is empty
 	if apiKey == "" {
-		logging.Log.Errorf(ctx, "API key is empty")
+		logging.Log.Errorf(logCtx, "API key is empty")
 		return false, childSpanID, ""
 	}
 
 	// Check if the API key exists in the KVDB
-	jsonString, exists, err := kvdbGetEntry(kvdbEndpoint, apiKey)
+	jsonString, exists, err := kvdbGetEntry(ctx, kvdbEndpoint, apiKey)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error in getting API key from KVDB: %v", err)
-		panic(err)
+		logging.Log.Errorf(logCtx, "Error in getting API key from KVDB: %v", err)
+		panic(NewUpstreamError(UpstreamHTTP, err, "Error in getting API key from KVDB: %v", err))
 	}
 	if !exists {
-		logging.Log.Warnf(ctx, "API key does not exist in KVDB: %s", apiKey)
+		logging.Log.Warnf(logCtx, "API key does not exist in KVDB: %s", apiKey)
 		return false, childSpanID, ""
 	}
 
@@ -574,17 +579,17 @@ func CheckApiKeyAuthKvDb(kvdbEndpoint string, apiKey string, traceID string, spa
 	var customer materialsCustomerObject
 	err = json.Unmarshal([]byte(jsonString), &customer)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error unmarshalling JSON string: %v", err)
+		logging.Log.Errorf(logCtx, "Error unmarshalling JSON string: %v", err)
 		panic(err)
 	}
 
 	// Check if customer is denied access
 	if customer.AccessDenied {
-		logging.Log.Infof(ctx, "Access denied for customer: %s", customer.UserID)
+		logging.Log.Infof(logCtx, "Access denied for customer: %s", customer.UserID)
 		return false, childSpanID, customer.UserID
 	}
 
-	logging.Log.Infof(ctx, "Customer with UserID: %s is authenticated. Request trace ID: %s", customer.UserID, traceID)
+	logging.Log.Infof(logCtx, "Customer with UserID: %s is authenticated. Request trace ID: %s", customer.UserID, traceID)
 
 	return true, childSpanID, customer.UserID
 }
@@ -595,6 +600,7 @@ func CheckApiKeyAuthKvDb(kvdbEndpoint string, apiKey string, traceID string, spa
 //   - @displayName: Update Customer Token Count
 //
 // Parameters:
+//   - ctx: the request context
 //   - kvdbEndpoint: the KVDB endpoint
 //   - apiKey: The API key of the customer
 //   - additionalTokenCount: The number of tokens to add to the customer's total token count
@@ -604,32 +610,32 @@ func CheckApiKeyAuthKvDb(kvdbEndpoint string, apiKey string, traceID string, spa
 // Returns:
 //   - tokenLimitReached: true if the new total token count exceeds the customer's token limit, false otherwise
 //   - childSpanID: the child span ID created for this operation
-func UpdateTotalTokenCountForCustomerKvDb(kvdbEndpoint string, apiKey string, additionalTokenCount int, traceID string, spanID string) (tokenLimitReached bool, childSpanID string) {
-	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+func UpdateTotalTokenCountForCustomerKvDb(ctx context.Context, kvdbEndpoint string, apiKey string, additionalTokenCount int, traceID string, spanID string) (tokenLimitReached bool, childSpanID string) {
+	logCtx := &logging.ContextMap{}
+	childSpanID = createChildSpan(logCtx, traceID, spanID)
 
 	// Check if the API key is empty
 	if apiKey == "" {
-		logging.Log.Errorf(ctx, "API key is empty")
-		panic("API key is empty")
+		logging.Log.Errorf(logCtx, "API key is empty")
+		panic(NewInvalidInputError("apiKey", "API key is empty"))
 	}
 
 	// Get the current token count for the customer
-	jsonString, exists, err := kvdbGetEntry(kvdbEndpoint, apiKey)
+	jsonString, exists, err := kvdbGetEntry(ctx, kvdbEndpoint, apiKey)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error getting customer object: %v", err)
-		panic(err)
+		logging.Log.Errorf(logCtx, "Error getting customer object: %v", err)
+		panic(NewUpstreamError(UpstreamHTTP, err, "Error getting customer object: %v", err))
 	}
 	if !exists {
-		logging.Log.Errorf(ctx, "API key does not exist in KVDB: %s", apiKey)
-		panic("API key does not exist in KVDB")
+		logging.Log.Errorf(logCtx, "API key does not exist in KVDB: %s", apiKey)
+		panic(NewNotFoundError("", "API key does not exist in KVDB"))
 	}
 
 	// Unmarshal the JSON string into materials customer object
 	var customer materialsCustomerObject
 	err = json.Unmarshal([]byte(jsonString), &customer)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error unmarshalling JSON string: %v", err)
+		logging.Log.Errorf(logCtx, "Error unmarshalling JSON string: %v", err)
 		panic(err)
 	}
 
@@ -639,15 +645,15 @@ func UpdateTotalTokenCountForCustomerKvDb(kvdbEndpoint string, apiKey string, ad
 	// create json string from customer object
 	newJsonString, err := json.Marshal(customer)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error marshalling updated customer object: %v", err)
+		logging.Log.Errorf(logCtx, "Error marshalling updated customer object: %v", err)
 		panic(err)
 	}
 
 	// Update the KVDB with the new JSON string
-	err = kvdbSetEntry(kvdbEndpoint, apiKey, string(newJsonString))
+	err = kvdbSetEntry(ctx, kvdbEndpoint, apiKey, string(newJsonString))
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error updating customer token count in KVDB: %v", err)
-		panic(err)
+		logging.Log.Errorf(logCtx, "Error updating customer token count in KVDB: %v", err)
+		panic(NewUpstreamError(UpstreamHTTP, err, "Error updating customer token count in KVDB: %v", err))
 	}
 
 	// Check if the new token count exceeds the limit
@@ -664,6 +670,7 @@ func UpdateTotalTokenCountForCustomerKvDb(kvdbEndpoint string, apiKey string, ad
 //   - @displayName: Deny Customer Access and Send Warning
 //
 // Parameters:
+//   - ctx: the request context
 //   - kvdbEndpoint: the KVDB endpoint
 //   - apiKey: The API key of the customer
 //   - traceID: the trace ID in decimal format
@@ -673,32 +680,32 @@ func UpdateTotalTokenCountForCustomerKvDb(kvdbEndpoint string, apiKey string, ad
 //   - customerName: The name of the customer
 //   - sendWarning: true if a warning was sent, false if it was already sent
 //   - childSpanID: the child span ID created for this operation
-func DenyCustomerAccessAndSendWarningKvDb(kvdbEndpoint string, apiKey string, traceID string, spanID string) (customerName string, sendWarning bool, childSpanID string) {
-	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+func DenyCustomerAccessAndSendWarningKvDb(ctx context.Context, kvdbEndpoint string, apiKey string, traceID string, spanID string) (customerName string, sendWarning bool, childSpanID string) {
+	logCtx := &logging.ContextMap{}
+	childSpanID = createChildSpan(logCtx, traceID, spanID)
 
 	// Check if the API key is empty
 	if apiKey == "" {
-		logging.Log.Errorf(ctx, "API key is empty")
-		panic("API key is empty")
+		logging.Log.Errorf(logCtx, "API key is empty")
+		panic(NewInvalidInputError("apiKey", "API key is empty"))
 	}
 
 	// Get the current customer object from KVDB
-	jsonString, exists, err := kvdbGetEntry(kvdbEndpoint, apiKey)
+	jsonString, exists, err := kvdbGetEntry(ctx, kvdbEndpoint, apiKey)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error getting customer object: %v", err)
-		panic(err)
+		logging.Log.Errorf(logCtx, "Error getting customer object: %v", err)
+		panic(NewUpstreamError(UpstreamHTTP, err, "Error getting customer object: %v", err))
 	}
 	if !exists {
-		logging.Log.Errorf(ctx, "API key does not exist in KVDB: %s", apiKey)
-		panic("API key does not exist in KVDB")
+		logging.Log.Errorf(logCtx, "API key does not exist in KVDB: %s", apiKey)
+		panic(NewNotFoundError("", "API key does not exist in KVDB"))
 	}
 
 	// Unmarshal the JSON string into materials customer object
 	var customer materialsCustomerObject
 	err = json.Unmarshal([]byte(jsonString), &customer)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error unmarshalling JSON string: %v", err)
+		logging.Log.Errorf(logCtx, "Error unmarshalling JSON string: %v", err)
 		panic(err)
 	}
 
@@ -714,15 +721,15 @@ func DenyCustomerAccessAndSendWarningKvDb(kvdbEndpoint string, apiKey string, tr
 	// create json string from customer object
 	newJsonString, err := json.Marshal(customer)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error marshalling updated customer object: %v", err)
+		logging.Log.Errorf(logCtx, "Error marshalling updated customer object: %v", err)
 		panic(err)
 	}
 
 	// Update the KVDB with the new JSON string
-	err = kvdbSetEntry(kvdbEndpoint, apiKey, string(newJsonString))
+	err = kvdbSetEntry(ctx, kvdbEndpoint, apiKey, string(newJsonString))
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error updating customer access in KVDB: %v", err)
-		panic(err)
+		logging.Log.Errorf(logCtx, "Error updating customer access in KVDB: %v", err)
+		panic(NewUpstreamError(UpstreamHTTP, err, "Error updating customer access in KVDB: %v", err))
 	}
 
 	return customer.CustomerName, sendWarning, childSpanID
@@ -745,7 +752,7 @@ func DenyCustomerAccessAndSendWarningKvDb(kvdbEndpoint string, apiKey string, tr
 //   - childSpanID: the child span ID created for this operation
 func ExtractDesignRequirementsAndSearchCriteria(userInput string, traceID string, spanID string) (designRequirements string, availableSearchCriteria []string, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	type promptInput struct {
 		UserDesignRequirements  string   `json:"userDesignRequirements"`
@@ -755,7 +762,7 @@ func ExtractDesignRequirementsAndSearchCriteria(userInput string, traceID string
 	var input promptInput
 	if err := json.Unmarshal([]byte(userInput), &input); err != nil {
 		logging.Log.Debugf(ctx, "Failed to parse user input: %v", err)
-		panic("failed to parse user input: " + err.Error())
+		panic(NewInvalidInputError("userInput", "failed to parse user input: %v", err))
 	}
 
 	logging.Log.Debugf(ctx, "Successfully extracted design requirements and %d search criteria", len(input.AvailableSearchCriteria))
@@ -780,7 +787,7 @@ func ExtractDesignRequirementsAndSearchCriteria(userInput string, traceID string
 //   - childSpanID: the child span ID created for this operation
 func AddAvailableAttributesToSystemPrompt(userDesignRequirements string, systemPromptTemplate string, allAvailableAttributes []sharedtypes.MaterialAttribute, availableSearchCriteria []string, traceID string, spanID string) (fullSystemPrompt string, childSpanID string) {
 	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+	childSpanID = createChildSpan(ctx, traceID, spanID)
 
 	// 1) Filter allAvailableAttributes using availableSearchCriteria (GUIDs)
 	guidSet := make(map[string]struct{}, len(availableSearchCriteria))
@@ -817,14 +824,15 @@ func AddAvailableAttributesToSystemPrompt(userDesignRequirements string, systemP
 //   - @displayName: Send Email to multiple email addresses
 //
 // Parameters:
+//   - ctx: the request context
 //   - logicAppEndpoint: The Logic App email service endpoint
 //   - emails: Array of email addresses to send to
 //   - subject: The email subject
 //   - content: The email content
-func SendLogicAppNotificationEmailToMultipleEmails(logicAppEndpoint string, emails []string, subject string, content string) {
+func SendLogicAppNotificationEmailToMultipleEmails(ctx context.Context, logicAppEndpoint string, emails []string, subject string, content string) {
 	for _, email := range emails {
 		if email != "" {
-			SendLogicAppNotificationEmail(logicAppEndpoint, email, subject, content)
+			SendLogicAppNotificationEmail(ctx, logicAppEndpoint, email, subject, content)
 		}
 	}
 }
@@ -836,34 +844,34 @@ func SendLogicAppNotificationEmailToMultipleEmails(logicAppEndpoint string, emai
 //   - @displayName: Reset Token Count if new month
 //
 // Parameters:
+//   - ctx: the request context
 //   - kvdbEndpoint: the KVDB endpoint
 //   - apiKey: The API key of the customer
 //   - traceID: the trace ID in decimal format
 //   - spanID: the span ID in decimal format
 //
 // Returns:
-//   - updatedCustomer: the customer object with potentially reset token count and updated timestamp
 //   - childSpanID: the child span ID created for this operation
-func ResetTokenCountIfNewMonth(kvdbEndpoint string, apiKey string, traceID string, spanID string) (childSpanID string) {
-	ctx := &logging.ContextMap{}
-	childSpanID = CreateChildSpan(ctx, traceID, spanID)
+func ResetTokenCountIfNewMonth(ctx context.Context, kvdbEndpoint string, apiKey string, traceID string, spanID string) (childSpanID string) {
+	logCtx := &logging.ContextMap{}
+	childSpanID = createChildSpan(logCtx, traceID, spanID)
 
 	// Get the latest customer object from KVDB
-	jsonString, exists, err := kvdbGetEntry(kvdbEndpoint, apiKey)
+	jsonString, exists, err := kvdbGetEntry(ctx, kvdbEndpoint, apiKey)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error getting customer object from KVDB: %v", err)
-		panic(err)
+		logging.Log.Errorf(logCtx, "Error getting customer object from KVDB: %v", err)
+		panic(NewUpstreamError(UpstreamHTTP, err, "Error getting customer object from KVDB: %v", err))
 	}
 	if !exists {
-		logging.Log.Errorf(ctx, "API key does not exist in KVDB: %s", apiKey)
-		panic("API key does not exist in KVDB")
+		logging.Log.Errorf(logCtx, "API key does not exist in KVDB: %s", apiKey)
+		panic(NewNotFoundError("", "API key does not exist in KVDB"))
 	}
 
 	// Unmarshal the JSON string into materials customer object
 	var customer materialsCustomerObject
 	err = json.Unmarshal([]byte(jsonString), &customer)
 	if err != nil {
-		logging.Log.Errorf(ctx, "Error unmarshalling JSON string: %v", err)
+		logging.Log.Errorf(logCtx, "Error unmarshalling JSON string: %v", err)
 		panic(err)
 	}
 
@@ -872,25 +880,25 @@ func ResetTokenCountIfNewMonth(kvdbEndpoint string, apiKey string, traceID strin
 
 	// Handle case where LastUpdated is 0 (new customer)
 	if customer.LastUpdated == 0 {
-		logging.Log.Debugf(ctx, "New customer with key %s. Setting initial timestamp.", apiKey)
+		logging.Log.Debugf(logCtx, "New customer with key %s. Setting initial timestamp.", apiKey)
 		// Don't save any history for initial setup, just set the timestamp
 		customer.LastUpdated = now.Unix()
 
 		updatedJsonString, err := json.Marshal(customer)
 		if err != nil {
-			logging.Log.Errorf(ctx, "Error marshalling updated customer object: %v", err)
+			logging.Log.Errorf(logCtx, "Error marshalling updated customer object: %v", err)
 			panic(err)
 		}
 
-		err = kvdbSetEntry(kvdbEndpoint, apiKey, string(updatedJsonString))
+		err = kvdbSetEntry(ctx, kvdbEndpoint, apiKey, string(updatedJsonString))
 		if err != nil {
-			logging.Log.Errorf(ctx, "Error updating customer timestamp in KVDB: %v", err)
-			panic(err)
+			logging.Log.Errorf(logCtx, "Error updating customer timestamp in KVDB: %v", err)
+			panic(NewUpstreamError(UpstreamHTTP, err, "Error updating customer timestamp in KVDB: %v", err))
 		}
 	} else {
 		// Check if last updated is from a different month or year
 		if now.Year() != lastUpdated.Year() || now.Month() != lastUpdated.Month() {
-			logging.Log.Debugf(ctx, "Token count reset for customer %s. Last updated: %v, Current time: %v",
+			logging.Log.Debugf(logCtx, "Token count reset for customer %s. Last updated: %v, Current time: %v",
 				apiKey, lastUpdated, now)
 
 			historyEntry := materialsCustomerHistoryObject{
@@ -899,7 +907,7 @@ func ResetTokenCountIfNewMonth(kvdbEndpoint string, apiKey string, traceID strin
 				Timestamp:       customer.LastUpdated,
 			}
 			customer.UsageHistory = append(customer.UsageHistory, historyEntry)
-			logging.Log.Debugf(ctx, "Saved usage history for customer %s: %d tokens (limit: %d) at timestamp %d",
+			logging.Log.Debugf(logCtx, "Saved usage history for customer %s: %d tokens (limit: %d) at timestamp %d",
 				apiKey, customer.TotalTokenCount, customer.TokenLimit, customer.LastUpdated)
 
 			// Reset token count to 0 and update timestamp
@@ -909,15 +917,15 @@ func ResetTokenCountIfNewMonth(kvdbEndpoint string, apiKey string, traceID strin
 			// Marshal updated customer object back to JSON
 			updatedJsonString, err := json.Marshal(customer)
 			if err != nil {
-				logging.Log.Errorf(ctx, "Error marshalling updated customer object: %v", err)
+				logging.Log.Errorf(logCtx, "Error marshalling updated customer object: %v", err)
 				panic(err)
 			}
 
 			// Update the KVDB with the reset token count
-			err = kvdbSetEntry(kvdbEndpoint, apiKey, string(updatedJsonString))
+			err = kvdbSetEntry(ctx, kvdbEndpoint, apiKey, string(updatedJsonString))
 			if err != nil {
-				logging.Log.Errorf(ctx, "Error updating customer token count in KVDB: %v", err)
-				panic(err)
+				logging.Log.Errorf(logCtx, "Error updating customer token count in KVDB: %v", err)
+				panic(NewUpstreamError(UpstreamHTTP, err, "Error updating customer token count in KVDB: %v", err))
 			}
 		}
 	}
@@ -40,6 +40,7 @@ import (
 	"github.com/ansys/aali-flowkit/pkg/meshpilot/azure"
 
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
 	"github.com/qdrant/go-client/qdrant"
 
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
@@ -51,142 +52,143 @@ import (
 //   - @displayName: SimilartitySearchOnPathDescriptions
 //
 // Parameters:
+//   - ctx: the request context
 //   - instruction: the user query
 //   - toolName: the tool name
 //
 // Returns:
 //   - descriptions: the list of descriptions
-func SimilartitySearchOnPathDescriptions(instruction string, toolName string) (descriptions []string) {
+func SimilartitySearchOnPathDescriptions(ctx context.Context, instruction string, toolName string) (descriptions []string) {
 	descriptions = []string{}
-	ctx := &logging.ContextMap{}
+	logCtx := &logging.ContextMap{}
 
 	db_endpoint := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["MESHPILOT_DB_ENDPOINT"]
-	logging.Log.Debugf(ctx, "DB Endpoint: %q", db_endpoint)
+	logging.Log.Debugf(logCtx, "DB Endpoint: %q", db_endpoint)
 
 	toolName1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_1_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_1_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName3, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_3_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 3 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName4, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_2_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 4 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName5, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_3_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 5 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName6, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_4_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 6 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName7, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_5_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 7 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName8, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_6_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 8 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName9, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_19_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 9 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolName10, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_8_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool name 10 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection1Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_1_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection2Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_2_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection3Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_3_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 3 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection4Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_4_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 4 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection5Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_5_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 5 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection6Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_6_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 6 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection7Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_7_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 7 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection8Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["COLLECTION_8_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load collection name 8 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	collection_name := ""
@@ -210,12 +212,12 @@ func SimilartitySearchOnPathDescriptions(instruction string, toolName string) (d
 		collection_name = collection7Name
 	} else {
 		errorMessage := fmt.Sprintf("Invalid Tool Name: %q", toolName)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInvalidInputError("toolName", "%s", errorMessage))
 	}
 
 	db_url := fmt.Sprintf("%s%s%s", db_endpoint, "/qdrant/similar_descriptions/from/", collection_name)
-	logging.Log.Debugf(ctx, "Constructed URL: %s", db_url)
+	logging.Log.Debugf(logCtx, "Constructed URL: %s", db_url)
 
 	body := map[string]string{
 		"query": instruction,
@@ -223,16 +225,16 @@ func SimilartitySearchOnPathDescriptions(instruction string, toolName string) (d
 	bodyBytes, err := json.Marshal(body)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Failed to marshal request body: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
-	logging.Log.Debugf(ctx, "Request Body: %s", string(bodyBytes))
+	logging.Log.Debugf(logCtx, "Request Body: %s", string(bodyBytes))
 
-	req, err := http.NewRequest("POST", db_url, bytes.NewBuffer(bodyBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", db_url, bytes.NewBuffer(bodyBytes))
 	if err != nil {
 		errorMessage := fmt.Sprintf("Failed to create request: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 	req.Header.Set("Content-Type", "application/json")
 
@@ -240,24 +242,24 @@ func SimilartitySearchOnPathDescriptions(instruction string, toolName string) (d
 	resp, err := client.Do(req)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Failed to send request: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewUpstreamUnavailableError(UpstreamHTTP, err, "%s", errorMessage))
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		errorMessage := fmt.Sprintf("Unexpected status code: %d", resp.StatusCode)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewUpstreamHTTPError(UpstreamHTTP, resp.StatusCode, "%s", errorMessage))
 	}
 
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Failed to read response body: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
-	logging.Log.Debugf(ctx, "Response: %s", string(responseBody))
+	logging.Log.Debugf(logCtx, "Response: %s", string(responseBody))
 
 	var response struct {
 		Descriptions []string `json:"descriptions"`
@@ -265,12 +267,12 @@ func SimilartitySearchOnPathDescriptions(instruction string, toolName string) (d
 	err = json.Unmarshal(responseBody, &response)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Failed to unmarshal response: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	descriptions = response.Descriptions
-	logging.Log.Debugf(ctx, "Descriptions: %q", descriptions)
+	logging.Log.Debugf(logCtx, "Descriptions: %q", descriptions)
 	return
 }
 
@@ -303,7 +305,7 @@ func FindRelevantPathDescription(descriptions []string, message string) (relevan
 	if len(message) == 0 {
 		errorMessage := fmt.Sprintf("no message found from the choice")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInvalidInputError("message", "%s", errorMessage))
 	}
 
 	// Log the response content for debugging
@@ -351,35 +353,41 @@ func FindRelevantPathDescription(descriptions []string, message string) (relevan
 //   - @displayName: FetchPropertiesFromPathDescription
 //
 // Parameters:
+//   - ctx: the request context.
+//   - db_name: the name of the database
 //   - description: the desctiption of path
 //
 // Returns:
 //   - properties: the list of descriptions
-func FetchPropertiesFromPathDescription(db_name, description string) (properties []string) {
+func FetchPropertiesFromPathDescription(ctx context.Context, db_name, description string) (properties []string) {
 
-	ctx := &logging.ContextMap{}
+	logCtx := &logging.ContextMap{}
+
+	logging.Log.Infof(logCtx, "Fetching Properties From Path Descriptions...")
 
-	logging.Log.Infof(ctx, "Fetching Properties From Path Descriptions...")
+	checkGraphDbContext(ctx, "fetching properties")
 
 	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
 	query := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_GET_PROPERTIES_QUERY"]
 
+	span := startGraphDbSpan(ctx, "GetProperties", db_name, query)
 	properties, err = ampgraphdb.GraphDbDriver.GetProperties(description, query)
+	telemetry.EndSpan(span, err)
 
 	if err != nil {
 		errorMessage := fmt.Sprintf("Error fetching properties from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errorMessage))
 	}
 
-	logging.Log.Debugf(ctx, "Propetries: %q\n", properties)
+	logging.Log.Debugf(logCtx, "Propetries: %q\n", properties)
 	return
 }
 
@@ -389,37 +397,43 @@ func FetchPropertiesFromPathDescription(db_name, description string) (properties
 //   - @displayName: FetchNodeDescriptionsFromPathDescription
 //
 // Parameters:
+//   - ctx: the request context.
+//   - db_name: the name of the database
 //   - description: the desctiption of path
 //
 // Returns:
 //   - actionDescriptions: action descriptions
-func FetchNodeDescriptionsFromPathDescription(db_name, description string) (actionDescriptions string) {
+func FetchNodeDescriptionsFromPathDescription(ctx context.Context, db_name, description string) (actionDescriptions string) {
 
-	ctx := &logging.ContextMap{}
+	logCtx := &logging.ContextMap{}
+
+	logging.Log.Infof(logCtx, "Fetching Node Descriptions From Path Descriptions...")
 
-	logging.Log.Infof(ctx, "Fetching Node Descriptions From Path Descriptions...")
+	checkGraphDbContext(ctx, "fetching node descriptions")
 
 	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
 	// Get environment variables
 	query := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_GET_STATE_NODE_QUERY"]
 
+	span := startGraphDbSpan(ctx, "GetSummaries", db_name, query)
 	summaries, err := ampgraphdb.GraphDbDriver.GetSummaries(description, query)
+	telemetry.EndSpan(span, err)
 
 	if err != nil {
 		errorMessage := fmt.Sprintf("Error fetching summaries from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errorMessage))
 	}
 
 	actionDescriptions = summaries
-	logging.Log.Debugf(ctx, "Summaries: %q\n", actionDescriptions)
+	logging.Log.Debugf(logCtx, "Summaries: %q\n", actionDescriptions)
 
 	return
 }
@@ -430,38 +444,42 @@ func FetchNodeDescriptionsFromPathDescription(db_name, description string) (acti
 //   - @displayName: FetchActionsPathFromPathDescription
 //
 // Parameters:
+//   - ctx: the request context.
+//   - db_name: the name of the database
 //   - description: the desctiption of path
 //   - nodeLabel: the label of the node
 //
 // Returns:
 //   - actions: the list of actions to execute
-func FetchActionsPathFromPathDescription(db_name, description, nodeLabel string) (actions []map[string]string) {
-	ctx := &logging.ContextMap{}
+func FetchActionsPathFromPathDescription(ctx context.Context, db_name, description, nodeLabel string) (actions []map[string]string) {
+	logCtx := &logging.ContextMap{}
 
-	logging.Log.Infof(ctx, "Fetching Actions From Path Descriptions...")
+	logging.Log.Infof(logCtx, "Fetching Actions From Path Descriptions...")
+
+	checkGraphDbContext(ctx, "fetching actions")
 
 	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
 	// Get the node label 1 from the configuration
 	nodeLabel1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_FETCH_PATH_NODES_QUERY_NODE_LABEL_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load node label 1 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get the node label 2 from the configuration
 	nodeLabel2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_FETCH_PATH_NODES_QUERY_NODE_LABEL_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load node label 2 from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	var query string
@@ -471,15 +489,17 @@ func FetchActionsPathFromPathDescription(db_name, description, nodeLabel string)
 		query, exists = config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_GET_ACTIONS_QUERY_LABEL_2"]
 	} else {
 		errorMessage := fmt.Sprintf("Invalid Node Label: %q", nodeLabel)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInvalidInputError("nodeLabel", "%s", errorMessage))
 	}
 
+	span := startGraphDbSpan(ctx, "GetActions", db_name, query)
 	actions, err = ampgraphdb.GraphDbDriver.GetActions(description, query)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Error fetching actions from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errorMessage))
 	}
 
 	return
@@ -505,7 +525,7 @@ func SynthesizeActionsTool2(message string, actions []map[string]string) (update
 	if len(message) == 0 {
 		errorMessage := fmt.Sprintf("the message is empty, cannot synthesize actions")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInvalidInputError("message", "%s", errorMessage))
 	}
 
 	logging.Log.Debugf(ctx, "The Message: %s\n", message)
@@ -526,7 +546,7 @@ func SynthesizeActionsTool2(message string, actions []map[string]string) (update
 	if err != nil {
 		errorMessage := fmt.Sprintf("SynthesizeActionsTool2: Failed to unmarshal response: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	scopePattern := output.ScopePattern
@@ -538,21 +558,21 @@ func SynthesizeActionsTool2(message string, actions []map[string]string) (update
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	synthesizeActionsValue, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_TOOL2_VALUE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	synthesizeActionsReplaceKey, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_REPLACE_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Updated actions from output
@@ -577,6 +597,7 @@ func SynthesizeActionsTool2(message string, actions []map[string]string) (update
 // Parameters:
 //   - message_1: the first message from the llm
 //   - message_2: the second message from the llm
+//   - target_object: the target object of the actions
 //   - actions: the list of actions
 //
 // Returns:
@@ -598,28 +619,28 @@ func SynthesizeActionsTool3(message_1 string, message_2 string, target_object st
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions tool 3 find key from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	synthesizeActionsFindKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_PROMPT_TEMPLATE_SYNTHESIZE_ACTION_FIND_KEY"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions tool 3 find key from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	APP_TOOL_ACTIONS_TARGET_5, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_5"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_5 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	APP_TOOL_ACTIONS_TARGET_6, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_6"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_6 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Initialize updatedActions with the input actions
@@ -678,7 +699,7 @@ func SynthesizeActionsTool11(content string) (result string) {
 		UnitSystem string `json:"UnitSystem"`
 	}
 	if err := json.Unmarshal([]byte(content), &out); err != nil {
-		panic(fmt.Sprintf("unmarshal UnitSystem failed: %v", err))
+		panic(NewInvalidInputError("content", "unmarshal UnitSystem failed: %v", err))
 	}
 	unitSystem := out.UnitSystem
 	logging.Log.Infof(ctx, "Synthesized UnitSystem: %s", unitSystem)
@@ -687,32 +708,32 @@ func SynthesizeActionsTool11(content string) (result string) {
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_ACTION_TOOL_11_SUCCESS_MESSAGE from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 	actionKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_2 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 	actionValue1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_11_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTION_11_NAME from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 	actionValue2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actions := []map[string]string{
@@ -732,7 +753,7 @@ func SynthesizeActionsTool11(content string) (result string) {
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to marshal final message for tool 11: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	result = string(resultStream)
@@ -760,7 +781,7 @@ func SynthesizeActionsTool12(content string) (result string) {
 	}
 
 	if err := json.Unmarshal([]byte(content), &out); err != nil {
-		panic(fmt.Sprintf("unmarshal Argument failed: %v", err))
+		panic(NewInvalidInputError("content", "unmarshal Argument failed: %v", err))
 	}
 
 	Argument := out.Argument
@@ -770,35 +791,35 @@ func SynthesizeActionsTool12(content string) (result string) {
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_ACTION_TOOL_12_SUCCESS_MESSAGE from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_2 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionValue1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_12_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTION_12_NAME from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionValue2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actions := []map[string]string{
@@ -818,7 +839,7 @@ func SynthesizeActionsTool12(content string) (result string) {
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to marshal final message for tool 12: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	result = string(resultStream)
@@ -848,7 +869,7 @@ func SynthesizeActionsTool17(content string) (result string) {
 	logging.Log.Infof(ctx, "SynthesizeActionsTool17 content: %s", content)
 
 	if err := json.Unmarshal([]byte(content), &out); err != nil {
-		panic(fmt.Sprintf("unmarshal Argument failed: %v", err))
+		panic(NewInvalidInputError("content", "unmarshal Argument failed: %v", err))
 	}
 
 	Argument := out.Argument
@@ -858,35 +879,35 @@ func SynthesizeActionsTool17(content string) (result string) {
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_ACTION_TOOL_17_SUCCESS_MESSAGE from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_2 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionValue1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_17_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTION_17_NAME from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionValue2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actions := []map[string]string{
@@ -906,7 +927,7 @@ func SynthesizeActionsTool17(content string) (result string) {
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to marshal final message for tool 17: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	result = string(resultStream)
@@ -942,7 +963,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	if len(message) == 0 {
 		errorMessage := fmt.Sprintf("the message is empty, cannot synthesize actions")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInvalidInputError("message", "%s", errorMessage))
 	}
 
 	logging.Log.Debugf(ctx, "The Message: %s\n", message)
@@ -978,7 +999,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions find key from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get synthesize actions replace key 1 from configuration
@@ -986,7 +1007,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions replace key 1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get synthesize actions replace key 2 from configuration
@@ -994,7 +1015,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize actions replace key 2 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get synthesize output key 1 from configuration
@@ -1002,7 +1023,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize output key 1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get synthesize output key 2 from configuration
@@ -1010,7 +1031,7 @@ func SynthesizeActions(message string, properties []string, actions []map[string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load synthesize output key 2 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Update the actions
@@ -1088,7 +1109,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 1 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 4 name from the configuration
@@ -1096,7 +1117,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 2 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 5 name from the configuration
@@ -1104,7 +1125,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 3 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 6 name from the configuration
@@ -1112,7 +1133,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 4 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 7 name from the configuration
@@ -1120,7 +1141,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 5 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 8 name from the configuration
@@ -1128,21 +1149,21 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 6 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction8Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_8_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 8 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction14Name, exexists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_14_NAME"]
 	if !exexists {
 		errorMessage := fmt.Sprintf("failed to load action tool 14 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool action success message from configuration
@@ -1150,14 +1171,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 15 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction19Name, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTION_19_NAME"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 19 name from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 2 action message from configuration
@@ -1165,7 +1186,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 1 message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 2 no action message from configuration
@@ -1173,14 +1194,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load action tool 1 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction2Message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_2_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 4 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 4 no action message from configuration
@@ -1188,14 +1209,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 4 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction3Message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_3_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 5 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 5 no action message from configuration
@@ -1203,14 +1224,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 5 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction4Message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_4_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 6 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 6 no action message from configuration
@@ -1218,14 +1239,14 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 6 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction5Message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_5_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 7 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 7 no action message from configuration
@@ -1233,21 +1254,21 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 7 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction6Message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_6_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 8 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction6NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_6_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 8 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 8 action message from configuration
@@ -1255,42 +1276,42 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 8 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction8NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_8_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 8 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction14Message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_14_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 16 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction14NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_14_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 16 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction15Message, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_15_SUCCESS_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 17 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	toolAction15NoActionMessage, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_ACTION_TOOL_15_NO_ACTION_MESSAGE"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 17 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 19 action message from configuration
@@ -1298,7 +1319,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 19 action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Get tool 19 no action message from configuration
@@ -1306,7 +1327,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load tool 19 no action message from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	if toolName == toolAction1Name {
@@ -1372,7 +1393,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	} else {
 		errorMessage := fmt.Sprintf("Invalid toolName %s", toolName)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInvalidInputError("toolName", "%s", errorMessage))
 	}
 
 	finalMessage := make(map[string]interface{})
@@ -1385,7 +1406,7 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to convert actions to json: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	result = string(bytesStream)
@@ -1400,48 +1421,54 @@ func FinalizeResult(actions []map[string]string, toolName string) (result string
 //   - @displayName: GetSolutionsToFixProblem
 //
 // Parameters:
+//   - ctx: the request context.
+//   - db_name: the name of the database
 //   - fmFailureCode: FM failure Code
 //   - primeMeshFailureCode: Prime Mesh Failure Code
 //
 // Returns:
 //   - solutions: the list of solutions in json
-func GetSolutionsToFixProblem(db_name, fmFailureCode, primeMeshFailureCode string) (solutions string) {
+func GetSolutionsToFixProblem(ctx context.Context, db_name, fmFailureCode, primeMeshFailureCode string) (solutions string) {
 
-	ctx := &logging.ContextMap{}
+	logCtx := &logging.ContextMap{}
 
-	logging.Log.Infof(ctx, "Get Solutions To Fix Problem...")
+	logging.Log.Infof(logCtx, "Get Solutions To Fix Problem...")
+
+	checkGraphDbContext(ctx, "fetching solutions")
 
 	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, db_name)
 
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
 	query, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_DATABASE_GET_SOLUTIONS_QUERY"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load query from the configuration")
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
+	span := startGraphDbSpan(ctx, "GetSolutions", db_name, query)
 	solutionsVec, err := ampgraphdb.GraphDbDriver.GetSolutions(fmFailureCode, primeMeshFailureCode, query)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Error fetching solutions from path description: %v", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errorMessage))
 	}
 
 	byteStream, err := json.Marshal(solutionsVec)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Error marshalling solutions: %v\n", err)
-		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		logging.Log.Error(logCtx, errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	solutions = string(byteStream)
-	logging.Log.Info(ctx, "found solutions to fix problem...")
+	logging.Log.Info(logCtx, "found solutions to fix problem...")
 	return
 }
 
@@ -1468,7 +1495,7 @@ func GetSelectedSolution(arguments string) (solution string) {
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to un marshal index output")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	solution = output.Solution
@@ -1580,7 +1607,7 @@ func ParseHistory(historyJson string) (history []map[string]string) {
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to unmarshal history json: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	// populate history
@@ -1653,7 +1680,7 @@ func GetActionsFromConfig(toolName string) (result string) {
 		if !exists {
 			errorMessage := fmt.Sprintf("%s: %s", errorMsg, key)
 			logging.Log.Error(ctx, errorMessage)
-			panic(errorMessage)
+			panic(NewInternalError(nil, "%s", errorMessage))
 		}
 		return value
 	}
@@ -1710,7 +1737,7 @@ func GetActionsFromConfig(toolName string) (result string) {
 	} else {
 		errorMessage := fmt.Sprintf("Invalid toolName %s", toolName)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInvalidInputError("toolName", "%s", errorMessage))
 	}
 
 	message := toolActionSuccessMessage
@@ -1730,14 +1757,14 @@ func GetActionsFromConfig(toolName string) (result string) {
 		if err != nil {
 			errorMessage := fmt.Sprintf("failed to convert actions to json: %v", err)
 			logging.Log.Error(ctx, errorMessage)
-			panic(errorMessage)
+			panic(NewInternalError(err, "%s", errorMessage))
 		}
 		result = string(bytesStream)
 		logging.Log.Infof(ctx, "successfully converted actions to json: %q", result)
 	} else {
 		errorMessage := fmt.Sprintf("Invalid toolName %s", toolName)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInvalidInputError("toolName", "%s", errorMessage))
 	}
 
 	return result
@@ -1749,19 +1776,22 @@ func GetActionsFromConfig(toolName string) (result string) {
 //   - @displayName: SimilartitySearchOnPathDescriptions (Qdrant)
 //
 // Parameters:
-//   - instruction: the user query
-//   - toolName: the tool name
+//   - ctx: the request context
+//   - vector: the embedded user query
+//   - collection: the collection to search in
+//   - similaritySearchResults: the number of results to return
+//   - similaritySearchMinScore: the minimum score of the results
 //
 // Returns:
 //   - descriptions: the list of descriptions
-func SimilartitySearchOnPathDescriptionsQdrant(vector []float32, collection string, similaritySearchResults int, similaritySearchMinScore float64) (descriptions []string) {
+func SimilartitySearchOnPathDescriptionsQdrant(ctx context.Context, vector []float32, collection string, similaritySearchResults int, similaritySearchMinScore float64) (descriptions []string) {
 	descriptions = []string{}
 
 	logCtx := &logging.ContextMap{}
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(logCtx, "unable to create qdrant client: %q", err)
+		logPanicError(logCtx, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
 	limit := uint64(similaritySearchResults)
@@ -1775,9 +1805,9 @@ func SimilartitySearchOnPathDescriptionsQdrant(vector []float32, collection stri
 		WithPayload:    qdrant.NewWithPayloadInclude("Description"),
 	}
 
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
-		logPanic(logCtx, "error in qdrant query: %q", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error in qdrant query: %q", err))
 	}
 	logging.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
 
@@ -1789,7 +1819,7 @@ func SimilartitySearchOnPathDescriptionsQdrant(vector []float32, collection stri
 		if err != nil {
 			errMsg := fmt.Sprintf("error converting qdrant payload to dbResponse: %q", err)
 			logging.Log.Errorf(logCtx, "%s", errMsg)
-			panic(errMsg)
+			panic(NewInternalError(err, "%s", errMsg))
 		}
 
 		description, ok := dbResponse["Description"].(string)
@@ -1824,7 +1854,7 @@ func ParseHistoryToHistoricMessages(historyJson string) (history []sharedtypes.H
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to unmarshal history json: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	for _, msg := range historyMaps {
@@ -1850,6 +1880,7 @@ func ParseHistoryToHistoricMessages(historyJson string) (history []sharedtypes.H
 //   - slashCmd: the slash command if found, otherwise an empty string
 //   - targetCmd: the target command if found, otherwise an empty string
 //   - hasCmd: boolean indicating if a slash command or target command was found
+//   - hasContext: boolean indicating if the input contains text besides the commands
 func ParseSlashCommand(userInput string) (slashCmd, targetCmd string, hasCmd bool, hasContext bool) {
 
 	targetRe := regexp.MustCompile(`@[A-Za-z][\w]*`)
@@ -1903,28 +1934,28 @@ func SynthesizeSlashCommand(slashCmd, targetCmd, finalizeResult string) (result
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_ACTION_TOOL_17_SUCCESS_MESSAGE from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionKey1, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionKey2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_KEY_2"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_KEY_2 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	actionValue2, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_TOOL_ACTIONS_TARGET_1"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load APP_TOOL_ACTIONS_TARGET_1 from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	var actions []map[string]string
@@ -1935,7 +1966,7 @@ func SynthesizeSlashCommand(slashCmd, targetCmd, finalizeResult string) (result
 		if err != nil {
 			errorMessage := fmt.Sprintf("failed to unmarshal finalizeResult: %v", err)
 			logging.Log.Error(ctx, errorMessage)
-			panic(errorMessage)
+			panic(NewInternalError(err, "%s", errorMessage))
 		}
 
 		if parsedActions, ok := parsedResult["Actions"].([]interface{}); ok {
@@ -1973,7 +2004,7 @@ func SynthesizeSlashCommand(slashCmd, targetCmd, finalizeResult string) (result
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to marshal final message: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	result = string(resultStream)
@@ -2042,13 +2073,13 @@ func GenerateActionsSubWorkflowPrompt(userInstruction string) (systemPrompt stri
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load system prompt template from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 	userPromptTemplate, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_SUBWORKFLOW_IDENTIFICATION_USER_PROMPT"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load user prompt template from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Format the prompts
@@ -2087,13 +2118,13 @@ func GenerateHelperSubWorkflowPrompt(userInstruction string) (systemPrompt strin
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load system prompt template from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 	userPromptTemplate, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["APP_SUBWORKFLOW_IDENTIFICATION_USER_PROMPT"]
 	if !exists {
 		errorMessage := fmt.Sprintf("failed to load user prompt template from the configuration")
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(nil, "%s", errorMessage))
 	}
 
 	// Format the prompts
@@ -2306,7 +2337,7 @@ func FinalizeMessage(message string) (result string) {
 	if err != nil {
 		errorMessage := fmt.Sprintf("failed to convert actions to json: %v", err)
 		logging.Log.Error(ctx, errorMessage)
-		panic(errorMessage)
+		panic(NewInternalError(err, "%s", errorMessage))
 	}
 
 	result = string(bytesStream)
@@ -2340,6 +2371,7 @@ func SelectedSolution(selectedSolution string) (solution string) {
 //   - @displayName: PerformSimilaritySearchForSubqueries
 //
 // Parameters:
+//   - ctx: the request context
 //   - subQueries: the list of expanded sub-queries
 //   - collection: the vector database collection name
 //   - similaritySearchResults: the number of similarity search results
@@ -2347,22 +2379,22 @@ func SelectedSolution(selectedSolution string) (solution string) {
 //
 // Returns:
 //   - uniqueQAPairs: the unique Q&A pairs from similarity search results
-func PerformSimilaritySearchForSubqueries(subQueries []string, collection string, similaritySearchResults int, similaritySearchMinScore float64) (uniqueQAPairs []map[string]interface{}) {
-	ctx := &logging.ContextMap{}
+func PerformSimilaritySearchForSubqueries(ctx context.Context, subQueries []string, collection string, similaritySearchResults int, similaritySearchMinScore float64) (uniqueQAPairs []map[string]interface{}) {
+	logCtx := &logging.ContextMap{}
 	uniqueQAPairs = []map[string]interface{}{}
 	uniqueQuestions := make(map[string]bool)
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logging.Log.Error(ctx, fmt.Sprintf("unable to create qdrant client: %v", err))
+		logging.Log.Error(logCtx, fmt.Sprintf("unable to create qdrant client: %v", err))
 		return
 	}
 
 	for _, subQuery := range subQueries {
-		logging.Log.Debugf(ctx, "Processing sub-query: %s", subQuery)
-		embeddedVector, _ := PerformVectorEmbeddingRequest(subQuery, false)
+		logging.Log.Debugf(logCtx, "Processing sub-query: %s", subQuery)
+		embeddedVector, _ := PerformVectorEmbeddingRequest(ctx, subQuery, false)
 		if len(embeddedVector) == 0 {
-			logging.Log.Warnf(ctx, "Failed to get embedding for sub-query: %s", subQuery)
+			logging.Log.Warnf(logCtx, "Failed to get embedding for sub-query: %s", subQuery)
 			continue
 		}
 
@@ -2377,16 +2409,16 @@ func PerformSimilaritySearchForSubqueries(subQueries []string, collection string
 			WithPayload:    qdrant.NewWithPayloadEnable(true),
 		}
 
-		scoredPoints, err := client.Query(context.TODO(), &query)
+		scoredPoints, err := client.Query(ctx, &query)
 		if err != nil {
-			logging.Log.Warnf(ctx, "Qdrant query failed: %v", err)
+			logging.Log.Warnf(logCtx, "Qdrant query failed: %v", err)
 			continue
 		}
 
 		for _, scoredPoint := range scoredPoints {
 			payload, err := qdrant_utils.QdrantPayloadToType[map[string]interface{}](scoredPoint.GetPayload())
 			if err != nil {
-				logging.Log.Warnf(ctx, "Failed to parse payload: %v", err)
+				logging.Log.Warnf(logCtx, "Failed to parse payload: %v", err)
 				continue
 			}
 			question, _ := payload["question"].(string)
@@ -2405,9 +2437,9 @@ func PerformSimilaritySearchForSubqueries(subQueries []string, collection string
 		}
 	}
 	for i, qa := range uniqueQAPairs {
-		logging.Log.Debugf(ctx, "Unique QA Pair #%d: Question: %s, Answer: %s", i+1, qa["question"], qa["answer"])
+		logging.Log.Debugf(logCtx, "Unique QA Pair #%d: Question: %s, Answer: %s", i+1, qa["question"], qa["answer"])
 	}
-	logging.Log.Infof(ctx, "Simple similarity search complete. Found %d unique Q&A pairs from %d sub-queries", len(uniqueQAPairs), len(subQueries))
+	logging.Log.Infof(logCtx, "Simple similarity search complete. Found %d unique Q&A pairs from %d sub-queries", len(uniqueQAPairs), len(subQueries))
 	return uniqueQAPairs
 }
 
@@ -2420,7 +2452,7 @@ func PerformSimilaritySearchForSubqueries(subQueries []string, collection string
 //   - response: the JSON response string
 //
 // Returns:
-//   - tags: the list of items extracted from the response
+//   - generatedList: the list of items extracted from the response
 func ProcessJSONListOutput(response string) (generatedList []string) {
 	ctx := &logging.ContextMap{}
 
@@ -2443,44 +2475,53 @@ func ProcessJSONListOutput(response string) (generatedList []string) {
 //   - @displayName: GenerateMKSummariesforTags
 //
 // Parameters:
-//   - query: the user query
+//   - ctx: the request context.
 //   - dbName: the name of the database
 //   - tags: the list of tags
+//   - GetTagIdByNameQuery: the query getting the ID of a tag by its name
+//   - GetMKSummaryFromDBQuery: the query getting the MK summary of a tag ID
 //
 // Returns:
 //   - allTagsSummaries: the list of unique MK summaries
+func GenerateMKSummariesforTags(ctx context.Context, dbName string, tags []string, GetTagIdByNameQuery string, GetMKSummaryFromDBQuery string) (allTagsSummaries []string) {
+	logCtx := &logging.ContextMap{}
 
-func GenerateMKSummariesforTags(dbName string, tags []string, GetTagIdByNameQuery string, GetMKSummaryFromDBQuery string) (allTagsSummaries []string) {
-	ctx := &logging.ContextMap{}
+	checkGraphDbContext(ctx, "fetching MK summaries")
 
 	err := ampgraphdb.EstablishConnection(config.GlobalConfig.GRAPHDB_ADDRESS, dbName)
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
 	uniqueSummaries := make(map[string]bool)
 	for _, tag := range tags {
+		checkGraphDbContext(ctx, "fetching MK summaries")
+
 		// Inline GetTagIdByName
+		span := startGraphDbSpan(ctx, "GetTagIdByName", dbName, GetTagIdByNameQuery)
 		id, err := ampgraphdb.GraphDbDriver.GetTagIdByName(tag, GetTagIdByNameQuery)
+		telemetry.EndSpan(span, err)
 		if err != nil {
-			logging.Log.Warnf(ctx, "No tag_id found for tag %s (error: %v)", tag, err)
+			logging.Log.Warnf(logCtx, "No tag_id found for tag %s (error: %v)", tag, err)
 			continue
 		}
 		if id != "" {
-			logging.Log.Infof(ctx, "Found tag_id %s for tag %s", id, tag)
+			logging.Log.Infof(logCtx, "Found tag_id %s for tag %s", id, tag)
 			// Inline GetMKSummaryFromDB
+			span := startGraphDbSpan(ctx, "GetMKSummaryFromDB", dbName, GetMKSummaryFromDBQuery)
 			sum, err := ampgraphdb.GraphDbDriver.GetMKSummaryFromDB(id, GetMKSummaryFromDBQuery)
+			telemetry.EndSpan(span, err)
 			if err != nil {
-				logging.Log.Warnf(ctx, "Error getting MK summary for tag_id %s: %v", id, err)
+				logging.Log.Warnf(logCtx, "Error getting MK summary for tag_id %s: %v", id, err)
 				continue
 			}
 			if sum != "" {
 				uniqueSummaries[sum] = true
 			}
 		} else {
-			logging.Log.Warnf(ctx, "No tag_id found for tag %s", tag)
+			logging.Log.Warnf(logCtx, "No tag_id found for tag %s", tag)
 		}
 	}
 
@@ -2489,7 +2530,7 @@ func GenerateMKSummariesforTags(dbName string, tags []string, GetTagIdByNameQuer
 		allTagsSummaries = append(allTagsSummaries, summary)
 	}
 
-	logging.Log.Infof(ctx, "Metatag extraction complete. Tags: %v, Summaries found: %d", tags, len(allTagsSummaries))
+	logging.Log.Infof(logCtx, "Metatag extraction complete. Tags: %v, Summaries found: %d", tags, len(allTagsSummaries))
 	return allTagsSummaries
 }
 
@@ -26,7 +26,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -41,6 +40,7 @@ import (
 //   - @displayName: Verify API Key
 //
 // Parameters:
+//   - ctx: the request context.
 //   - apiKey: The API key to check.
 //   - mongoDbUrl: The URL of the MongoDB database.
 //   - mongoDatabaseName: The name of the MongoDB database.
@@ -48,21 +48,20 @@ import (
 //
 // Returns:
 //   - isAuthenticated: A boolean indicating whether the API key is authenticated.
-func CheckApiKeyAuthMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (isAuthenticated bool) {
+func CheckApiKeyAuthMongoDb(ctx context.Context, apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (isAuthenticated bool) {
 
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		panic(NewUpstreamUnavailableError(UpstreamMongoDB, err, "Error initializing mongoDb client: %v", err))
 	}
-	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if customer exists
-	exists, customer, err := mongoDbGetCustomerByApiKey(mongoDbContext, apiKey)
+	exists, customer, err := mongoDbGetCustomerByApiKey(ctx, mongoDbContext, apiKey)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error getting customer by API key: %v", err))
 	}
 	if !exists {
 		logging.Log.Warnf(&logging.ContextMap{}, "Authenticating failed: given API key not found in database")
@@ -84,34 +83,36 @@ func CheckApiKeyAuthMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName
 //   - @displayName: Check and Create User ID
 //
 // Parameters:
+//   - ctx: the request context.
 //   - userId: The user ID to check.
-//   - tokenLimitForNewUsers: The token limit for new users.
+//   - temporaryTokenLimit: The token limit for new users.
+//   - hoursUntilTokenLimitReset: The number of hours until the token limit of new users is reset.
+//   - modelId: The IDs of the models the new users can access.
 //   - mongoDbUrl: The URL of the MongoDB database.
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
 //
 // Returns:
 //   - existingUser: A boolean indicating whether the user ID already exists.
-func CheckCreateUserIdMongoDb(userId string, temporaryTokenLimit int, hoursUntilTokenLimitReset int, modelId []string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (existingUser bool) {
+func CheckCreateUserIdMongoDb(ctx context.Context, userId string, temporaryTokenLimit int, hoursUntilTokenLimitReset int, modelId []string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (existingUser bool) {
 	// check if userId is empty
 	if userId == "" {
 		logging.Log.Errorf(&logging.ContextMap{}, "User ID is empty")
-		panic(fmt.Errorf("user ID is empty"))
+		panic(NewInvalidInputError("userId", "user ID is empty"))
 	}
 
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		panic(NewUpstreamUnavailableError(UpstreamMongoDB, err, "Error initializing mongoDb client: %v", err))
 	}
-	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if customer for userid exists if not, create it
-	existingUser, _, err = mongoDbGetCreateCustomerByUserId(mongoDbContext, userId, temporaryTokenLimit, hoursUntilTokenLimitReset, modelId)
+	existingUser, _, err = mongoDbGetCreateCustomerByUserId(ctx, mongoDbContext, userId, temporaryTokenLimit, hoursUntilTokenLimitReset, modelId)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error getting or creating customer by userId: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error getting or creating customer by userId: %v", err))
 	}
 
 	return existingUser
@@ -123,6 +124,7 @@ func CheckCreateUserIdMongoDb(userId string, temporaryTokenLimit int, hoursUntil
 //   - @displayName: Update Total Token Count
 //
 // Parameters:
+//   - ctx: the request context.
 //   - apiKey: The API key of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
 //   - mongoDatabaseName: The name of the MongoDB database.
@@ -131,28 +133,31 @@ func CheckCreateUserIdMongoDb(userId string, temporaryTokenLimit int, hoursUntil
 //
 // Returns:
 //   - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.
-func UpdateTotalTokenCountForCustomerMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalTokenCount int) (tokenLimitReached bool) {
+func UpdateTotalTokenCountForCustomerMongoDb(ctx context.Context, apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalTokenCount int) (tokenLimitReached bool) {
 
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		panic(NewUpstreamUnavailableError(UpstreamMongoDB, err, "Error initializing mongoDb client: %v", err))
 	}
-	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// update token count
-	err = mongoDbAddToTotalTokenCount(mongoDbContext, "api_key", apiKey, additionalTokenCount)
+	err = mongoDbAddToTotalTokenCount(ctx, mongoDbContext, "api_key", apiKey, additionalTokenCount)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error updating total token count for customer: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error updating total token count for customer: %v", err))
 	}
 
 	// check if customer is over the limit
-	exists, customer, err := mongoDbGetCustomerByApiKey(mongoDbContext, apiKey)
-	if err != nil || !exists {
+	exists, customer, err := mongoDbGetCustomerByApiKey(ctx, mongoDbContext, apiKey)
+	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error getting customer by API key: %v", err))
+	}
+	if !exists {
+		logging.Log.Errorf(&logging.ContextMap{}, "Customer not found for API key")
+		panic(NewNotFoundError(UpstreamMongoDB, "customer not found for API key"))
 	}
 	if customer.TotalTokenCount >= customer.TokenLimit {
 		return true
@@ -167,28 +172,33 @@ func UpdateTotalTokenCountForCustomerMongoDb(apiKey string, mongoDbUrl string, m
 //   - @displayName: Update Total Token Count by User ID
 //
 // Parameters:
+//   - ctx: the request context.
+//   - userId: The user ID of the customer.
+//   - mongoDbUrl: The URL of the MongoDB database.
 //   - userId: The user ID of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
 //   - mongoDatabaseName: The name of the MongoDB database.
 //   - mongoDbCollectionName: The name of the MongoDB collection.
-//   - additionalTokenCount: The number of additional tokens to add to the total token count.
+//   - additionalInputTokenCount: The number of input tokens to add to the token count.
+//   - additionalOutputTokenCount: The number of output tokens to add to the token count.
+//   - hoursUntilTokenLimitReset: The number of hours until the token limit is reset.
+//   - modelId: The IDs of the models the tokens were used for.
 //
 // Returns:
 //   - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.
-func UpdateTotalTokenCountForUserIdMongoDb(userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalInputTokenCount int, additionalOutputTokenCount int, hoursUntilTokenLimitReset int, modelId []string) (tokenLimitReached bool) {
+func UpdateTotalTokenCountForUserIdMongoDb(ctx context.Context, userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string, additionalInputTokenCount int, additionalOutputTokenCount int, hoursUntilTokenLimitReset int, modelId []string) (tokenLimitReached bool) {
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		panic(NewUpstreamUnavailableError(UpstreamMongoDB, err, "Error initializing mongoDb client: %v", err))
 	}
-	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// update token count
-	tokenLimitReached, err = mongoDbAddToInputOutputTokenCountAndCheckLimit(mongoDbContext, userId, additionalInputTokenCount, additionalOutputTokenCount, hoursUntilTokenLimitReset, modelId)
+	tokenLimitReached, err = mongoDbAddToInputOutputTokenCountAndCheckLimit(ctx, mongoDbContext, userId, additionalInputTokenCount, additionalOutputTokenCount, hoursUntilTokenLimitReset, modelId)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error updating total token count for customer: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error updating total token count for customer: %v", err))
 	}
 
 	return tokenLimitReached
@@ -200,6 +210,7 @@ func UpdateTotalTokenCountForUserIdMongoDb(userId string, mongoDbUrl string, mon
 //   - @displayName: Deny Customer Access
 //
 // Parameters:
+//   - ctx: the request context.
 //   - apiKey: The API key of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
 //   - mongoDatabaseName: The name of the MongoDB database.
@@ -208,30 +219,33 @@ func UpdateTotalTokenCountForUserIdMongoDb(userId string, mongoDbUrl string, mon
 // Returns:
 //   - customerName: The name of the customer.
 //   - sendWarning: A boolean indicating whether a warning should be sent to the customer.
-func DenyCustomerAccessAndSendWarningMongoDb(apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (customerName string, sendWarning bool) {
+func DenyCustomerAccessAndSendWarningMongoDb(ctx context.Context, apiKey string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (customerName string, sendWarning bool) {
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		panic(NewUpstreamUnavailableError(UpstreamMongoDB, err, "Error initializing mongoDb client: %v", err))
 	}
-	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if warning for customer needs to be sent
-	exists, customer, err := mongoDbGetCustomerByApiKey(mongoDbContext, apiKey)
-	if err != nil || !exists {
+	exists, customer, err := mongoDbGetCustomerByApiKey(ctx, mongoDbContext, apiKey)
+	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error getting customer by API key: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error getting customer by API key: %v", err))
+	}
+	if !exists {
+		logging.Log.Errorf(&logging.ContextMap{}, "Customer not found for API key")
+		panic(NewNotFoundError(UpstreamMongoDB, "customer not found for API key"))
 	}
 	if !customer.WarningSent {
 		sendWarning = true
 	}
 
 	// deny customer access and set warning sent
-	err = mongoDbUpdateAccessAndWarning(mongoDbContext, "api_key", apiKey)
+	err = mongoDbUpdateAccessAndWarning(ctx, mongoDbContext, "api_key", apiKey)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error updating access and warning for customer: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error updating access and warning for customer: %v", err))
 	}
 
 	return customer.CustomerName, sendWarning
@@ -243,6 +257,7 @@ func DenyCustomerAccessAndSendWarningMongoDb(apiKey string, mongoDbUrl string, m
 //   - @displayName: Deny Customer Access by User ID
 //
 // Parameters:
+//   - ctx: the request context.
 //   - userId: The user ID of the customer.
 //   - mongoDbUrl: The URL of the MongoDB database.
 //   - mongoDatabaseName: The name of the MongoDB database.
@@ -250,32 +265,31 @@ func DenyCustomerAccessAndSendWarningMongoDb(apiKey string, mongoDbUrl string, m
 //
 // Returns:
 //   - sendWarning: A boolean indicating whether a warning should be sent to the customer.
-func DenyCustomerAccessAndSendWarningMongoDbUserId(userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (sendWarning bool) {
+func DenyCustomerAccessAndSendWarningMongoDbUserId(ctx context.Context, userId string, mongoDbUrl string, mongoDatabaseName string, mongoDbCollectionName string) (sendWarning bool) {
 	// create mongoDb context
-	mongoDbContext, err := mongoDbInitializeClient(mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
+	mongoDbContext, err := mongoDbInitializeClient(ctx, mongoDbUrl, mongoDatabaseName, mongoDbCollectionName)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error initializing mongoDb client: %v", err)
-		panic(err)
+		panic(NewUpstreamUnavailableError(UpstreamMongoDB, err, "Error initializing mongoDb client: %v", err))
 	}
-	defer mongoDbContext.Client.Disconnect(context.Background())
 
 	// check if warning for customer needs to be sent
 	customer := &MongoDbCustomerObjectDisco{}
 	filter := bson.M{"user_id": userId}
-	err = mongoDbContext.Collection.FindOne(context.Background(), filter).Decode(&customer)
+	err = mongoDbContext.Collection.FindOne(ctx, filter).Decode(&customer)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error finding customer by user ID: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error finding customer by user ID: %v", err))
 	}
 	if !customer.WarningSent {
 		sendWarning = true
 	}
 
 	// deny customer access and set warning sent
-	err = mongoDbUpdateAccessAndWarning(mongoDbContext, "user_id", userId)
+	err = mongoDbUpdateAccessAndWarning(ctx, mongoDbContext, "user_id", userId)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error updating access and warning for customer: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "Error updating access and warning for customer: %v", err))
 	}
 
 	return sendWarning
@@ -287,11 +301,12 @@ func DenyCustomerAccessAndSendWarningMongoDbUserId(userId string, mongoDbUrl str
 //   - @displayName: Send Email Notification
 //
 // Parameters:
-//   - endpoint: The email service endpoint.
+//   - ctx: the request context.
+//   - logicAppEndpoint: The email service endpoint.
 //   - email: The email address.
 //   - subject: The email subject.
 //   - content: The email content.
-func SendLogicAppNotificationEmail(logicAppEndpoint string, email string, subject string, content string) {
+func SendLogicAppNotificationEmail(ctx context.Context, logicAppEndpoint string, email string, subject string, content string) {
 	// Create the request body
 	requestBody := EmailRequest{
 		Email:   email,
@@ -303,7 +318,7 @@ func SendLogicAppNotificationEmail(logicAppEndpoint string, email string, subjec
 	jsonData, err := json.Marshal(requestBody)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error marshaling JSON: %v", err)
-		panic(fmt.Errorf("error marshaling JSON: %v", err))
+		panic(NewInternalError(err, "error marshaling JSON: %v", err))
 	}
 
 	// Create a new HTTP client with timeout
@@ -312,10 +327,10 @@ func SendLogicAppNotificationEmail(logicAppEndpoint string, email string, subjec
 	}
 
 	// Create the POST request
-	req, err := http.NewRequest("POST", logicAppEndpoint, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", logicAppEndpoint, bytes.NewBuffer(jsonData))
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error creating request: %v", err)
-		panic(fmt.Errorf("error creating request: %v", err))
+		panic(NewInternalError(err, "error creating request: %v", err))
 	}
 
 	// Set headers
@@ -325,14 +340,14 @@ func SendLogicAppNotificationEmail(logicAppEndpoint string, email string, subjec
 	resp, err := client.Do(req)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error sending request: %v", err)
-		panic(fmt.Errorf("error sending request: %v", err))
+		panic(NewUpstreamUnavailableError(UpstreamHTTP, err, "error sending request: %v", err))
 	}
 	defer resp.Body.Close()
 
 	// Check response status
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		logging.Log.Errorf(&logging.ContextMap{}, "Unexpected status code: %d", resp.StatusCode)
-		panic(fmt.Errorf("unexpected status code: %d", resp.StatusCode))
+		panic(NewUpstreamHTTPError(UpstreamHTTP, resp.StatusCode, "unexpected status code: %d", resp.StatusCode))
 	}
 }
 
@@ -32,7 +32,7 @@ package externalfunctions
 //   - @displayName: Cast Any to String
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - string
@@ -48,7 +48,7 @@ func CastAnyToString(data any) string {
 //   - @displayName: Cast Any to Bool
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - bool
@@ -64,7 +64,7 @@ func CastAnyToBool(data any) bool {
 //   - @displayName: Cast Any to Int8
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - int8
@@ -80,7 +80,7 @@ func CastAnyToInt8(data any) int8 {
 //   - @displayName: Cast Any to Int16
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - int16
@@ -96,7 +96,7 @@ func CastAnyToInt16(data any) int16 {
 //   - @displayName: Cast Any to Int32
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - int32
@@ -112,7 +112,7 @@ func CastAnyToInt32(data any) int32 {
 //   - @displayName: Cast Any to Int64
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - int64
@@ -128,7 +128,7 @@ func CastAnyToInt64(data any) int64 {
 //   - @displayName: Cast Any to Int
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - int
@@ -144,7 +144,7 @@ func CastAnyToInt(data any) int {
 //   - @displayName: Cast Any to Uint8
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - uint8
@@ -160,7 +160,7 @@ func CastAnyToUint8(data any) uint8 {
 //   - @displayName: Cast Any to Uint16
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - uint16
@@ -176,7 +176,7 @@ func CastAnyToUint16(data any) uint16 {
 //   - @displayName: Cast Any to Uint32
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - uint32
@@ -192,7 +192,7 @@ func CastAnyToUint32(data any) uint32 {
 //   - @displayName: Cast Any to Uint64
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - uint64
@@ -208,7 +208,7 @@ func CastAnyToUint64(data any) uint64 {
 //   - @displayName: Cast Any to Uint
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - uint
@@ -224,7 +224,7 @@ func CastAnyToUint(data any) uint {
 //   - @displayName: Cast Any to Float32
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - float32
@@ -240,7 +240,7 @@ func CastAnyToFloat32(data any) float32 {
 //   - @displayName: Cast Any to Float64
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - float64
@@ -256,7 +256,7 @@ func CastAnyToFloat64(data any) float64 {
 //   - @displayName: Cast Any to Complex64
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - complex64
@@ -272,7 +272,7 @@ func CastAnyToComplex64(data any) complex64 {
 //   - @displayName: Cast Any to Complex128
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - complex128
@@ -288,7 +288,7 @@ func CastAnyToComplex128(data any) complex128 {
 //   - @displayName: Cast Any to Byte
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - byte
@@ -304,7 +304,7 @@ func CastAnyToByte(data any) byte {
 //   - @displayName: Cast Any to Rune
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - rune
@@ -318,7 +318,7 @@ func CastAnyToRune(data any) rune {
 //   - @displayName: Cast []map[string]any to any
 //
 // Parameters:
-//   - data ([]map[string]any)
+//   - data: the []map[string]any value to cast.
 //
 // Returns
 //   - any
@@ -332,7 +332,7 @@ func CastArrayMapStringAnyToAny(data []map[string]any) any {
 //   - @displayName: Cast any to interface{}
 //
 // Parameters:
-//   - data (any)
+//   - data: the any value to cast.
 //
 // Returns
 //   - interface {}
@@ -346,7 +346,7 @@ func CastAnyToInterface(data any) interface{} {
 //   - @displayName: Cast interface{} to any
 //
 // Parameters:
-//   - data (interface {})
+//   - data: the interface {} value to cast.
 //
 // Returns
 //   - any
@@ -360,7 +360,7 @@ func CastInterfaceToAny(data interface{}) any {
 //   - @displayName: Cast String to Any
 //
 // Parameters:
-//   - data (string)
+//   - data: the string value to cast.
 //
 // Returns
 //   - any
@@ -374,7 +374,7 @@ func CastStringToAny(data string) any {
 //   - @displayName: Cast Bool to Any
 //
 // Parameters:
-//   - data (bool)
+//   - data: the bool value to cast.
 //
 // Returns
 //   - any
@@ -388,7 +388,7 @@ func CastBoolToAny(data bool) any {
 //   - @displayName: Cast Int8 to Any
 //
 // Parameters:
-//   - data (int8)
+//   - data: the int8 value to cast.
 //
 // Returns
 //   - any
@@ -402,7 +402,7 @@ func CastInt8ToAny(data int8) any {
 //   - @displayName: Cast Int16 to Any
 //
 // Parameters:
-//   - data (int16)
+//   - data: the int16 value to cast.
 //
 // Returns
 //   - any
@@ -416,7 +416,7 @@ func CastInt16ToAny(data int16) any {
 //   - @displayName: Cast Int32 to Any
 //
 // Parameters:
-//   - data (int32)
+//   - data: the int32 value to cast.
 //
 // Returns
 //   - any
@@ -430,7 +430,7 @@ func CastInt32ToAny(data int32) any {
 //   - @displayName: Cast Int64 to Any
 //
 // Parameters:
-//   - data (int64)
+//   - data: the int64 value to cast.
 //
 // Returns
 //   - any
@@ -444,7 +444,7 @@ func CastInt64ToAny(data int64) any {
 //   - @displayName: Cast Int to Any
 //
 // Parameters:
-//   - data (int)
+//   - data: the int value to cast.
 //
 // Returns
 //   - any
@@ -458,7 +458,7 @@ func CastIntToAny(data int) any {
 //   - @displayName: Cast Uint8 to Any
 //
 // Parameters:
-//   - data (uint8)
+//   - data: the uint8 value to cast.
 //
 // Returns
 //   - any
@@ -472,7 +472,7 @@ func CastUint8ToAny(data uint8) any {
 //   - @displayName: Cast Uint16 to Any
 //
 // Parameters:
-//   - data (uint16)
+//   - data: the uint16 value to cast.
 //
 // Returns
 //   - any
@@ -486,7 +486,7 @@ func CastUint16ToAny(data uint16) any {
 //   - @displayName: Cast Uint32 to Any
 //
 // Parameters:
-//   - data (uint32)
+//   - data: the uint32 value to cast.
 //
 // Returns
 //   - any
@@ -500,7 +500,7 @@ func CastUint32ToAny(data uint32) any {
 //   - @displayName: Cast Uint64 to Any
 //
 // Parameters:
-//   - data (uint64)
+//   - data: the uint64 value to cast.
 //
 // Returns
 //   - any
@@ -514,7 +514,7 @@ func CastUint64ToAny(data uint64) any {
 //   - @displayName: Cast Uint to Any
 //
 // Parameters:
-//   - data (uint)
+//   - data: the uint value to cast.
 //
 // Returns
 //   - any
@@ -528,7 +528,7 @@ func CastUintToAny(data uint) any {
 //   - @displayName: Cast Float32 to Any
 //
 // Parameters:
-//   - data (float32)
+//   - data: the float32 value to cast.
 //
 // Returns
 //   - any
@@ -542,7 +542,7 @@ func CastFloat32ToAny(data float32) any {
 //   - @displayName: Cast Float64 to Any
 //
 // Parameters:
-//   - data (float64)
+//   - data: the float64 value to cast.
 //
 // Returns
 //   - any
@@ -556,7 +556,7 @@ func CastFloat64ToAny(data float64) any {
 //   - @displayName: Cast Complex64 to Any
 //
 // Parameters:
-//   - data (complex64)
+//   - data: the complex64 value to cast.
 //
 // Returns
 //   - any
@@ -570,7 +570,7 @@ func CastComplex64ToAny(data complex64) any {
 //   - @displayName: Cast Complex128 to Any
 //
 // Parameters:
-//   - data (complex128)
+//   - data: the complex128 value to cast.
 //
 // Returns
 //   - any
@@ -584,7 +584,7 @@ func CastComplex128ToAny(data complex128) any {
 //   - @displayName: Cast Byte to Any
 //
 // Parameters:
-//   - data (byte)
+//   - data: the byte value to cast.
 //
 // Returns
 //   - any
@@ -598,7 +598,7 @@ func CastByteToAny(data byte) any {
 //   - @displayName: Cast Rune to Any
 //
 // Parameters:
-//   - data (rune)
+//   - data: the rune value to cast.
 //
 // Returns
 //   - any
@@ -38,6 +38,7 @@ import (
 	"github.com/qdrant/go-client/qdrant"
 
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
@@ -54,6 +55,7 @@ import (
 //   - @displayName: List Github Files
 //
 // Parameters:
+//   - ctx: the request context.
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
@@ -64,7 +66,7 @@ import (
 //
 // Returns:
 //   - githubFilesToExtract: github files to extract.
-func GetGithubFilesToExtract(githubRepoName string, githubRepoOwner string,
+func GetGithubFilesToExtract(ctx context.Context, githubRepoName string, githubRepoOwner string,
 	githubRepoBranch string, githubAccessToken string, githubFileExtensions []string,
 	githubFilteredDirectories []string, githubExcludedDirectories []string) (githubFilesToExtract []string) {
 	// If github repo name is empty, return empty list.
@@ -72,14 +74,14 @@ func GetGithubFilesToExtract(githubRepoName string, githubRepoOwner string,
 		return githubFilesToExtract
 	}
 
-	client, ctx := dataExtractNewGithubClient(githubAccessToken)
+	client := dataExtractNewGithubClient(ctx, githubAccessToken)
 
 	// Retrieve the specified branch SHA (commit hash) from the GitHub repository. This is used to identify the latest state of the branch.
 	branch, _, err := client.Repositories.GetBranch(ctx, githubRepoOwner, githubRepoName, githubRepoBranch, 1)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting branch %s: %v", githubRepoBranch, err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamHTTP, err, "%s", errMessage))
 	}
 
 	// Extract the SHA from the branch information.
@@ -90,7 +92,7 @@ func GetGithubFilesToExtract(githubRepoName string, githubRepoOwner string,
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting tree: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamHTTP, err, "%s", errMessage))
 	}
 
 	// Extract the files that need to be extracted from the tree.
@@ -128,7 +130,7 @@ func GetLocalFilesToExtract(localPath string, localFileExtensions []string,
 	if _, err := os.Stat(localPath); os.IsNotExist(err) {
 		errMessage := fmt.Sprintf("Local path does not exist: %s", localPath)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInvalidInputError("localPath", "%s", errMessage))
 	}
 
 	localFiles := &[]string{}
@@ -144,7 +146,7 @@ func GetLocalFilesToExtract(localPath string, localFileExtensions []string,
 	if err != nil {
 		errMessage := fmt.Sprintf("Error walking through the files: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInternalError(err, "%s", errMessage))
 	}
 
 	// Log the files that need to be extracted.
@@ -161,7 +163,11 @@ func GetLocalFilesToExtract(localPath string, localFileExtensions []string,
 //   - @displayName: Append String Slices
 //
 // Parameters:
-//   - slice1, slice2, slice3, slice4, slice5: slices to append.
+//   - slice1: the first slice to append.
+//   - slice2: the second slice to append.
+//   - slice3: the third slice to append.
+//   - slice4: the fourth slice to append.
+//   - slice5: the fifth slice to append.
 //
 // Returns:
 //   - result: a new slice with all elements appended.
@@ -184,6 +190,7 @@ func AppendStringSlices(slice1, slice2, slice3, slice4, slice5 []string) []strin
 //   - @displayName: Download Github File Content
 //
 // Parameters:
+//   - ctx: the request context.
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
@@ -193,14 +200,14 @@ func AppendStringSlices(slice1, slice2, slice3, slice4, slice5 []string) []strin
 // Returns:
 //   - checksum: checksum of file.
 //   - content: content of file.
-func DownloadGithubFileContent(githubRepoName string, githubRepoOwner string,
+func DownloadGithubFileContent(ctx context.Context, githubRepoName string, githubRepoOwner string,
 	githubRepoBranch string, gihubFilePath string, githubAccessToken string) (checksum string, content []byte) {
 
-	checksum, content, err := downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
+	checksum, content, err := downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamHTTP, err, "%s", errMessage))
 	}
 
 	return checksum, content
@@ -212,27 +219,31 @@ func DownloadGithubFileContent(githubRepoName string, githubRepoOwner string,
 //   - @displayName: Download Github Files Content
 //
 // Parameters:
+//   - ctx: the request context.
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
-//   - gihubFilePath: path to file in the github repository.
+//   - gihubFilePaths: paths to the files in the github repository.
 //   - githubAccessToken: access token for github.
 //
 // Returns:
 //   - filesMap: map of file paths to file content.
-func DownloadGithubFilesContent(githubRepoName string, githubRepoOwner string,
+func DownloadGithubFilesContent(ctx context.Context, githubRepoName string, githubRepoOwner string,
 	githubRepoBranch string, gihubFilePaths []string, githubAccessToken string) (filesMap map[string][]byte) {
 	filesMap = make(map[string][]byte)
 
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("download", len(gihubFilePaths))
 	for _, gihubFilePath := range gihubFilePaths {
-		_, content, err := downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
+		_, content, err := downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, gihubFilePath, githubAccessToken)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewUpstreamError(UpstreamHTTP, err, "%s", errMessage))
 		}
 
 		filesMap[gihubFilePath] = content
+		progress.Add(1)
 	}
 
 	return filesMap
@@ -255,7 +266,7 @@ func GetLocalFileContent(localFilePath string) (checksum string, content []byte)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting file content from local: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInternalError(err, "%s", errMessage))
 	}
 
 	return checksum, content
@@ -279,7 +290,7 @@ func GetLocalFilesContent(localFilePaths []string) (filesMap map[string][]byte)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error getting file content from local: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		filesMap[localFilePath] = content
@@ -312,14 +323,15 @@ func GetDocumentType(filePath string) (documentType string) {
 //   - @displayName: Split Content
 //
 // Parameters:
-//   - content: content to split.
+//   - ctx: the request context.
+//   - bytesContent: content to split.
 //   - documentType: type of document.
 //   - chunkSize: size of the chunks.
 //   - chunkOverlap: overlap of the chunks.
 //
 // Returns:
 //   - output: chunks as an slice of strings.
-func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int, chunkOverlap int) (output []string) {
+func LangchainSplitter(ctx context.Context, bytesContent []byte, documentType string, chunkSize int, chunkOverlap int) (output []string) {
 	output = []string{}
 	var splittedChunks []schema.Document
 	var err error
@@ -337,11 +349,11 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 	switch documentType {
 	case "html":
 		htmlLoader := documentloaders.NewHTML(reader)
-		splittedChunks, err = htmlLoader.LoadAndSplit(context.Background(), splitter)
+		splittedChunks, err = htmlLoader.LoadAndSplit(ctx, splitter)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		for _, chunk := range splittedChunks {
@@ -349,37 +361,37 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 		}
 
 	case "py", "ipynb":
-		output, err = dataExtractionPerformSplitterRequest(bytesContent, "py", chunkSize, chunkOverlap)
+		output, err = dataExtractionPerformSplitterRequest(ctx, bytesContent, "py", chunkSize, chunkOverlap)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error splitting python document: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 	case "pdf":
-		output, err = dataExtractionPerformSplitterRequest(bytesContent, "pdf", chunkSize, chunkOverlap)
+		output, err = dataExtractionPerformSplitterRequest(ctx, bytesContent, "pdf", chunkSize, chunkOverlap)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error splitting pdf document: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 	case "pptx", "ppt":
-		output, err = dataExtractionPerformSplitterRequest(bytesContent, "ppt", chunkSize, chunkOverlap)
+		output, err = dataExtractionPerformSplitterRequest(ctx, bytesContent, "ppt", chunkSize, chunkOverlap)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error splitting ppt document: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 	default:
 		// Default document type is text.
 		txtLoader := documentloaders.NewText(reader)
-		splittedChunks, err = txtLoader.LoadAndSplit(context.Background(), splitter)
+		splittedChunks, err = txtLoader.LoadAndSplit(ctx, splitter)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		for _, chunk := range splittedChunks {
@@ -399,6 +411,7 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 //   - @displayName: Document Tree
 //
 // Parameters:
+//   - ctx: the request context.
 //   - documentName: name of the document.
 //   - documentId: id of the document.
 //   - documentChunks: chunks of the document.
@@ -410,8 +423,8 @@ func LangchainSplitter(bytesContent []byte, documentType string, chunkSize int,
 //   - numLlmWorkers: number of llm workers.
 //
 // Returns:
-//   - documentData: tree structure of the document.
-func GenerateDocumentTree(documentName string, documentId string, documentChunks []string,
+//   - returnedDocumentData: tree structure of the document.
+func GenerateDocumentTree(ctx context.Context, documentName string, documentId string, documentChunks []string,
 	embeddingsDimensions int, getSummary bool, getKeywords bool, numKeywords int, chunkSize int, numLlmWorkers int) (returnedDocumentData []sharedtypes.DbData) {
 
 	logging.Log.Debugf(&logging.ContextMap{}, "Processing document: %s with %v leaf chunks \n", documentName, len(documentChunks))
@@ -424,7 +437,7 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 	// Start LLM Handler workers.
 	for i := 0; i < numLlmWorkers; i++ {
 		llmHandlerWaitGroup.Add(1)
-		go dataExtractionLLMHandlerWorker(&llmHandlerWaitGroup, llmHandlerInputChannel, errorChannel, embeddingsDimensions)
+		go dataExtractionLLMHandlerWorker(ctx, &llmHandlerWaitGroup, llmHandlerInputChannel, errorChannel, embeddingsDimensions)
 	}
 
 	// Create root data object.
@@ -448,9 +461,11 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 	documentData := []*sharedtypes.DbData{rootData}
 
 	// Create child data objects.
-	orderedChildDataObjects, err := dataExtractionDocumentLevelHandler(llmHandlerInputChannel, errorChannel, documentChunks, documentId, documentName, getSummary, getKeywords, uint32(numKeywords))
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("leaves", 0)
+	orderedChildDataObjects, err := dataExtractionDocumentLevelHandler(ctx, llmHandlerInputChannel, errorChannel, documentChunks, documentId, documentName, getSummary, getKeywords, uint32(numKeywords))
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
 
 	// If summary is disabled -> flat structure, only iterate over chunks.
@@ -478,7 +493,7 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 			documentData = append(documentData, childData)
 		}
 
-		for {
+		for level := 1; ; level++ {
 			// Concatenate all summaries.
 			branches := []*DataExtractionBranch{}
 			branch := &DataExtractionBranch{
@@ -512,9 +527,10 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 				textChunks = append(textChunks, branch.Text)
 			}
 
-			orderedChildDataObjectsFromBranches, err := dataExtractionDocumentLevelHandler(llmHandlerInputChannel, errorChannel, textChunks, documentId, documentName, getSummary, getKeywords, uint32(numKeywords))
+			progress.StartPhase(fmt.Sprintf("summaries level %d", level), 0)
+			orderedChildDataObjectsFromBranches, err := dataExtractionDocumentLevelHandler(ctx, llmHandlerInputChannel, errorChannel, textChunks, documentId, documentName, getSummary, getKeywords, uint32(numKeywords))
 			if err != nil {
-				panic(err.Error())
+				panic(err)
 			}
 
 			// Exit if only one -> assign details to root.
@@ -573,11 +589,11 @@ func GenerateDocumentTree(documentName string, documentId string, documentChunks
 
 	// Send batch embedding request to LLM handler. Set max batch size to 1000.
 	maxBatchSize := 100
-	err = dataExtractionProcessBatchEmbeddings(documentData, maxBatchSize)
+	err = dataExtractionProcessBatchEmbeddings(ctx, documentData, maxBatchSize)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error in dataExtractionProcessBatchEmbeddings: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInternalError(err, "%s", errMessage))
 	}
 
 	logging.Log.Debugf(&logging.ContextMap{}, "Finished processing document: %s \n", documentName)
@@ -621,7 +637,7 @@ func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elemen
 		if err != nil {
 			errMessage := fmt.Sprintf("Error unmarshalling object definition document: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInvalidInputError("content", "%s", errMessage))
 		}
 	case ".json":
 		// Unmarshal the JSON content into a list of assembly members.
@@ -629,13 +645,13 @@ func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elemen
 		if err != nil {
 			errMessage := fmt.Sprintf("Error unmarshalling object definition document: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInvalidInputError("content", "%s", errMessage))
 		}
 
 	default:
 		errMessage := fmt.Sprintf("Unknown file extension: %s", fileExtension)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInvalidInputError("elementsFilePath", "%s", errMessage))
 	}
 
 	for _, objectDefinition := range objectDefinitionDoc.Members {
@@ -670,7 +686,7 @@ func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elemen
 		if err != nil {
 			errMessage := fmt.Sprintf("Error creating return element list: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		switch prefix {
@@ -731,7 +747,7 @@ func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elemen
 		default:
 			errMessage := fmt.Sprintf("Unknown prefix: %s", prefix)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInvalidInputError("content", "%s", errMessage))
 		}
 
 		// Get name pseudocode and formatted name.
@@ -739,7 +755,7 @@ func LoadCodeGenerationElements(content []byte, elementsFilePath string) (elemen
 		if err != nil {
 			errMessage := fmt.Sprintf("Error processing element name: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		elements = append(elements, element)
@@ -767,11 +783,12 @@ func mapToSparseVec(m map[uint]float32) *qdrant.Vector {
 //   - @displayName: Store Elements in Vector Database
 //
 // Parameters:
+//   - ctx: the request context.
 //   - elements: code generation elements.
 //   - elementsCollectionName: name of the collection.
 //   - batchSize: batch size for embeddings.
 //   - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)
-func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement, elementsCollectionName string, batchSize int, vectorDistance string) {
+func StoreElementsInVectorDatabase(ctx context.Context, elements []sharedtypes.CodeGenerationElement, elementsCollectionName string, batchSize int, vectorDistance string) {
 	// Set default batch size if not provided.
 	if batchSize <= 0 {
 		batchSize = 2
@@ -780,11 +797,11 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 	logging.Log.Debugf(&logging.ContextMap{}, "Storing %v code generation elements in the vector database", len(elements))
 
 	// Generate dense and sparse embeddings
-	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddings(elements, batchSize)
+	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddings(ctx, elements, batchSize)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error generating embeddings for elements: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInternalError(err, "%s", errMessage))
 	}
 
 	// if you have no embeddings, quit
@@ -822,11 +839,9 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 	if err != nil {
 		errMessage := fmt.Sprintf("Error creating qdrant client: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamUnavailableError(UpstreamQdrant, err, "%s", errMessage))
 	}
 
-	ctx := context.TODO()
-
 	// Create the collection.
 	err = qdrant_utils.CreateCollectionIfNotExists(
 		ctx,
@@ -843,10 +858,12 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 	if err != nil {
 		errMessage := fmt.Sprintf("Error creating the collection: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamQdrant, err, "%s", errMessage))
 	}
 
 	// insert into db
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("store", len(points))
 	_, err = client.Upsert(ctx, &qdrant.UpsertPoints{
 		CollectionName: elementsCollectionName,
 		Points:         points,
@@ -854,8 +871,9 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 	if err != nil {
 		errMessage := fmt.Sprintf("Error inserting data into the vector database: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamQdrant, err, "%s", errMessage))
 	}
+	progress.Add(len(points))
 
 	// create some indexes
 	// TODO: are these the right ones to create? anything that will be searched/filtered on should be indexed
@@ -876,7 +894,7 @@ func StoreElementsInVectorDatabase(elements []sharedtypes.CodeGenerationElement,
 		if err != nil {
 			errMessage := fmt.Sprintf("Error creating index on field %q: %v", index.FieldName, err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewUpstreamError(UpstreamQdrant, err, "%s", errMessage))
 		}
 	}
 }
@@ -896,40 +914,51 @@ func graphDbNameOrDefault(dbname string) string {
 //   - @displayName: Store Elements in Graph Database
 //
 // Parameters:
+//   - ctx: the request context.
 //   - dbname: the name of the graphdb to target. If not provided, defaults to "aali".
 //   - elements: code generation elements.
-func StoreElementsInGraphDatabase(dbname string, elements []sharedtypes.CodeGenerationElement) {
-	ctx := &logging.ContextMap{}
+func StoreElementsInGraphDatabase(ctx context.Context, dbname string, elements []sharedtypes.CodeGenerationElement) {
+	logCtx := &logging.ContextMap{}
 
 	dbname = graphDbNameOrDefault(dbname)
 
+	checkGraphDbContext(ctx, "storing elements")
+
 	// Initialize the graph database.
 	err := graphdb.Initialize(config.GlobalConfig.GRAPHDB_ADDRESS, dbname)
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb %q: %v", dbname, err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
+	span := startGraphDbSpan(ctx, "CreateSchema", dbname, "")
 	err = graphdb.GraphDbDriver.CreateSchema(dbname)
+	telemetry.EndSpan(span, err)
 	if err != nil {
-		logPanic(ctx, "error error creating aali schema: %v", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamGraphDB, err, "error error creating aali schema: %v", err))
 	}
 
 	// Add the elements to the graph database.
+	span = startGraphDbSpan(ctx, "AddCodeGenerationElementNodes", dbname, "")
 	err = graphdb.GraphDbDriver.AddCodeGenerationElementNodes(dbname, elements)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errMsg := fmt.Sprintf("error adding code gen element nodes to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
+	checkGraphDbContext(ctx, "adding code gen relationships")
+
 	// Add the dependencies to the graph database.
+	span = startGraphDbSpan(ctx, "CreateCodeGenerationRelationships", dbname, "")
 	err = graphdb.GraphDbDriver.CreateCodeGenerationRelationships(dbname, elements)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errMsg := fmt.Sprintf("error adding code gen relationships to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 }
 
@@ -959,7 +988,7 @@ func LoadAndCheckExampleDependencies(
 	if err != nil {
 		errMessage := fmt.Sprintf("Error unmarshalling dependencies: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInvalidInputError("dependenciesContent", "%s", errMessage))
 	}
 
 	// Initialize maps.
@@ -1078,6 +1107,7 @@ func LoadAndCheckExampleDependencies(
 //   - @displayName: Load Code Generation Examples
 //
 // Parameters:
+//   - ctx: the request context.
 //   - source: source of the examples (local or github).
 //   - examplesToExtract: paths to the examples.
 //   - githubRepoName: name of the github repository.
@@ -1092,6 +1122,7 @@ func LoadAndCheckExampleDependencies(
 // Returns:
 //   - examples: code generation examples.
 func LoadCodeGenerationExamples(
+	ctx context.Context,
 	source string,
 	examplesToExtract []string,
 	githubRepoName string,
@@ -1116,27 +1147,27 @@ func LoadCodeGenerationExamples(
 			if err != nil {
 				errMessage := fmt.Sprintf("Error getting local file content: %v", err)
 				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				panic(NewInternalError(err, "%s", errMessage))
 			}
 		case "github":
-			_, content, err = downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, examplePath, githubAccessToken)
+			_, content, err = downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, examplePath, githubAccessToken)
 			if err != nil {
 				errMessage := fmt.Sprintf("Error getting github file content: %v", err)
 				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				panic(NewUpstreamError(UpstreamHTTP, err, "%s", errMessage))
 			}
 		default:
 			errMessage := fmt.Sprintf("Unknown data source: %s", source)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInvalidInputError("source", "%s", errMessage))
 		}
 
 		// Create the chunks for the current element.
-		chunks, err := dataExtractionTextSplitter(string(content), chunkSize, chunkOverlap)
+		chunks, err := dataExtractionTextSplitter(ctx, string(content), chunkSize, chunkOverlap)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error splitting text into chunks: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		// The name should be only the file name
@@ -1169,11 +1200,12 @@ func LoadCodeGenerationExamples(
 //   - @displayName: Store Examples in Vector Database
 //
 // Parameters:
+//   - ctx: the request context.
 //   - examples: code generation examples.
 //   - examplesCollectionName: name of the collection.
 //   - batchSize: batch size for embeddings.
 //   - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)
-func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample, examplesCollectionName string, batchSize int, vectorDistance string) {
+func StoreExamplesInVectorDatabase(ctx context.Context, examples []sharedtypes.CodeGenerationExample, examplesCollectionName string, batchSize int, vectorDistance string) {
 	// Set default batch size if not provided.
 	if batchSize <= 0 {
 		batchSize = 2
@@ -1215,11 +1247,11 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 	}
 
 	// Generate dense and sparse embeddings
-	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForExamples(vectorExamples, batchSize)
+	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForExamples(ctx, vectorExamples, batchSize)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error generating embeddings for examples: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInternalError(err, "%s", errMessage))
 	}
 
 	// if you have no embeddings, quit
@@ -1268,11 +1300,9 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "Error creating qdrant client: %v", err)
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamQdrant, err, "Error creating qdrant client: %v", err))
 	}
 
-	ctx := context.TODO()
-
 	// Create the collection.
 	err = qdrant_utils.CreateCollectionIfNotExists(
 		ctx,
@@ -1287,17 +1317,20 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 		}),
 	)
 	if err != nil {
-		logPanic(nil, "Error creating the collection: %v", err)
+		logPanicError(nil, NewUpstreamError(UpstreamQdrant, err, "Error creating the collection: %v", err))
 	}
 
 	// insert into db
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("store", len(points))
 	_, err = client.Upsert(ctx, &qdrant.UpsertPoints{
 		CollectionName: examplesCollectionName,
 		Points:         points,
 	})
 	if err != nil {
-		logPanic(nil, "Error inserting data into the vector database: %v", err)
+		logPanicError(nil, NewUpstreamError(UpstreamQdrant, err, "Error inserting data into the vector database: %v", err))
 	}
+	progress.Add(len(points))
 
 	// create some indexes
 	// TODO: are these the right ones to create? anything that will be searched/filtered on should be indexed
@@ -1318,7 +1351,7 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 		if err != nil {
 			errMessage := fmt.Sprintf("Error creating index on field %q: %v", index.FieldName, err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewUpstreamError(UpstreamQdrant, err, "%s", errMessage))
 		}
 	}
 }
@@ -1329,40 +1362,51 @@ func StoreExamplesInVectorDatabase(examples []sharedtypes.CodeGenerationExample,
 //   - @displayName: Store Examples in Graph Database
 //
 // Parameters:
+//   - ctx: the request context.
 //   - dbname: the name of the graphdb to target. If not provided, defaults to "aali".
 //   - examples: code generation examples.
-func StoreExamplesInGraphDatabase(dbname string, examples []sharedtypes.CodeGenerationExample) {
-	ctx := &logging.ContextMap{}
+func StoreExamplesInGraphDatabase(ctx context.Context, dbname string, examples []sharedtypes.CodeGenerationExample) {
+	logCtx := &logging.ContextMap{}
 
 	dbname = graphDbNameOrDefault(dbname)
 
+	checkGraphDbContext(ctx, "storing examples")
+
 	// Initialize the graph database.
 	err := graphdb.Initialize(config.GlobalConfig.GRAPHDB_ADDRESS, dbname)
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb %q: %v", dbname, err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
+	span := startGraphDbSpan(ctx, "CreateSchema", dbname, "")
 	err = graphdb.GraphDbDriver.CreateSchema(dbname)
+	telemetry.EndSpan(span, err)
 	if err != nil {
-		logPanic(ctx, "error error creating aali schema: %v", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamGraphDB, err, "error error creating aali schema: %v", err))
 	}
 
 	// Add the elements to the graph database.
+	span = startGraphDbSpan(ctx, "AddCodeGenerationExampleNodes", dbname, "")
 	err = graphdb.GraphDbDriver.AddCodeGenerationExampleNodes(dbname, examples)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errMsg := fmt.Sprintf("error adding code gen example nodes to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
+	checkGraphDbContext(ctx, "adding code gen example relationships")
+
 	// Add the dependencies to the graph database.
+	span = startGraphDbSpan(ctx, "CreateCodeGenerationExampleRelationships", dbname, "")
 	err = graphdb.GraphDbDriver.CreateCodeGenerationExampleRelationships(dbname, examples)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errMsg := fmt.Sprintf("error adding code gen example relationships to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 }
 
@@ -1372,6 +1416,7 @@ func StoreExamplesInGraphDatabase(dbname string, examples []sharedtypes.CodeGene
 //   - @displayName: Load User Guide Sections
 //
 // Parameters:
+//   - ctx: the request context.
 //   - source: source of the sections (local or github).
 //   - sectionFilePaths: paths to the sections.
 //   - githubRepoName: name of the github repository.
@@ -1381,7 +1426,7 @@ func StoreExamplesInGraphDatabase(dbname string, examples []sharedtypes.CodeGene
 //
 // Returns:
 //   - sections: user guide sections.
-func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoName string, githubRepoOwner string,
+func LoadUserGuideSections(ctx context.Context, source string, sectionFilePaths []string, githubRepoName string, githubRepoOwner string,
 	githubRepoBranch string, githubAccessToken string) (sections []sharedtypes.CodeGenerationUserGuideSection) {
 	// Initialize the sections.
 	sections = []sharedtypes.CodeGenerationUserGuideSection{}
@@ -1396,19 +1441,19 @@ func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoN
 			if err != nil {
 				errMessage := fmt.Sprintf("Error getting local file content: %v", err)
 				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				panic(NewInternalError(err, "%s", errMessage))
 			}
 		case "github":
-			_, content, err = downloadGithubFileContent(githubRepoName, githubRepoOwner, githubRepoBranch, path, githubAccessToken)
+			_, content, err = downloadGithubFileContent(ctx, githubRepoName, githubRepoOwner, githubRepoBranch, path, githubAccessToken)
 			if err != nil {
 				errMessage := fmt.Sprintf("Error getting github file content: %v", err)
 				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				panic(NewUpstreamError(UpstreamHTTP, err, "%s", errMessage))
 			}
 		default:
 			errMessage := fmt.Sprintf("Unknown data source: %s", source)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInvalidInputError("source", "%s", errMessage))
 		}
 
 		// Initialize the sections.
@@ -1419,7 +1464,7 @@ func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoN
 		if err != nil {
 			errMessage := fmt.Sprintf("Error unmarshalling user guide sections: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInvalidInputError("sectionFilePaths", "%s", errMessage))
 		}
 
 		// Add the new sections to the sections.
@@ -1437,13 +1482,14 @@ func LoadUserGuideSections(source string, sectionFilePaths []string, githubRepoN
 //   - @displayName: Store User Guide Sections in Vector Database
 //
 // Parameters:
+//   - ctx: the request context.
 //   - sections: user guide sections.
 //   - userGuideCollectionName: name of the collection.
 //   - batchSize: batch size for embeddings.
 //   - chunkSize: size of the chunks.
 //   - chunkOverlap: overlap of the chunks.
 //   - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)
-func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGenerationUserGuideSection, userGuideCollectionName string, batchSize int, chunkSize int, chunkOverlap int, vectorDistance string) {
+func StoreUserGuideSectionsInVectorDatabase(ctx context.Context, sections []sharedtypes.CodeGenerationUserGuideSection, userGuideCollectionName string, batchSize int, chunkSize int, chunkOverlap int, vectorDistance string) {
 	// Set default batch size if not provided.
 	if batchSize <= 0 {
 		batchSize = 2
@@ -1453,11 +1499,11 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 	vectorUserGuideSectionChunks := []codegeneration.VectorDatabaseUserGuideSection{}
 	for _, section := range sections {
 		// Create the chunks for the current element.
-		chunks, err := dataExtractionTextSplitter(section.Content, chunkSize, chunkOverlap)
+		chunks, err := dataExtractionTextSplitter(ctx, section.Content, chunkSize, chunkOverlap)
 		if err != nil {
 			errMessage := fmt.Sprintf("Error splitting text into chunks: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 		section.Chunks = chunks
 
@@ -1496,11 +1542,11 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 	}
 
 	// Generate dense and sparse embeddings
-	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(vectorUserGuideSectionChunks, batchSize)
+	denseEmbeddings, sparseEmbeddings, err := codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(ctx, vectorUserGuideSectionChunks, batchSize)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error generating embeddings for user guide sections: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInternalError(err, "%s", errMessage))
 	}
 
 	// if you have no embeddings, quit
@@ -1539,11 +1585,9 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "Error creating qdrant client: %v", err)
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamQdrant, err, "Error creating qdrant client: %v", err))
 	}
 
-	ctx := context.TODO()
-
 	// Create the collection.
 	err = qdrant_utils.CreateCollectionIfNotExists(
 		ctx,
@@ -1559,10 +1603,12 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 	if err != nil {
 		errMessage := fmt.Sprintf("Error creating the collection: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamQdrant, err, "%s", errMessage))
 	}
 
 	// insert into db
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("store", len(points))
 	_, err = client.Upsert(ctx, &qdrant.UpsertPoints{
 		CollectionName: userGuideCollectionName,
 		Points:         points,
@@ -1570,8 +1616,9 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 	if err != nil {
 		errMessage := fmt.Sprintf("Error inserting data into the vector database: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamQdrant, err, "%s", errMessage))
 	}
+	progress.Add(len(points))
 
 	// create some indexes
 	// TODO: are these the right ones to create? anything that will be searched/filtered on should be indexed
@@ -1597,7 +1644,7 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 		if err != nil {
 			errMessage := fmt.Sprintf("Error creating index on field %q: %v", index.FieldName, err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewUpstreamError(UpstreamQdrant, err, "%s", errMessage))
 		}
 	}
 }
@@ -1608,40 +1655,51 @@ func StoreUserGuideSectionsInVectorDatabase(sections []sharedtypes.CodeGeneratio
 //   - @displayName: Store User Guide Sections in Graph Database
 //
 // Parameters:
+//   - ctx: the request context.
 //   - dbname: the name of the graphdb to target. If not provided, defaults to "aali".
 //   - sections: user guide sections.
-func StoreUserGuideSectionsInGraphDatabase(dbname string, sections []sharedtypes.CodeGenerationUserGuideSection) {
-	ctx := &logging.ContextMap{}
+func StoreUserGuideSectionsInGraphDatabase(ctx context.Context, dbname string, sections []sharedtypes.CodeGenerationUserGuideSection) {
+	logCtx := &logging.ContextMap{}
 
 	dbname = graphDbNameOrDefault(dbname)
 
+	checkGraphDbContext(ctx, "storing user guide sections")
+
 	// Initialize the graph database.
 	err := graphdb.Initialize(config.GlobalConfig.GRAPHDB_ADDRESS, dbname)
 	if err != nil {
 		errMsg := fmt.Sprintf("error initializing graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamUnavailableError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
+	span := startGraphDbSpan(ctx, "CreateSchema", dbname, "")
 	err = graphdb.GraphDbDriver.CreateSchema(dbname)
+	telemetry.EndSpan(span, err)
 	if err != nil {
-		logPanic(ctx, "error error creating aali schema: %v", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamGraphDB, err, "error error creating aali schema: %v", err))
 	}
 
 	// Add the elements to the graph database.
+	span = startGraphDbSpan(ctx, "AddUserGuideSectionNodes", dbname, "")
 	err = graphdb.GraphDbDriver.AddUserGuideSectionNodes(dbname, sections)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errMsg := fmt.Sprintf("error adding user guide section nodes to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 
+	checkGraphDbContext(ctx, "adding user guide section relationships")
+
 	// Add the dependencies to the graph database.
+	span = startGraphDbSpan(ctx, "CreateUserGuideSectionRelationships", dbname, "")
 	err = graphdb.GraphDbDriver.CreateUserGuideSectionRelationships(dbname, sections)
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		errMsg := fmt.Sprintf("error adding user guide section relationships to graphdb: %v", err)
-		logging.Log.Error(ctx, errMsg)
-		panic(errMsg)
+		logging.Log.Error(logCtx, errMsg)
+		panic(NewUpstreamError(UpstreamGraphDB, err, "%s", errMsg))
 	}
 }
 
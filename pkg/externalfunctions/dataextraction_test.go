@@ -397,7 +397,7 @@ func TestStoreElementsInVectorDatabase(t *testing.T) {
 	}
 	assert.Len(t, expectedPayloads, len(elements))
 
-	StoreElementsInVectorDatabase(elements, COLLECTIONNAME, 2, "cosine")
+	StoreElementsInVectorDatabase(ctx, elements, COLLECTIONNAME, 2, "cosine")
 
 	// query qdrant to make sure things are as they should be
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -518,7 +518,7 @@ func TestStoreExamplesInVectorDatabase(t *testing.T) {
 		},
 	}
 
-	StoreExamplesInVectorDatabase(examples, COLLECTIONNAME, 2, "cosine")
+	StoreExamplesInVectorDatabase(ctx, examples, COLLECTIONNAME, 2, "cosine")
 
 	// query qdrant to make sure things are as they should be
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -653,7 +653,7 @@ func TestStoreUserGuideSectionsInVectorDatabase(t *testing.T) {
 		},
 	}
 
-	StoreUserGuideSectionsInVectorDatabase(sections, COLLECTIONNAME, 2, 5, 1, "cosine")
+	StoreUserGuideSectionsInVectorDatabase(ctx, sections, COLLECTIONNAME, 2, 5, 1, "cosine")
 
 	// query qdrant to make sure things are as they should be
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -797,7 +797,7 @@ func TestStoreElementsInGraphDatabase(t *testing.T) {
 		EnumValues: nil,
 	}
 
-	StoreElementsInGraphDatabase(DBNAME, []sharedtypes.CodeGenerationElement{element})
+	StoreElementsInGraphDatabase(ctx, DBNAME, []sharedtypes.CodeGenerationElement{element})
 
 	// query graphdb to make sure things are as they should be
 
@@ -881,7 +881,7 @@ func TestStoreExamplesInGraphDatabase(t *testing.T) {
 		},
 	}
 
-	StoreExamplesInGraphDatabase(DBNAME, examples)
+	StoreExamplesInGraphDatabase(ctx, DBNAME, examples)
 
 	// query graphdb to make sure things are as they should be
 
@@ -993,7 +993,7 @@ func TestStoreUserGuideSectionsInGraphDatabase(t *testing.T) {
 		},
 	}
 
-	StoreUserGuideSectionsInGraphDatabase(DBNAME, sections)
+	StoreUserGuideSectionsInGraphDatabase(ctx, DBNAME, sections)
 
 	// query graphdb to make sure things are as they should be
 	type dbUserGuideSection struct {
@@ -0,0 +1,436 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/privatefunctions/llmclient"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is the domain of the gRPC error details sent by aali-flowkit
+const ErrorDomain = "aali-flowkit"
+
+// Reasons of the function errors, sent as reason of the gRPC error details
+const (
+	ReasonInvalidInput        = "INVALID_INPUT"
+	ReasonNotFound            = "NOT_FOUND"
+	ReasonQuotaExceeded       = "QUOTA_EXCEEDED"
+	ReasonPermissionDenied    = "PERMISSION_DENIED"
+	ReasonUpstreamUnavailable = "UPSTREAM_UNAVAILABLE"
+	ReasonUpstreamTimeout     = "UPSTREAM_TIMEOUT"
+	ReasonUpstreamError       = "UPSTREAM_ERROR"
+	ReasonInternal            = "INTERNAL"
+)
+
+// Names of the upstream services, sent as "upstream" in the gRPC error details
+const (
+	UpstreamLLM     = "aali-llm"
+	UpstreamQdrant  = "qdrant"
+	UpstreamGraphDB = "aali-graphdb"
+	UpstreamMongoDB = "mongodb"
+	UpstreamHTTP    = "http"
+)
+
+// FunctionError is a typed error raised by an external function
+// External functions raise it by panicking with it (see logPanicError), the gRPC server
+// converts it to a status with the matching code and the details of the error.
+type FunctionError struct {
+	Code       codes.Code
+	Reason     string
+	Function   string
+	Input      string
+	Upstream   string
+	Message    string
+	Violations []InputViolation
+	Err        error
+}
+
+// InputViolation describes why an input of a function is invalid
+type InputViolation struct {
+	Input       string
+	Description string
+}
+
+// Error returns the message of the error
+//
+// Returns:
+//   - string: the error message
+func (e *FunctionError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error
+//
+// Returns:
+//   - error: the underlying error, nil if there is none
+func (e *FunctionError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus converts the error to a gRPC status
+// The status carries an ErrorInfo detail with the reason, function, input and upstream of the error,
+// and a BadRequest detail listing every invalid input.
+//
+// Returns:
+//   - *status.Status: the gRPC status
+func (e *FunctionError) GRPCStatus() *status.Status {
+	st := status.New(e.Code, e.Message)
+
+	metadata := map[string]string{}
+	if e.Function != "" {
+		metadata["function"] = e.Function
+	}
+	if e.Input != "" {
+		metadata["input"] = e.Input
+	}
+	if e.Upstream != "" {
+		metadata["upstream"] = e.Upstream
+	}
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: e.Reason, Domain: ErrorDomain, Metadata: metadata}}
+	if e.Code == codes.InvalidArgument {
+		violations := []*errdetails.BadRequest_FieldViolation{}
+		for _, violation := range e.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: violation.Input, Description: violation.Description})
+		}
+		if len(violations) == 0 && e.Input != "" {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: e.Input, Description: e.Message})
+		}
+		if len(violations) > 0 {
+			details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+		}
+	}
+
+	// details are only dropped if they cannot be marshaled, the status is still valid without them
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		logging.Log.Warnf(&logging.ContextMap{}, "failed to add details to gRPC status: %v", err)
+		return st
+	}
+	return withDetails
+}
+
+// WithFunction sets the name of the function that raised the error, if not set yet
+//
+// Parameters:
+//   - functionName: the name of the function
+//
+// Returns:
+//   - *FunctionError: the error
+func (e *FunctionError) WithFunction(functionName string) *FunctionError {
+	if e.Function == "" {
+		e.Function = functionName
+	}
+	return e
+}
+
+// NewInvalidInputError creates an error for an input of a function that is invalid
+//
+// Parameters:
+//   - input: the name of the input
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewInvalidInputError(input string, format string, args ...any) *FunctionError {
+	return &FunctionError{
+		Code:    codes.InvalidArgument,
+		Reason:  ReasonInvalidInput,
+		Input:   input,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// NewInvalidInputsError creates an error for a call of a function with one or more invalid inputs
+// The message lists every invalid input, the inputs are also sent as field violations of the gRPC status.
+//
+// Parameters:
+//   - functionName: the name of the function
+//   - violations: the invalid inputs
+//
+// Returns:
+//   - *FunctionError: the error
+func NewInvalidInputsError(functionName string, violations []InputViolation) *FunctionError {
+	descriptions := make([]string, len(violations))
+	for i, violation := range violations {
+		descriptions[i] = fmt.Sprintf("%s: %s", violation.Input, violation.Description)
+	}
+	err := &FunctionError{
+		Code:       codes.InvalidArgument,
+		Reason:     ReasonInvalidInput,
+		Function:   functionName,
+		Message:    fmt.Sprintf("invalid inputs for function '%s': %s", functionName, strings.Join(descriptions, "; ")),
+		Violations: violations,
+	}
+	if len(violations) == 1 {
+		err.Input = violations[0].Input
+	}
+	return err
+}
+
+// NewNotFoundError creates an error for a resource that does not exist
+//
+// Parameters:
+//   - upstream: the service the resource was looked up in, empty if none
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewNotFoundError(upstream string, format string, args ...any) *FunctionError {
+	return &FunctionError{
+		Code:     codes.NotFound,
+		Reason:   ReasonNotFound,
+		Upstream: upstream,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// NewQuotaExceededError creates an error for an upstream service that rejected a request because of rate limits or quotas
+//
+// Parameters:
+//   - upstream: the upstream service
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewQuotaExceededError(upstream string, format string, args ...any) *FunctionError {
+	return &FunctionError{
+		Code:     codes.ResourceExhausted,
+		Reason:   ReasonQuotaExceeded,
+		Upstream: upstream,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// NewPermissionDeniedError creates an error for a request that is not authorized
+//
+// Parameters:
+//   - upstream: the service that denied the request, empty if none
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewPermissionDeniedError(upstream string, format string, args ...any) *FunctionError {
+	return &FunctionError{
+		Code:     codes.PermissionDenied,
+		Reason:   ReasonPermissionDenied,
+		Upstream: upstream,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// NewUpstreamUnavailableError creates an error for an upstream service that cannot be reached
+// The request can be retried.
+//
+// Parameters:
+//   - upstream: the upstream service
+//   - err: the underlying error
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewUpstreamUnavailableError(upstream string, err error, format string, args ...any) *FunctionError {
+	return &FunctionError{
+		Code:     codes.Unavailable,
+		Reason:   ReasonUpstreamUnavailable,
+		Upstream: upstream,
+		Message:  fmt.Sprintf(format, args...),
+		Err:      err,
+	}
+}
+
+// NewUpstreamError creates an error for an upstream service that failed to process a request
+//
+// Parameters:
+//   - upstream: the upstream service
+//   - err: the underlying error
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewUpstreamError(upstream string, err error, format string, args ...any) *FunctionError {
+	return &FunctionError{
+		Code:     codes.Internal,
+		Reason:   ReasonUpstreamError,
+		Upstream: upstream,
+		Message:  fmt.Sprintf(format, args...),
+		Err:      err,
+	}
+}
+
+// NewUpstreamHTTPError creates an error for an HTTP response with an error status code
+// The gRPC code is derived from the HTTP status code.
+//
+// Parameters:
+//   - upstream: the upstream service
+//   - statusCode: the HTTP status code of the response
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewUpstreamHTTPError(upstream string, statusCode int, format string, args ...any) *FunctionError {
+	message := fmt.Sprintf(format, args...)
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return NewQuotaExceededError(upstream, "%s", message)
+	case statusCode == http.StatusNotFound:
+		return NewNotFoundError(upstream, "%s", message)
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return NewPermissionDeniedError(upstream, "%s", message)
+	case statusCode == http.StatusRequestTimeout || statusCode == http.StatusGatewayTimeout:
+		return &FunctionError{Code: codes.DeadlineExceeded, Reason: ReasonUpstreamTimeout, Upstream: upstream, Message: message}
+	case statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable:
+		return NewUpstreamUnavailableError(upstream, nil, "%s", message)
+	default:
+		return NewUpstreamError(upstream, nil, "%s", message)
+	}
+}
+
+// NewInternalError creates an error for an unexpected failure inside a function
+//
+// Parameters:
+//   - err: the underlying error
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func NewInternalError(err error, format string, args ...any) *FunctionError {
+	return &FunctionError{
+		Code:    codes.Internal,
+		Reason:  ReasonInternal,
+		Message: fmt.Sprintf(format, args...),
+		Err:     err,
+	}
+}
+
+// ErrorFromPanic converts the value recovered from a panicking function to a FunctionError
+// Function errors are returned as is, error responses from aali-llm are converted to upstream errors
+// and any other value is converted to an internal error.
+//
+// Parameters:
+//   - recovered: the value recovered from the panic
+//
+// Returns:
+//   - *FunctionError: the error
+func ErrorFromPanic(recovered any) *FunctionError {
+	switch value := recovered.(type) {
+	case *FunctionError:
+		return value
+	case *sharedtypes.ErrorResponse:
+		return errorFromLLMResponse(value)
+	case error:
+		var functionError *FunctionError
+		if errors.As(value, &functionError) {
+			return functionError
+		}
+		return NewInternalError(value, "%v", value)
+	default:
+		return NewInternalError(nil, "%v", value)
+	}
+}
+
+// errorFromLLMResponse converts an error response of aali-llm to a FunctionError
+//
+// Parameters:
+//   - response: the error response
+//
+// Returns:
+//   - *FunctionError: the error
+func errorFromLLMResponse(response *sharedtypes.ErrorResponse) *FunctionError {
+	if response == nil {
+		return NewUpstreamError(UpstreamLLM, nil, "unknown error in request to aali-llm")
+	}
+
+	switch response.Code {
+	case llmclient.ErrorCodeConnection:
+		return NewUpstreamUnavailableError(UpstreamLLM, nil, "%s", response.Message)
+	case llmclient.ErrorCodeAborted:
+		return &FunctionError{Code: codes.Canceled, Reason: ReasonUpstreamError, Upstream: UpstreamLLM, Message: response.Message}
+	case llmclient.ErrorCodeTimeout:
+		return &FunctionError{Code: codes.DeadlineExceeded, Reason: ReasonUpstreamTimeout, Upstream: UpstreamLLM, Message: response.Message}
+	case http.StatusTooManyRequests:
+		return NewQuotaExceededError(UpstreamLLM, "%s", response.Message)
+	}
+
+	// the model providers report rate limits and exhausted quotas as plain messages,
+	// the messages are only inspected for codes without a mapping as they may contain IDs and token counts
+	message := strings.ToLower(response.Message)
+	if strings.Contains(message, "rate limit") || strings.Contains(message, "quota") || strings.Contains(message, "too many requests") {
+		return NewQuotaExceededError(UpstreamLLM, "%s", response.Message)
+	}
+	return NewUpstreamError(UpstreamLLM, nil, "%s", response.Message)
+}
+
+// errorFromContext converts the error of a done request context to a FunctionError
+//
+// Parameters:
+//   - upstream: the upstream service the request was aborted for
+//   - err: the error of the context
+//   - format: the format of the error message
+//   - args: the arguments of the error message
+//
+// Returns:
+//   - *FunctionError: the error
+func errorFromContext(upstream string, err error, format string, args ...any) *FunctionError {
+	functionError := &FunctionError{
+		Code:     status.FromContextError(err).Code(),
+		Reason:   ReasonUpstreamError,
+		Upstream: upstream,
+		Message:  fmt.Sprintf(format, args...),
+		Err:      err,
+	}
+	if functionError.Code == codes.DeadlineExceeded {
+		functionError.Reason = ReasonUpstreamTimeout
+	}
+	return functionError
+}
+
+// logPanicError logs a function error and panics with it
+//
+// Parameters:
+//   - ctx: the logging context, nil for an empty one
+//   - err: the function error
+func logPanicError(ctx *logging.ContextMap, err *FunctionError) {
+	if ctx == nil {
+		ctx = &logging.ContextMap{}
+	}
+	logging.Log.Error(ctx, err.Message)
+	panic(err)
+}
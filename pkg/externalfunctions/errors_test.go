@@ -0,0 +1,235 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/privatefunctions/llmclient"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorFromPanic(t *testing.T) {
+	tests := []struct {
+		name         string
+		recovered    any
+		wantCode     codes.Code
+		wantUpstream string
+	}{
+		{
+			name:      "String panic",
+			recovered: "something went wrong",
+			wantCode:  codes.Internal,
+		},
+		{
+			name:      "Error panic",
+			recovered: errors.New("something went wrong"),
+			wantCode:  codes.Internal,
+		},
+		{
+			name:      "Wrapped function error",
+			recovered: fmt.Errorf("wrapped: %w", NewInvalidInputError("query", "query is empty")),
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:         "Function error",
+			recovered:    NewUpstreamUnavailableError(UpstreamQdrant, nil, "unable to create qdrant client"),
+			wantCode:     codes.Unavailable,
+			wantUpstream: UpstreamQdrant,
+		},
+		{
+			name:         "LLM request error",
+			recovered:    &sharedtypes.ErrorResponse{Code: llmclient.ErrorCodeRequest, Message: "model failed"},
+			wantCode:     codes.Internal,
+			wantUpstream: UpstreamLLM,
+		},
+		{
+			name:         "LLM rate limit",
+			recovered:    &sharedtypes.ErrorResponse{Code: llmclient.ErrorCodeRequest, Message: "Rate limit reached for model"},
+			wantCode:     codes.ResourceExhausted,
+			wantUpstream: UpstreamLLM,
+		},
+		{
+			name:         "LLM rate limit code",
+			recovered:    &sharedtypes.ErrorResponse{Code: http.StatusTooManyRequests, Message: "model failed"},
+			wantCode:     codes.ResourceExhausted,
+			wantUpstream: UpstreamLLM,
+		},
+		{
+			name:         "LLM request error with a number in its message",
+			recovered:    &sharedtypes.ErrorResponse{Code: llmclient.ErrorCodeRequest, Message: "error in request 4297a1: context of 4290 tokens too long"},
+			wantCode:     codes.Internal,
+			wantUpstream: UpstreamLLM,
+		},
+		{
+			name:         "LLM connection error with a number in its message",
+			recovered:    &sharedtypes.ErrorResponse{Code: llmclient.ErrorCodeConnection, Message: "connection 429 closed: quota of sockets reached"},
+			wantCode:     codes.Unavailable,
+			wantUpstream: UpstreamLLM,
+		},
+		{
+			name:         "LLM connection error",
+			recovered:    &sharedtypes.ErrorResponse{Code: llmclient.ErrorCodeConnection, Message: "failed to read message from aali-llm"},
+			wantCode:     codes.Unavailable,
+			wantUpstream: UpstreamLLM,
+		},
+		{
+			name:         "LLM timeout",
+			recovered:    &sharedtypes.ErrorResponse{Code: llmclient.ErrorCodeTimeout, Message: "no response from aali-llm for 5m0s"},
+			wantCode:     codes.DeadlineExceeded,
+			wantUpstream: UpstreamLLM,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ErrorFromPanic(tt.recovered)
+			if got.Code != tt.wantCode {
+				t.Errorf("ErrorFromPanic() code = %v, want %v", got.Code, tt.wantCode)
+			}
+			if got.Upstream != tt.wantUpstream {
+				t.Errorf("ErrorFromPanic() upstream = %q, want %q", got.Upstream, tt.wantUpstream)
+			}
+		})
+	}
+}
+
+func TestLogPanic(t *testing.T) {
+	cause := errors.New("connection refused")
+	defer func() {
+		got, ok := recover().(*FunctionError)
+		if !ok {
+			t.Fatalf("logPanic() did not panic with a *FunctionError")
+		}
+		if got.Code != codes.Internal || got.Message != "unable to load: connection refused" {
+			t.Errorf("logPanic() = %v/%q, want %v/%q", got.Code, got.Message, codes.Internal, "unable to load: connection refused")
+		}
+		if !errors.Is(got, cause) {
+			t.Errorf("logPanic() does not wrap the error of its arguments")
+		}
+	}()
+	logPanic(nil, "unable to load: %v", cause)
+}
+
+func TestErrorFromContext(t *testing.T) {
+	canceled := errorFromContext(UpstreamGraphDB, context.Canceled, "request aborted")
+	if canceled.Code != codes.Canceled || canceled.Upstream != UpstreamGraphDB {
+		t.Errorf("errorFromContext(Canceled) = %v/%q, want %v/%q", canceled.Code, canceled.Upstream, codes.Canceled, UpstreamGraphDB)
+	}
+
+	expired := errorFromContext(UpstreamGraphDB, context.DeadlineExceeded, "request aborted")
+	if expired.Code != codes.DeadlineExceeded || expired.Reason != ReasonUpstreamTimeout {
+		t.Errorf("errorFromContext(DeadlineExceeded) = %v/%v, want %v/%v", expired.Code, expired.Reason, codes.DeadlineExceeded, ReasonUpstreamTimeout)
+	}
+}
+
+func TestNewUpstreamHTTPError(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       codes.Code
+	}{
+		{http.StatusTooManyRequests, codes.ResourceExhausted},
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusGatewayTimeout, codes.DeadlineExceeded},
+		{http.StatusServiceUnavailable, codes.Unavailable},
+		{http.StatusInternalServerError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		got := NewUpstreamHTTPError(UpstreamHTTP, tt.statusCode, "status %d", tt.statusCode)
+		if got.Code != tt.want {
+			t.Errorf("NewUpstreamHTTPError(%d) code = %v, want %v", tt.statusCode, got.Code, tt.want)
+		}
+	}
+}
+
+func TestFunctionErrorGRPCStatus(t *testing.T) {
+	err := NewInvalidInputError("collectionName", "collection name is empty").WithFunction("QdrantCreateCollection")
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("status.FromError() could not convert %v", err)
+	}
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+	if info == nil {
+		t.Fatalf("status has no ErrorInfo detail")
+	}
+	if info.Reason != ReasonInvalidInput || info.Domain != ErrorDomain {
+		t.Errorf("ErrorInfo = %v/%v, want %v/%v", info.Reason, info.Domain, ReasonInvalidInput, ErrorDomain)
+	}
+	if info.Metadata["function"] != "QdrantCreateCollection" || info.Metadata["input"] != "collectionName" {
+		t.Errorf("ErrorInfo metadata = %v", info.Metadata)
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "collectionName" {
+		t.Errorf("BadRequest = %v, want a violation for collectionName", badRequest)
+	}
+}
+
+func TestNewInvalidInputsErrorGRPCStatus(t *testing.T) {
+	err := NewInvalidInputsError("AppendMessageHistory", []InputViolation{
+		{Input: "newMessage", Description: "required input is missing"},
+		{Input: "role", Description: "value 'admin' is not one of the options user, assistant, system"},
+	})
+
+	st, _ := status.FromError(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+	if !strings.Contains(st.Message(), "newMessage") || !strings.Contains(st.Message(), "role") {
+		t.Errorf("message = %q, want both inputs listed", st.Message())
+	}
+
+	fields := []string{}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	if strings.Join(fields, ",") != "newMessage,role" {
+		t.Errorf("field violations = %v, want [newMessage role]", fields)
+	}
+}
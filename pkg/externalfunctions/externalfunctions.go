@@ -22,6 +22,16 @@
 
 package externalfunctions
 
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ExternalFunctionsMap registers the external functions by name
+// Every exported function of the files in functionCategories must be registered here.
+// After changing the map, regenerate the registry with "go generate ./pkg/externalfunctions".
 var ExternalFunctionsMap = map[string]interface{}{
 	// llm handler
 	"PerformVectorEmbeddingRequest":                                                             PerformVectorEmbeddingRequest,
@@ -29,6 +39,8 @@ var ExternalFunctionsMap = map[string]interface{}{
 	"PerformBatchEmbeddingRequest":                                                              PerformBatchEmbeddingRequest,
 	"PerformBatchHybridEmbeddingRequest":                                                        PerformBatchHybridEmbeddingRequest,
 	"PerformKeywordExtractionRequest":                                                           PerformKeywordExtractionRequest,
+	"PerformLLMRequest":                                                                         PerformLLMRequest,
+	"PerformLLMStreamRequest":                                                                   PerformLLMStreamRequest,
 	"PerformGeneralRequest":                                                                     PerformGeneralRequest,
 	"PerformGeneralRequestWithImages":                                                           PerformGeneralRequestWithImages,
 	"PerformGeneralModelSpecificationRequest":                                                   PerformGeneralModelSpecificationRequest,
@@ -40,11 +52,13 @@ var ExternalFunctionsMap = map[string]interface{}{
 	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput": PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput,
 	"PerformCodeLLMRequest":                                                                     PerformCodeLLMRequest,
 	"PerformGeneralRequestNoStreaming":                                                          PerformGeneralRequestNoStreaming,
+	"PerformSummaryRequest":                                                                     PerformSummaryRequest,
 	"BuildLibraryContext":                                                                       BuildLibraryContext,
 	"BuildFinalQueryForGeneralLLMRequest":                                                       BuildFinalQueryForGeneralLLMRequest,
 	"BuildFinalQueryForCodeLLMRequest":                                                          BuildFinalQueryForCodeLLMRequest,
 	"AppendMessageHistory":                                                                      AppendMessageHistory,
 	"ShortenMessageHistory":                                                                     ShortenMessageHistory,
+	"CutLatestMessagesFromHistory":                                                              CutLatestMessagesFromHistory,
 	"CheckTokenLimitReached":                                                                    CheckTokenLimitReached,
 
 	// knowledge db
@@ -90,6 +104,8 @@ var ExternalFunctionsMap = map[string]interface{}{
 	"AppendStringSlices":                         AppendStringSlices,
 	"DownloadGithubFileContent":                  DownloadGithubFileContent,
 	"GetLocalFileContent":                        GetLocalFileContent,
+	"DownloadGithubFilesContent":                 DownloadGithubFilesContent,
+	"GetLocalFilesContent":                       GetLocalFilesContent,
 	"GetDocumentType":                            GetDocumentType,
 	"LangchainSplitter":                          LangchainSplitter,
 	"GenerateDocumentTree":                       GenerateDocumentTree,
@@ -163,6 +179,7 @@ var ExternalFunctionsMap = map[string]interface{}{
 	// qdrant
 	"QdrantCreateCollection": QdrantCreateCollection,
 	"QdrantInsertData":       QdrantInsertData,
+	"QdrantCreateIndex":      QdrantCreateIndex,
 
 	// auth
 	"CheckApiKeyAuthMongoDb":                        CheckApiKeyAuthMongoDb,
@@ -205,3 +222,85 @@ var ExternalFunctionsMap = map[string]interface{}{
 	// fluent
 	"FluentCodeGen": FluentCodeGen,
 }
+
+// functionCategories maps the source files of the external functions to the category of their functions
+// It is read by the registry generator in internal/gen/registry.
+var functionCategories = map[string]string{
+	"dataextraction.go": "data_extraction",
+	"generic.go":        "generic",
+	"cast.go":           "cast",
+	"knowledgedb.go":    "knowledge_db",
+	"llmhandler.go":     "llm_handler",
+	"ansysgpt.go":       "ansys_gpt",
+	"qdrant.go":         "qdrant",
+	"ansysmeshpilot.go": "ansys_mesh_pilot",
+	"ansysmaterials.go": "ansys_materials",
+	"auth.go":           "auth",
+	"mcp.go":            "mcp",
+	"rhsc.go":           "rhsc",
+	"fluent.go":         "fluent",
+}
+
+// CheckRegistry checks that the generated function registry matches ExternalFunctionsMap
+// Every registered function must have a definition, an adapter and metadata for the same function,
+// and every definition must belong to a known category.
+// A mismatch means that "go generate ./pkg/externalfunctions" was not run after a change.
+//
+// Returns:
+//   - error: an error listing all mismatches, nil if the registry is consistent
+func CheckRegistry() error {
+	problems := []string{}
+
+	categories := map[string]bool{}
+	for _, category := range functionCategories {
+		categories[category] = true
+	}
+
+	for name, function := range ExternalFunctionsMap {
+		definition, hasDefinition := FunctionDefinitions[name]
+		adapter, hasAdapter := FunctionAdapters[name]
+		metadata, hasMetadata := FunctionsMetadata[name]
+		if !hasDefinition || !hasAdapter || !hasMetadata {
+			problems = append(problems, fmt.Sprintf("function %v is registered but missing from the generated registry", name))
+			continue
+		}
+		if reflect.ValueOf(function).Pointer() != reflect.ValueOf(adapter.function).Pointer() {
+			problems = append(problems, fmt.Sprintf("function %v is registered with a different function than in the generated registry", name))
+		}
+		if !categories[definition.Category] {
+			problems = append(problems, fmt.Sprintf("function %v has unknown category %q", name, definition.Category))
+		}
+		if len(definition.Input) != len(adapter.Inputs) || len(definition.Output) != len(adapter.Outputs) ||
+			len(definition.Input) != len(metadata.Inputs) || len(definition.Output) != len(metadata.Outputs) {
+			problems = append(problems, fmt.Sprintf("definition, adapter and metadata of function %v have different inputs or outputs", name))
+			continue
+		}
+		for i, input := range definition.Input {
+			if input.Name != adapter.Inputs[i].Name || input.GoType != adapter.Inputs[i].GoType || input.Name != metadata.Inputs[i].Name {
+				problems = append(problems, fmt.Sprintf("generated registry of function %v differs for input %v", name, input.Name))
+			}
+		}
+		for i, output := range definition.Output {
+			if output.Name != adapter.Outputs[i].Name || output.GoType != adapter.Outputs[i].GoType || output.Name != metadata.Outputs[i].Name {
+				problems = append(problems, fmt.Sprintf("generated registry of function %v differs for output %v", name, output.Name))
+			}
+		}
+	}
+
+	for name := range FunctionDefinitions {
+		if _, registered := ExternalFunctionsMap[name]; !registered {
+			problems = append(problems, fmt.Sprintf("function %v is in the generated registry but not registered", name))
+		}
+	}
+	for name := range FunctionAdapters {
+		if _, hasDefinition := FunctionDefinitions[name]; !hasDefinition {
+			problems = append(problems, fmt.Sprintf("function %v has an adapter but no definition", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("function registry is out of date, run \"go generate ./pkg/externalfunctions\":\n  %v", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
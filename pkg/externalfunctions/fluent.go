@@ -24,6 +24,7 @@ package externalfunctions
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -39,19 +40,21 @@ import (
 //   - @displayName: Fluent Code Gen
 //
 // Parameters:
+//   - ctx: the request context
+//   - url: the URL of the Fluent container
 //   - message: the raw user message to send to the container
 //
 // Returns:
 //   - response: the response from the Fluent container as a string
-func FluentCodeGen(url string, message string) (response string) {
+func FluentCodeGen(ctx context.Context, url string, message string) (response string) {
 	// Create the JSON payload directly
 	jsonData := fmt.Sprintf(`{"message": "%s"}`, message)
 
 	// Create HTTP request
-	req, err := http.NewRequest("POST", url, bytes.NewBufferString(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBufferString(jsonData))
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error creating HTTP request: %v", err)
-		panic(fmt.Sprintf("Error creating HTTP request: %v", err))
+		panic(NewInternalError(err, "Error creating HTTP request: %v", err))
 	}
 
 	// Set headers
@@ -63,7 +66,7 @@ func FluentCodeGen(url string, message string) (response string) {
 	resp, err := client.Do(req)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error executing HTTP request: %v", err)
-		panic(fmt.Sprintf("Error executing HTTP request: %v", err))
+		panic(NewUpstreamUnavailableError(UpstreamHTTP, err, "Error executing HTTP request: %v", err))
 	}
 	defer resp.Body.Close()
 
@@ -71,20 +74,20 @@ func FluentCodeGen(url string, message string) (response string) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error reading response body: %v", err)
-		panic(fmt.Sprintf("Error reading response body: %v", err))
+		panic(NewInternalError(err, "Error reading response body: %v", err))
 	}
 
 	// Check if the response code is successful (2xx)
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		logging.Log.Errorf(&logging.ContextMap{}, "HTTP request failed with status code %d: %s", resp.StatusCode, string(body))
-		panic(fmt.Sprintf("HTTP request failed with status code %d: %s", resp.StatusCode, string(body)))
+		panic(NewUpstreamHTTPError(UpstreamHTTP, resp.StatusCode, "HTTP request failed with status code %d: %s", resp.StatusCode, string(body)))
 	}
 
 	// Parse JSON response to extract just the response content
 	var responseData map[string]interface{}
 	if err := json.Unmarshal(body, &responseData); err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error parsing JSON response: %v", err)
-		panic(fmt.Sprintf("Error parsing JSON response: %v", err))
+		panic(NewUpstreamError(UpstreamHTTP, err, "Error parsing JSON response: %v", err))
 	}
 
 	// Extract the response field
@@ -0,0 +1,422 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/typeconverters"
+)
+
+// packagePath is the import path of this package
+var packagePath = reflect.TypeFor[FunctionAdapter]().PkgPath()
+
+// FunctionAdapter calls an external function without reflection
+// The adapters are generated by internal/gen/registry/gen.go for every function in ExternalFunctionsMap.
+type FunctionAdapter struct {
+	Name     string
+	Inputs   []AdapterParameter
+	Outputs  []AdapterParameter
+	function any
+	call     func(ctx context.Context, inputs []any) []any
+}
+
+// AdapterParameter is an input or output of a function adapter
+// The GoType and Options are the same as in the function definition, Required and Default
+// are set for inputs marked with "@required" and "@default(...)" in the docstring of the function.
+// Type is the Go type of the parameter in the function signature.
+type AdapterParameter struct {
+	Name     string
+	GoType   string
+	Required bool
+	Default  string
+	Options  []string
+	Type     reflect.Type
+}
+
+// IsStream checks whether the parameter is a stream output, a pointer to a channel such as *chan string
+//
+// Returns:
+//   - bool: true for stream outputs
+func (parameter AdapterParameter) IsStream() bool {
+	if parameter.Type != nil {
+		return parameter.Type.Kind() == reflect.Pointer && parameter.Type.Elem().Kind() == reflect.Chan
+	}
+	return strings.HasPrefix(parameter.GoType, "*chan ")
+}
+
+// Enum is a string enumeration used by the inputs of the functions
+// The enumerations are generated with the adapters, see Enums.
+type Enum struct {
+	Name      string
+	Constants []EnumConstant
+}
+
+// EnumConstant is a declared constant of an enumeration
+type EnumConstant struct {
+	Name  string
+	Value string
+}
+
+// Values returns the declared values of the constants of the enumeration
+//
+// Returns:
+//   - []string: the values, in the order of declaration
+func (enum Enum) Values() []string {
+	values := make([]string, len(enum.Constants))
+	for i, constant := range enum.Constants {
+		values[i] = constant.Value
+	}
+	return values
+}
+
+// Contains checks whether a value is one of the declared values of the enumeration
+//
+// Parameters:
+//   - value: the value to check
+//
+// Returns:
+//   - bool: true if a constant of the enumeration has this value
+func (enum Enum) Contains(value string) bool {
+	return slices.Contains(enum.Values(), value)
+}
+
+// NewFunctionAdapter creates the adapter of a function that is not generated from ExternalFunctionsMap,
+// such as the macro functions
+// The call receives the decoded inputs in the order of the inputs and must return one value per output.
+//
+// Parameters:
+//   - name: the name of the function
+//   - inputs: the inputs of the function
+//   - outputs: the outputs of the function
+//   - call: calls the function, reporting failures by panicking like the external functions
+//
+// Returns:
+//   - FunctionAdapter: the adapter
+func NewFunctionAdapter(name string, inputs []AdapterParameter, outputs []AdapterParameter, call func(ctx context.Context, inputs []any) []any) FunctionAdapter {
+	return FunctionAdapter{Name: name, Inputs: inputs, Outputs: outputs, call: call}
+}
+
+// Call binds the inputs, calls the function and returns its outputs
+// The function reports failures by panicking, the panic is not recovered.
+//
+// Parameters:
+//   - ctx: the request context, passed to functions that accept one
+//   - inputs: the inputs of the request
+//
+// Returns:
+//   - []any: the outputs of the function
+//   - error: an error listing every invalid input
+func (adapter FunctionAdapter) Call(ctx context.Context, inputs []*aaliflowkitgrpc.FunctionInput) ([]any, error) {
+	values, err := adapter.Bind(inputs)
+	if err != nil {
+		return nil, err
+	}
+	return adapter.call(ctx, values), nil
+}
+
+// CallWithValues binds the inputs and the decoded values, calls the function and returns its outputs
+// It lets the outputs of a function be passed to another one without encoding them, see BindValues.
+// The function reports failures by panicking, the panic is not recovered.
+//
+// Parameters:
+//   - ctx: the request context, passed to functions that accept one
+//   - inputs: the encoded inputs
+//   - values: the decoded inputs by name
+//
+// Returns:
+//   - []any: the outputs of the function
+//   - error: an error listing every invalid input
+func (adapter FunctionAdapter) CallWithValues(ctx context.Context, inputs []*aaliflowkitgrpc.FunctionInput, values map[string]any) ([]any, error) {
+	decoded, err := adapter.BindValues(inputs, values)
+	if err != nil {
+		return nil, err
+	}
+	return adapter.call(ctx, decoded), nil
+}
+
+// AcceptsValue checks whether a decoded value can be passed as is to an input of the function
+// The value must have the exact type of the input in the function signature, or implement it for
+// interface inputs. String inputs, which include the enumerations, are always passed encoded so that
+// their options are checked.
+//
+// Parameters:
+//   - name: the name of the input
+//   - value: the decoded value
+//
+// Returns:
+//   - bool: true if the value can be bound with BindValues
+func (adapter FunctionAdapter) AcceptsValue(name string, value any) bool {
+	index := adapter.inputIndex(name)
+	if index < 0 || value == nil {
+		return false
+	}
+	inputType := adapter.Inputs[index].Type
+	if inputType == nil || inputType.Kind() == reflect.String {
+		return false
+	}
+	valueType := reflect.TypeOf(value)
+	return valueType == inputType || (inputType.Kind() == reflect.Interface && valueType.Implements(inputType))
+}
+
+// Bind matches the inputs of a request to the inputs of the function and decodes them
+// Inputs are matched by name, inputs without a name are matched by their position.
+// Missing optional inputs are passed to the function as their default value if they have one,
+// as zero values of their type otherwise.
+// All inputs are checked before returning, so that the error lists every invalid input:
+// unknown, duplicated and extra inputs, missing required inputs, values that are not
+// one of the options of the input and values that cannot be decoded.
+//
+// Parameters:
+//   - inputs: the inputs of the request
+//
+// Returns:
+//   - []any: the decoded inputs in the order of the function definition
+//   - error: an error listing every invalid input
+func (adapter FunctionAdapter) Bind(inputs []*aaliflowkitgrpc.FunctionInput) ([]any, error) {
+	return adapter.BindValues(inputs, nil)
+}
+
+// BindValues binds encoded inputs like Bind, along with inputs that are already decoded
+// The decoded values are matched by name and must be accepted by AcceptsValue.
+//
+// Parameters:
+//   - inputs: the encoded inputs
+//   - values: the decoded inputs by name
+//
+// Returns:
+//   - []any: the decoded inputs in the order of the function definition
+//   - error: an error listing every invalid input
+func (adapter FunctionAdapter) BindValues(inputs []*aaliflowkitgrpc.FunctionInput, values map[string]any) ([]any, error) {
+	violations := []InputViolation{}
+
+	// match the inputs to the parameters of the function
+	encoded := make([]*string, len(adapter.Inputs))
+	for position, input := range inputs {
+		if input == nil {
+			continue
+		}
+		index := position
+		if input.Name != "" {
+			index = adapter.inputIndex(input.Name)
+			if index < 0 {
+				violations = append(violations, InputViolation{Input: input.Name, Description: "unknown input"})
+				continue
+			}
+		} else if position >= len(adapter.Inputs) {
+			violations = append(violations, InputViolation{
+				Input:       fmt.Sprintf("inputs[%d]", position),
+				Description: fmt.Sprintf("function takes %d inputs but %d were given", len(adapter.Inputs), len(inputs)),
+			})
+			continue
+		}
+		if encoded[index] != nil {
+			violations = append(violations, InputViolation{Input: adapter.Inputs[index].Name, Description: "input given more than once"})
+			continue
+		}
+		encoded[index] = &input.Value
+	}
+
+	// the decoded values are passed as is
+	decoded := make([]any, len(adapter.Inputs))
+	bound := make([]bool, len(adapter.Inputs))
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		value := values[name]
+		index := adapter.inputIndex(name)
+		switch {
+		case index < 0:
+			violations = append(violations, InputViolation{Input: name, Description: "unknown input"})
+		case encoded[index] != nil:
+			violations = append(violations, InputViolation{Input: name, Description: "input given more than once"})
+		case !adapter.AcceptsValue(name, value):
+			violations = append(violations, InputViolation{
+				Input:       name,
+				Description: fmt.Sprintf("value of type %T cannot be passed as type '%s'", value, adapter.Inputs[index].GoType),
+			})
+			bound[index] = true
+		default:
+			decoded[index] = value
+			bound[index] = true
+		}
+	}
+
+	// validate and decode the values
+	for i, param := range adapter.Inputs {
+		if bound[i] {
+			continue
+		}
+		value := encoded[i]
+		if value == nil && param.Default != "" {
+			value = &param.Default
+		}
+		if value == nil {
+			if param.Required {
+				violations = append(violations, InputViolation{Input: param.Name, Description: "required input is missing"})
+			}
+			continue
+		}
+		if len(param.Options) > 0 && !slices.Contains(param.Options, *value) && (param.Required || *value != "") {
+			violations = append(violations, InputViolation{
+				Input:       param.Name,
+				Description: fmt.Sprintf("value '%s' is not one of the options %s", *value, strings.Join(param.Options, ", ")),
+			})
+			continue
+		}
+		decodedValue, err := param.decode(*value)
+		if err != nil {
+			violations = append(violations, InputViolation{
+				Input:       param.Name,
+				Description: fmt.Sprintf("error converting value to type '%s': %v", param.GoType, err),
+			})
+			continue
+		}
+		decoded[i] = decodedValue
+	}
+
+	if len(violations) > 0 {
+		return nil, NewInvalidInputsError(adapter.Name, violations)
+	}
+	return decoded, nil
+}
+
+// decode decodes the string value of an input
+// The struct types declared in this package are unknown to the type converters of aali-sharedtypes,
+// they are decoded from their JSON value.
+//
+// Parameters:
+//   - value: the string value of the input
+//
+// Returns:
+//   - any: the decoded value
+//   - error: an error if the value cannot be decoded
+func (parameter AdapterParameter) decode(value string) (any, error) {
+	if parameter.Type == nil || parameter.Type.Kind() != reflect.Struct || parameter.Type.PkgPath() != packagePath {
+		return typeconverters.ConvertStringToGivenType(value, parameter.GoType)
+	}
+
+	decoded := reflect.New(parameter.Type)
+	if value != "" {
+		err := json.Unmarshal([]byte(value), decoded.Interface())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return decoded.Elem().Interface(), nil
+}
+
+// inputIndex returns the index of an input of the function
+//
+// Parameters:
+//   - name: the name of the input
+//
+// Returns:
+//   - int: the index of the input, -1 if the function has no input with this name
+func (adapter FunctionAdapter) inputIndex(name string) int {
+	return slices.IndexFunc(adapter.Inputs, func(input AdapterParameter) bool {
+		return input.Name == name
+	})
+}
+
+// EncodeOutput encodes an output of the function to its string value
+//
+// Parameters:
+//   - index: the index of the output
+//   - value: the output returned by Call
+//
+// Returns:
+//   - string: the string value of the output
+//   - error: an error if the output cannot be encoded
+func (adapter FunctionAdapter) EncodeOutput(index int, value any) (string, error) {
+	output := adapter.Outputs[index]
+	encoded, err := typeconverters.ConvertGivenTypeToString(value, output.GoType)
+	if err != nil {
+		return "", NewInternalError(err, "error converting output %s to string: %v", output.Name, err).WithFunction(adapter.Name)
+	}
+	return encoded, nil
+}
+
+// inputValue returns a decoded input of a function adapter
+// Missing inputs are returned as the zero value of their type.
+//
+// Parameters:
+//   - inputs: the decoded inputs
+//   - index: the index of the input
+//   - name: the name of the input
+//
+// Returns:
+//   - T: the input
+func inputValue[T any](inputs []any, index int, name string) T {
+	var value T
+	if inputs[index] == nil {
+		return value
+	}
+	value, ok := inputs[index].(T)
+	if !ok {
+		panic(NewInvalidInputError(name, "input '%s' has type %T, expected %T", name, inputs[index], value))
+	}
+	return value
+}
+
+// enumValue returns a decoded string enumeration input of a function adapter
+// Missing inputs are returned as the zero value of the enumeration, other values must be declared constants of the enumeration.
+//
+// Parameters:
+//   - inputs: the decoded inputs
+//   - index: the index of the input
+//   - name: the name of the input
+//   - enumName: the name of the enumeration in Enums
+//
+// Returns:
+//   - T: the input
+func enumValue[T ~string](inputs []any, index int, name string, enumName string) T {
+	value := inputValue[string](inputs, index, name)
+	if value != "" && !Enums[enumName].Contains(value) {
+		panic(NewInvalidInputError(name, "value '%s' of input '%s' is not one of the options %s", value, name, strings.Join(Enums[enumName].Values(), ", ")))
+	}
+	return T(value)
+}
+
+// inputMap returns a decoded map input of a function adapter
+// Missing inputs are returned as empty maps, so that the function can write to them.
+//
+// Parameters:
+//   - inputs: the decoded inputs
+//   - index: the index of the input
+//   - name: the name of the input
+//
+// Returns:
+//   - M: the input
+func inputMap[M ~map[K]V, K comparable, V any](inputs []any, index int, name string) M {
+	value := inputValue[M](inputs, index, name)
+	if value == nil {
+		value = M{}
+	}
+	return value
+}
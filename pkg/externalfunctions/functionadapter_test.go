@@ -0,0 +1,218 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckRegistry(t *testing.T) {
+	if err := CheckRegistry(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFunctionAdapterMissingInputs(t *testing.T) {
+	tests := []string{"AppendStringSlices", "AisChangeAcsResponsesByFactor", "AnsysGPTExtractFieldsFromQuery"}
+
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			adapter := FunctionAdapters[name]
+			outputs := adapter.call(context.Background(), make([]any, len(adapter.Inputs)))
+			if len(outputs) != len(adapter.Outputs) {
+				t.Errorf("got %d outputs, want %d", len(outputs), len(adapter.Outputs))
+			}
+		})
+	}
+}
+
+func TestFunctionAdapterEnumInput(t *testing.T) {
+	adapter := FunctionAdapters["AppendMessageHistory"]
+	outputs := adapter.call(context.Background(), []any{"hello", "user", []sharedtypes.HistoricMessage{}})
+
+	history := outputs[0].([]sharedtypes.HistoricMessage)
+	if len(history) != 1 || history[0].Role != "user" || history[0].Content != "hello" {
+		t.Errorf("AppendMessageHistory() = %+v", history)
+	}
+}
+
+func TestFunctionAdapterInvalidEnumInput(t *testing.T) {
+	defer func() {
+		err := ErrorFromPanic(recover())
+		if err.Code != codes.InvalidArgument || err.Input != "role" {
+			t.Errorf("got error %v (%v, input %q), want an invalid input error for role", err, err.Code, err.Input)
+		}
+	}()
+
+	adapter := FunctionAdapters["AppendMessageHistory"]
+	adapter.call(context.Background(), []any{"hello", "admin", []sharedtypes.HistoricMessage{}})
+}
+
+func TestFunctionAdapterWrongInputType(t *testing.T) {
+	defer func() {
+		err := ErrorFromPanic(recover())
+		if err.Code != codes.InvalidArgument || err.Input != "slice1" {
+			t.Errorf("got error %v (%v, input %q), want an invalid input error for slice1", err, err.Code, err.Input)
+		}
+	}()
+
+	adapter := FunctionAdapters["AppendStringSlices"]
+	adapter.call(context.Background(), []any{42, nil, nil, nil, nil})
+}
+
+func TestFunctionAdapterBind(t *testing.T) {
+	adapter := FunctionAdapter{
+		Name: "TestFunction",
+		Inputs: []AdapterParameter{
+			{Name: "query", GoType: "string", Required: true},
+			{Name: "maxResults", GoType: "int", Default: "10"},
+			{Name: "role", GoType: "string", Options: []string{"user", "assistant"}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		inputs     []*aaliflowkitgrpc.FunctionInput
+		want       []any
+		violations []string
+	}{
+		{
+			name:   "by name in any order",
+			inputs: []*aaliflowkitgrpc.FunctionInput{{Name: "role", Value: "user"}, {Name: "query", Value: "q"}, {Name: "maxResults", Value: "3"}},
+			want:   []any{"q", 3, "user"},
+		},
+		{
+			name:   "by position without names",
+			inputs: []*aaliflowkitgrpc.FunctionInput{{Value: "q"}, {Value: "3"}},
+			want:   []any{"q", 3, nil},
+		},
+		{
+			name:   "missing input with default",
+			inputs: []*aaliflowkitgrpc.FunctionInput{{Name: "query", Value: "q"}},
+			want:   []any{"q", 10, nil},
+		},
+		{
+			name:       "unknown and duplicated inputs",
+			inputs:     []*aaliflowkitgrpc.FunctionInput{{Name: "query", Value: "q"}, {Name: "query", Value: "q"}, {Name: "limit", Value: "3"}},
+			violations: []string{"query", "limit"},
+		},
+		{
+			name:       "too many inputs",
+			inputs:     []*aaliflowkitgrpc.FunctionInput{{Value: "q"}, {Value: "3"}, {Value: "user"}, {Value: "extra"}},
+			violations: []string{"inputs[3]"},
+		},
+		{
+			name:       "every invalid input is reported",
+			inputs:     []*aaliflowkitgrpc.FunctionInput{{Name: "maxResults", Value: "three"}, {Name: "role", Value: "admin"}},
+			violations: []string{"query", "maxResults", "role"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := adapter.Bind(tt.inputs)
+			if tt.violations == nil {
+				if err != nil {
+					t.Fatalf("Bind() error = %v", err)
+				}
+				if !reflect.DeepEqual(values, tt.want) {
+					t.Errorf("Bind() = %v, want %v", values, tt.want)
+				}
+				return
+			}
+
+			var functionError *FunctionError
+			if !errors.As(err, &functionError) || functionError.Code != codes.InvalidArgument {
+				t.Fatalf("Bind() error = %v, want an invalid input error", err)
+			}
+			inputs := []string{}
+			for _, violation := range functionError.Violations {
+				inputs = append(inputs, violation.Input)
+			}
+			if !reflect.DeepEqual(inputs, tt.violations) {
+				t.Errorf("violations = %v, want %v", inputs, tt.violations)
+			}
+		})
+	}
+}
+
+func TestFunctionAdapterBindValues(t *testing.T) {
+	adapter := FunctionAdapter{
+		Name: "TestFunction",
+		Inputs: []AdapterParameter{
+			{Name: "query", GoType: "string", Required: true, Type: reflect.TypeFor[string]()},
+			{Name: "vector", GoType: "[]float32", Required: true, Type: reflect.TypeFor[[]float32]()},
+		},
+	}
+
+	// decoded values of the exact type are passed as is, strings are always encoded
+	vector := []float32{0.5, 1}
+	if !adapter.AcceptsValue("vector", vector) || adapter.AcceptsValue("query", "q") || adapter.AcceptsValue("vector", []float64{0.5}) {
+		t.Error("AcceptsValue() accepted the wrong values")
+	}
+	values, err := adapter.BindValues([]*aaliflowkitgrpc.FunctionInput{{Name: "query", Value: "q"}}, map[string]any{"vector": vector})
+	if err != nil {
+		t.Fatalf("BindValues() error = %v", err)
+	}
+	if !reflect.DeepEqual(values, []any{"q", vector}) {
+		t.Errorf("BindValues() = %v", values)
+	}
+
+	// a value of another type or given twice is reported like an invalid input
+	_, err = adapter.BindValues([]*aaliflowkitgrpc.FunctionInput{{Name: "query", Value: "q"}}, map[string]any{"query": "q", "vector": []float64{0.5}})
+	var functionError *FunctionError
+	if !errors.As(err, &functionError) || len(functionError.Violations) != 2 {
+		t.Errorf("BindValues() error = %v, want two violations", err)
+	}
+}
+
+func TestFunctionAdapterBindStructInput(t *testing.T) {
+	adapter := FunctionAdapters["PerformLLMRequest"]
+
+	// the struct types of this package are decoded from JSON
+	values, err := adapter.Bind([]*aaliflowkitgrpc.FunctionInput{
+		{Name: "input", Value: "hello"},
+		{Name: "options", Value: `{"systemPrompt": "be brief", "modelIds": ["gpt-4o"], "tokenCountModelName": "gpt-4o"}`},
+	})
+	if err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	want := LLMRequestOptions{SystemPrompt: "be brief", ModelIds: []string{"gpt-4o"}, TokenCountModelName: "gpt-4o"}
+	if !reflect.DeepEqual(values[1], want) {
+		t.Errorf("Bind() options = %+v, want %+v", values[1], want)
+	}
+
+	// an invalid JSON value is reported like an invalid input
+	_, err = adapter.Bind([]*aaliflowkitgrpc.FunctionInput{{Name: "input", Value: "hello"}, {Name: "options", Value: "{"}})
+	var functionError *FunctionError
+	if !errors.As(err, &functionError) || len(functionError.Violations) != 1 || functionError.Violations[0].Input != "options" {
+		t.Errorf("Bind() error = %v, want a violation for options", err)
+	}
+}
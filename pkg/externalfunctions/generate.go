@@ -22,4 +22,7 @@
 
 //go:generate go run ../../internal/gen/cast/gen.go
 
+// The registry is generated for all functions, including the generated cast functions
+//go:generate go run ../../internal/gen/registry/gen.go
+
 package externalfunctions
@@ -24,6 +24,7 @@ package externalfunctions
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -43,25 +44,26 @@ import (
 //   - @displayName: REST Call
 //
 // Parameters:
+//   - ctx: the request context
 //   - requestType: the type of the request (GET, POST, PUT, PATCH, DELETE)
-//   - urlString: the URL to send the request to
-//   - headers: the headers to include in the request
+//   - endpoint: the URL to send the request to
+//   - header: the headers to include in the request
 //   - query: the query parameters to include in the request
 //   - jsonBody: the body of the request as a JSON string
 //
 // Returns:
 //   - success: a boolean indicating whether the request was successful
 //   - returnJsonBody: the JSON body of the response as a string
-func SendRestAPICall(requestType string, endpoint string, header map[string]string, query map[string]string, jsonBody string) (success bool, returnJsonBody string) {
+func SendRestAPICall(ctx context.Context, requestType string, endpoint string, header map[string]string, query map[string]string, jsonBody string) (success bool, returnJsonBody string) {
 	// verify correct request type
 	if requestType != "GET" && requestType != "POST" && requestType != "PUT" && requestType != "PATCH" && requestType != "DELETE" {
-		panic(fmt.Sprintf("Invalid request type: %v", requestType))
+		panic(NewInvalidInputError("requestType", "Invalid request type: %v", requestType))
 	}
 
 	// Parse the URL and add query parameters
 	parsedURL, err := url.Parse(endpoint)
 	if err != nil {
-		panic(fmt.Sprintf("Error parsing URL: %v", err))
+		panic(NewInvalidInputError("endpoint", "Error parsing URL: %v", err))
 	}
 
 	q := parsedURL.Query()
@@ -73,12 +75,12 @@ func SendRestAPICall(requestType string, endpoint string, header map[string]stri
 	// Create the HTTP request
 	var req *http.Request
 	if jsonBody != "" {
-		req, err = http.NewRequest(requestType, parsedURL.String(), bytes.NewBuffer([]byte(jsonBody)))
+		req, err = http.NewRequestWithContext(ctx, requestType, parsedURL.String(), bytes.NewBuffer([]byte(jsonBody)))
 	} else {
-		req, err = http.NewRequest(requestType, parsedURL.String(), nil)
+		req, err = http.NewRequestWithContext(ctx, requestType, parsedURL.String(), nil)
 	}
 	if err != nil {
-		panic(fmt.Sprintf("Error creating request: %v", err))
+		panic(NewInternalError(err, "Error creating request: %v", err))
 	}
 
 	// Add headers
@@ -90,14 +92,14 @@ func SendRestAPICall(requestType string, endpoint string, header map[string]stri
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		panic(fmt.Sprintf("Error executing request: %v", err))
+		panic(NewUpstreamUnavailableError(UpstreamHTTP, err, "Error executing request: %v", err))
 	}
 	defer resp.Body.Close()
 
 	// Read the response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		panic(fmt.Sprintf("Error reading response body: %v", err))
+		panic(NewInternalError(err, "Error reading response body: %v", err))
 	}
 
 	// Check if the response code is successful (2xx)
@@ -131,7 +133,7 @@ func PrintFeedback(feedback sharedtypes.Feedback) {
 	// create json string from feedback struct
 	jsonString, err := json.Marshal(feedback)
 	if err != nil {
-		panic(fmt.Sprintf("Error marshalling feedback to JSON: %v", err))
+		panic(NewInternalError(err, "Error marshalling feedback to JSON: %v", err))
 	}
 	// print json string to console
 	fmt.Println(string(jsonString))
@@ -152,7 +154,7 @@ func PrintFeedback(feedback sharedtypes.Feedback) {
 func ExtractJSONStringField(jsonStr string, keyPath string) string {
 	var data map[string]interface{}
 	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
-		panic(fmt.Sprintf("Error unmarshalling JSON: %v", err))
+		panic(NewInvalidInputError("jsonStr", "Error unmarshalling JSON: %v", err))
 	}
 
 	keys := strings.Split(keyPath, ".")
@@ -161,11 +163,11 @@ func ExtractJSONStringField(jsonStr string, keyPath string) string {
 	for _, key := range keys {
 		m, ok := current.(map[string]interface{})
 		if !ok {
-			panic(fmt.Sprintf("Expected map for key %q but got %T", key, current))
+			panic(NewInvalidInputError("keyPath", "Expected map for key %q but got %T", key, current))
 		}
 		current, ok = m[key]
 		if !ok {
-			panic(fmt.Sprintf("Key %q not found in JSON", key))
+			panic(NewNotFoundError("", "Key %q not found in JSON", key))
 		}
 	}
 
@@ -177,7 +179,7 @@ func ExtractJSONStringField(jsonStr string, keyPath string) string {
 		// Try to marshal the value back to a JSON string
 		bytes, err := json.Marshal(v)
 		if err != nil {
-			panic(fmt.Sprintf("Unable to convert final value to string: %v", err))
+			panic(NewInternalError(err, "Unable to convert final value to string: %v", err))
 		}
 		return string(bytes)
 	}
@@ -200,9 +202,9 @@ func GenerateUUID() string {
 //   - @displayName: JSON Path
 //
 // Parameters:
-//   - pat (string): The JSON Path pattern
-//   - data (any): The data to extract from
-//   - oneResult (bool): Whether you are expecting to extract 1 result or an array of results
+//   - pat: The JSON Path pattern
+//   - data: The data to extract from
+//   - oneResult: Whether you are expecting to extract 1 result or an array of results
 //     If you set oneResult=true but there are not exactle 1 result in the output, you will
 //     receive an error. This should only be set if the result is guaranteed to have length 1.
 //
@@ -214,11 +216,11 @@ func JsonPath(pat string, data any, oneResult bool) any {
 	pat = fmt.Sprintf("{ %v }", pat)
 	err := jpath.Parse(pat)
 	if err != nil {
-		logPanic(nil, "could not parse the provided JSONPath %q: %v", pat, err)
+		logPanicError(nil, NewInvalidInputError("pat", "could not parse the provided JSONPath %q: %v", pat, err))
 	}
 	res, err := jpath.FindResults(data)
 	if err != nil {
-		logPanic(nil, "could not find JSONPath results with pattern %q in data %#v: %v", pat, data, err)
+		logPanicError(nil, NewNotFoundError("", "could not find JSONPath results with pattern %q in data %#v: %v", pat, data, err))
 	}
 
 	if len(res) != 1 {
@@ -229,7 +231,7 @@ func JsonPath(pat string, data any, oneResult bool) any {
 	reflectVals := res[0]
 	if oneResult {
 		if len(reflectVals) != 1 {
-			logPanic(nil, "specified 1 result but found %d", len(reflectVals))
+			logPanicError(nil, NewInvalidInputError("oneResult", "specified 1 result but found %d", len(reflectVals)))
 		}
 		return reflectVals[0].Interface()
 	} else {
@@ -246,10 +248,10 @@ func JsonPath(pat string, data any, oneResult bool) any {
 // Tags:
 //   - @displayName: Concatenate Strings
 //
-// Parameters
-//   - a (string) the first string
-//   - b (string) the second string
-//   - separator (string) the separator string. If not provided, will be an empty string.
+// Parameters:
+//   - a: the first string
+//   - b: the second string
+//   - separator: the separator string. If not provided, will be an empty string.
 func StringConcat(a string, b string, separator string) string {
 	return fmt.Sprintf("%v%v%v", a, separator, b)
 }
@@ -261,9 +263,9 @@ func StringConcat(a string, b string, separator string) string {
 // Tags:
 //   - @displayName: Format data as string
 //
-// Parameters
-//   - data (any): the data to format as a string
-//   - format (string): the format specifier to use. If not provided will default to "%v".
+// Parameters:
+//   - data: the data to format as a string
+//   - format: the format specifier to use. If not provided will default to "%v".
 //     See the [go fmt docs](https://pkg.go.dev/fmt) for details.
 func StringFormat(data any, format string) string {
 	if format == "" {
@@ -279,11 +281,11 @@ func StringFormat(data any, format string) string {
 //   - @displayName: Parse Slash Commands
 //
 // Parameters:
-//   - input (string): The input string containing slash commands.
+//   - input: The input string containing slash commands.
 //
 // Returns:
-//   - slashCommands (map[string]string): A map of parsed slash commands. The keys are the scopes and the values are the corresponding commands.
-//     If no scope is provided, the key will be 'global'.
+//   - slashCommands: The parsed slash commands, each with its scope and command.
+//     If no scope is provided, the scope will be 'global'.
 func ParseSlashCommands(input string) (slashCommands []sharedtypes.SlashCommand) {
 	// Split input into lines to handle multiple commands
 	lines := strings.Split(input, "\n")
@@ -0,0 +1,78 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+// ClientIdentity is the identity of a client, verified with its TLS client certificate
+// It is set by the gRPC server when mutual TLS is enabled, see ClientIdentityFromContext.
+type ClientIdentity struct {
+	CommonName     string   `json:"commonName,omitempty"`
+	DNSNames       []string `json:"dnsNames,omitempty"`
+	URIs           []string `json:"uris,omitempty"`
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+}
+
+// String returns the common name of the client, or its subject alternative names if it has none
+//
+// Returns:
+//   - string: the name of the client
+func (identity ClientIdentity) String() string {
+	if identity.CommonName != "" {
+		return identity.CommonName
+	}
+	return strings.Join(slices.Concat(identity.DNSNames, identity.URIs, identity.EmailAddresses), ",")
+}
+
+// clientIdentityContextKey is the context key of the client identity
+type clientIdentityContextKey struct{}
+
+// ContextWithClientIdentity returns a copy of the context carrying the identity of the client
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - identity: the verified identity of the client
+//
+// Returns:
+//   - context.Context: the context carrying the identity
+func ContextWithClientIdentity(ctx context.Context, identity ClientIdentity) context.Context {
+	return context.WithValue(ctx, clientIdentityContextKey{}, identity)
+}
+
+// ClientIdentityFromContext returns the verified identity of the client calling a function
+// Functions that accept a context can use it to authorize or audit the caller.
+//
+// Parameters:
+//   - ctx: the context passed to the function
+//
+// Returns:
+//   - ClientIdentity: the identity of the client
+//   - bool: false if the client did not authenticate with a certificate
+func ClientIdentityFromContext(ctx context.Context) (ClientIdentity, bool) {
+	identity, ok := ctx.Value(clientIdentityContextKey{}).(ClientIdentity)
+	return identity, ok
+}
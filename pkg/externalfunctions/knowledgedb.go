@@ -29,6 +29,7 @@ import (
 
 	"github.com/ansys/aali-flowkit/pkg/privatefunctions/graphdb"
 	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
 	"github.com/ansys/aali-sharedtypes/pkg/aali_graphdb"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
@@ -43,6 +44,7 @@ import (
 //   - @displayName: Similarity Search
 //
 // Parameters:
+//   - ctx: the request context
 //   - vector: the vector to be sent to the KnowledgeDB
 //   - keywords: the keywords to be used to filter the results
 //   - keywordsSearch: the flag to enable the keywords search
@@ -53,14 +55,14 @@ import (
 //
 // Returns:
 //   - databaseResponse: an array of the most relevant data
-func SendVectorsToKnowledgeDB(vector []float32, keywords []string, keywordsSearch bool, collection string, similaritySearchResults int, similaritySearchMinScore float64, sparseVector map[uint]float32) (databaseResponse []sharedtypes.DbResponse) {
+func SendVectorsToKnowledgeDB(ctx context.Context, vector []float32, keywords []string, keywordsSearch bool, collection string, similaritySearchResults int, similaritySearchMinScore float64, sparseVector map[uint]float32) (databaseResponse []sharedtypes.DbResponse) {
 	// Use the provided sparse vector directly (will be empty map if not provided)
 	sparse := sparseVector
 
 	logCtx := &logging.ContextMap{}
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(logCtx, "unable to create qdrant client: %q", err)
+		logPanicError(logCtx, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 	// Pure vector similarity search across all collection types
 	filter := qdrant.Filter{}
@@ -115,9 +117,9 @@ func SendVectorsToKnowledgeDB(vector []float32, keywords []string, keywordsSearc
 	}
 
 	// Execute query
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
-		logPanic(logCtx, "error in qdrant query: %q", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error in qdrant query: %q", err))
 	}
 
 	// Transform results
@@ -129,7 +131,7 @@ func SendVectorsToKnowledgeDB(vector []float32, keywords []string, keywordsSearc
 		if err != nil {
 			errMsg := fmt.Sprintf("error converting qdrant payload to dbResponse: %q", err)
 			logging.Log.Errorf(logCtx, "%s", errMsg)
-			panic(errMsg)
+			panic(NewInternalError(err, "%s", errMsg))
 		}
 
 		logging.Log.Debugf(&logging.ContextMap{}, "Similarity file id: %v", dbResponse.DocumentId)
@@ -167,20 +169,20 @@ func createSparseQuery(sparseVector map[uint]float32) *qdrant.Query {
 // The function returns the list of collections.
 //
 // Parameters:
-//   - knowledgeDbEndpoint: the KnowledgeDB endpoint
+//   - ctx: the request context
 //
 // Returns:
 //   - collectionsList: the list of collections
-func GetListCollections() (collectionsList []string) {
+func GetListCollections(ctx context.Context) (collectionsList []string) {
 	logCtx := &logging.ContextMap{}
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(logCtx, "unable to create qdrant client: %q", err)
+		logPanicError(logCtx, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
-	collectionsList, err = client.ListCollections(context.TODO())
+	collectionsList, err = client.ListCollections(ctx)
 	if err != nil {
-		logPanic(logCtx, "unable to list qdrant collections: %q", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "unable to list qdrant collections: %q", err))
 	}
 	return collectionsList
 }
@@ -193,6 +195,7 @@ func GetListCollections() (collectionsList []string) {
 //   - @displayName: Retrieve Dependencies
 //
 // Parameters:
+//   - ctx: the request context.
 //   - dbname: the name of the graphdb to target. If not provided, defaults to "aali".
 //   - relationshipName: the name of the relationship to retrieve dependencies for.
 //   - relationshipDirection: the direction of the relationship to retrieve dependencies for.
@@ -203,16 +206,21 @@ func GetListCollections() (collectionsList []string) {
 // Returns:
 //   - dependenciesIds: the list of dependencies
 func RetrieveDependencies(
+	ctx context.Context,
 	dbname string,
 	relationshipName string,
 	relationshipDirection string,
 	sourceDocumentId string,
 	nodeTypesFilter sharedtypes.DbArrayFilter,
 	maxHopsNumber int) (dependenciesIds []string) {
-	ctx := &logging.ContextMap{}
+	logCtx := &logging.ContextMap{}
+
+	checkGraphDbContext(ctx, "retrieving dependencies")
+
 	dbname = graphDbNameOrDefault(dbname)
+	span := startGraphDbSpan(ctx, "RetrieveDependencies", dbname, "")
 	dependenciesIds, err := graphdb.GraphDbDriver.RetrieveDependencies(
-		ctx,
+		logCtx,
 		dbname,
 		relationshipName,
 		relationshipDirection,
@@ -221,8 +229,9 @@ func RetrieveDependencies(
 		[]string{},
 		maxHopsNumber,
 	)
+	telemetry.EndSpan(span, err)
 	if err != nil {
-		logPanic(nil, "unable to retrieve dependencies: %q", err)
+		logPanicError(nil, NewUpstreamError(UpstreamGraphDB, err, "unable to retrieve dependencies: %q", err))
 	}
 	return dependenciesIds
 }
@@ -244,7 +253,7 @@ func AddGraphDbParameter(parameters aali_graphdb.ParameterMap, name string, valu
 	valType := sharedtypes.GraphDbValueType(strings.ToLower(paramType))
 	val, err := valType.Parse(value)
 	if err != nil {
-		logPanic(nil, "could not build graph DB parameter: %v", err)
+		logPanicError(nil, NewInvalidInputError("value", "could not build graph DB parameter: %v", err))
 	}
 	parameters[name] = val
 	return parameters
@@ -258,22 +267,27 @@ func AddGraphDbParameter(parameters aali_graphdb.ParameterMap, name string, valu
 //   - @displayName: General Graph DB Query
 //
 // Parameters:
+//   - ctx: the request context.
 //   - dbname: the name of the graphdb to target. If not provided, defaults to "aali".
 //   - query: the Cypher query to be executed.
 //   - parameters: parameters to pass to the query during execution
 //
 // Returns:
 //   - databaseResponse: the graph db response
-func GeneralGraphDbQuery(dbname string, query string, parameters aali_graphdb.ParameterMap) []map[string]any {
+func GeneralGraphDbQuery(ctx context.Context, dbname string, query string, parameters aali_graphdb.ParameterMap) []map[string]any {
+	checkGraphDbContext(ctx, "executing cypher query")
+
 	// Initialize the graph database.
 	dbname = graphDbNameOrDefault(dbname)
 	err := graphdb.Initialize(config.GlobalConfig.GRAPHDB_ADDRESS, dbname)
 	if err != nil {
-		logPanic(nil, "error initializing graphdb: %v", err)
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamGraphDB, err, "error initializing graphdb: %v", err))
 	}
+	span := startGraphDbSpan(ctx, "CypherQueryWrite", dbname, query)
 	res, err := graphdb.GraphDbDriver.WriteCypherQuery(dbname, query, parameters)
+	telemetry.EndSpan(span, err)
 	if err != nil {
-		logPanic(nil, "error executing cypher query: %q", err)
+		logPanicError(nil, NewUpstreamError(UpstreamGraphDB, err, "error executing cypher query: %q", err))
 	}
 	return res
 }
@@ -286,6 +300,7 @@ func GeneralGraphDbQuery(dbname string, query string, parameters aali_graphdb.Pa
 //   - @displayName: Query
 //
 // Parameters:
+//   - ctx: the request context.
 //   - collectionName: the name of the collection to which the data objects will be added.
 //   - maxRetrievalCount: the maximum number of results to be retrieved.
 //   - outputFields: the fields to be included in the output.
@@ -293,11 +308,11 @@ func GeneralGraphDbQuery(dbname string, query string, parameters aali_graphdb.Pa
 //
 // Returns:
 //   - databaseResponse: the query results
-func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []string, filters sharedtypes.DbFilters) (databaseResponse []sharedtypes.DbResponse) {
+func GeneralQuery(ctx context.Context, collectionName string, maxRetrievalCount int, outputFields []string, filters sharedtypes.DbFilters) (databaseResponse []sharedtypes.DbResponse) {
 	logCtx := &logging.ContextMap{}
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(logCtx, "unable to create qdrant client: %q", err)
+		logPanicError(logCtx, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
 	// perform the qdrant query
@@ -310,9 +325,9 @@ func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []s
 		WithVectors:    qdrant.NewWithVectorsEnable(false),
 		WithPayload:    qdrant.NewWithPayloadInclude(outputFields...),
 	}
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
-		logPanic(logCtx, "error in qdrant query: %q", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error in qdrant query: %q", err))
 	}
 	logging.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
 
@@ -337,10 +352,10 @@ func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []s
 //   - @displayName: Similarity Search (Filtered)
 //
 // Parameters:
+//   - ctx: the request context.
 //   - collectionName: the name of the collection to which the data objects will be added.
 //   - embeddedVector: the embedded vector used for searching.
 //   - maxRetrievalCount: the maximum number of results to be retrieved.
-//   - outputFields: the fields to be included in the output.
 //   - filters: the filter for the query.
 //   - minScore: the minimum score filter.
 //   - getLeafNodes: flag to indicate whether to retrieve all the leaf nodes in the result node branch.
@@ -351,6 +366,7 @@ func GeneralQuery(collectionName string, maxRetrievalCount int, outputFields []s
 // Returns:
 //   - databaseResponse: the similarity search results
 func SimilaritySearch(
+	ctx context.Context,
 	collectionName string,
 	embeddedVector []float32,
 	maxRetrievalCount int,
@@ -363,7 +379,7 @@ func SimilaritySearch(
 	logCtx := &logging.ContextMap{}
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(logCtx, "unable to create qdrant client: %q", err)
+		logPanicError(logCtx, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
 	// perform the qdrant query
@@ -378,9 +394,9 @@ func SimilaritySearch(
 		WithVectors:    qdrant.NewWithVectorsEnable(false),
 		WithPayload:    qdrant.NewWithPayloadEnable(true),
 	}
-	scoredPoints, err := client.Query(context.TODO(), &query)
+	scoredPoints, err := client.Query(ctx, &query)
 	if err != nil {
-		logPanic(logCtx, "error in qdrant query: %q", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error in qdrant query: %q", err))
 	}
 	logging.Log.Debugf(logCtx, "Got %d points from qdrant query", len(scoredPoints))
 
@@ -403,30 +419,30 @@ func SimilaritySearch(
 	// get related nodes if requested
 	if getLeafNodes {
 		logging.Log.Debugf(logCtx, "getting leaf nodes")
-		err := qdrant_utils.RetrieveLeafNodes(logCtx, client, collectionName, &databaseResponse)
+		err := qdrant_utils.RetrieveLeafNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
-			logPanic(logCtx, "error getting leaf nodes: %q", err)
+			logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error getting leaf nodes: %q", err))
 		}
 	}
 	if getSiblings {
 		logging.Log.Debugf(logCtx, "getting sibling nodes")
-		err := qdrant_utils.RetrieveDirectSiblingNodes(logCtx, client, collectionName, &databaseResponse)
+		err := qdrant_utils.RetrieveDirectSiblingNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
-			logPanic(logCtx, "error getting sibling nodes: %q", err)
+			logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error getting sibling nodes: %q", err))
 		}
 	}
 	if getParent {
 		logging.Log.Debugf(logCtx, "getting parent nodes")
-		err := qdrant_utils.RetrieveParentNodes(logCtx, client, collectionName, &databaseResponse)
+		err := qdrant_utils.RetrieveParentNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
-			logPanic(logCtx, "error getting parent nodes: %q", err)
+			logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error getting parent nodes: %q", err))
 		}
 	}
 	if getChildren {
 		logging.Log.Debugf(logCtx, "getting child nodes")
-		err := qdrant_utils.RetrieveChildNodes(logCtx, client, collectionName, &databaseResponse)
+		err := qdrant_utils.RetrieveChildNodes(ctx, logCtx, client, collectionName, &databaseResponse)
 		if err != nil {
-			logPanic(logCtx, "error getting child nodes: %q", err)
+			logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error getting child nodes: %q", err))
 		}
 	}
 	return databaseResponse
@@ -571,9 +587,10 @@ func CreateDbFilter(
 //   - @displayName: Add Data
 //
 // Parameters:
+//   - ctx: the request context.
 //   - collectionName: name of the collection the request is sent to.
-//   - data: the data to add.
-func AddDataRequest(collectionName string, documentData []sharedtypes.DbData) {
+//   - documentData: the data to add.
+func AddDataRequest(ctx context.Context, collectionName string, documentData []sharedtypes.DbData) {
 	points := make([]*qdrant.PointStruct, len(documentData))
 	for i, doc := range documentData {
 		id := qdrant.NewIDUUID(doc.Guid.String())
@@ -593,18 +610,16 @@ func AddDataRequest(collectionName string, documentData []sharedtypes.DbData) {
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "unable to create qdrant client: %q", err)
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
-	ctx := context.TODO()
-
 	resp, err := client.Upsert(ctx, &qdrant.UpsertPoints{
 		CollectionName: collectionName,
 		Points:         points,
 		Wait:           qdrant.PtrOf(true),
 	})
 	if err != nil {
-		logPanic(nil, "failed to insert data: %q", err)
+		logPanicError(nil, NewUpstreamError(UpstreamQdrant, err, "failed to insert data: %q", err))
 	}
 	logging.Log.Debugf(&logging.ContextMap{}, "successfully upserted %d points into qdrant collection %q: %q", len(points), collectionName, resp.GetStatus())
 }
@@ -615,23 +630,22 @@ func AddDataRequest(collectionName string, documentData []sharedtypes.DbData) {
 //   - @displayName: Create Collection
 //
 // Parameters:
+//   - ctx: the request context.
 //   - collectionName: the name of the collection to create.
 //   - vectorSize: the length of the vector S
 //   - vectorDistance: the vector similarity distance algorithm to use for the vector index (cosine, dot, euclid, manhattan)
-func CreateCollectionRequest(collectionName string, vectorSize uint64, vectorDistance string) {
+func CreateCollectionRequest(ctx context.Context, collectionName string, vectorSize uint64, vectorDistance string) {
 	logCtx := &logging.ContextMap{}
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(logCtx, "unable to create qdrant client: %q", err)
+		logPanicError(logCtx, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
-	ctx := context.TODO()
-
 	// check if collection already exists
 	collectionExists, err := client.CollectionExists(ctx, collectionName)
 	if err != nil {
-		logPanic(logCtx, "unable to determine if collection already exists: %v", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "unable to determine if collection already exists: %v", err))
 	}
 	if collectionExists {
 		logging.Log.Debugf(logCtx, "collection %q already exists, skipping creation", collectionName)
@@ -647,7 +661,7 @@ func CreateCollectionRequest(collectionName string, vectorSize uint64, vectorDis
 		}),
 	})
 	if err != nil {
-		logPanic(logCtx, "failed to create collection: %q", err)
+		logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "failed to create collection: %q", err))
 	}
 	logging.Log.Debugf(logCtx, "Created collection: %s", collectionName)
 
@@ -670,7 +684,7 @@ func CreateCollectionRequest(collectionName string, vectorSize uint64, vectorDis
 		}
 		res, err := client.CreateFieldIndex(ctx, &request)
 		if err != nil {
-			logPanic(logCtx, "error creating payload index on %q: %v", index.name, err)
+			logPanicError(logCtx, NewUpstreamError(UpstreamQdrant, err, "error creating payload index on %q: %v", index.name, err))
 		}
 		logging.Log.Debugf(logCtx, "created payload index on %q: %q", index.name, res.Status)
 	}
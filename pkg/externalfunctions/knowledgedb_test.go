@@ -69,7 +69,7 @@ func TestSendVectorsToKnowledgeDB(t *testing.T) {
 		assert.False(collExists, "collection %q shouldn't exist before running", collection)
 
 		// now create collection
-		QdrantCreateCollection(collection, 4, distance)
+		QdrantCreateCollection(ctx, collection, 4, distance)
 
 		// now check collection is there
 		collExists, err = qdrantClient.CollectionExists(ctx, collection)
@@ -100,27 +100,27 @@ func TestSendVectorsToKnowledgeDB(t *testing.T) {
 				"level":         "leaf",
 			},
 		}
-		QdrantInsertData(collection, data, "id", "vector")
+		QdrantInsertData(ctx, collection, data, "id", "vector")
 
 		// create index
-		QdrantCreateIndex(collection, "document_name", "keyword", true)
-		QdrantCreateIndex(collection, "keywords", "keyword", true)
-		QdrantCreateIndex(collection, "level", "keyword", true)
+		QdrantCreateIndex(ctx, collection, "document_name", "keyword", true)
+		QdrantCreateIndex(ctx, collection, "keywords", "keyword", true)
+		QdrantCreateIndex(ctx, collection, "level", "keyword", true)
 
 		// do a straight up search with an exact match (dense-only)
-		resp := SendVectorsToKnowledgeDB([]float32{0, -1, -2, -3}, []string{}, false, collection, 1, 0, make(map[uint]float32))
+		resp := SendVectorsToKnowledgeDB(ctx, []float32{0, -1, -2, -3}, []string{}, false, collection, 1, 0, make(map[uint]float32))
 		require.Len(resp, 1, "expected 1 result but got %d", len(resp))
 		assert.Equal("Doc 1", resp[0].DocumentName)
 
 		// do a generalist search (keywords ignored in simplified implementation, still dense-only)
-		resp = SendVectorsToKnowledgeDB([]float32{4, 5, 6, 7}, []string{"kw5"}, true, collection, 1, 0, make(map[uint]float32))
+		resp = SendVectorsToKnowledgeDB(ctx, []float32{4, 5, 6, 7}, []string{"kw5"}, true, collection, 1, 0, make(map[uint]float32))
 		require.Len(resp, 1, "expected 1 result but got %d", len(resp))
 		// Different distance metrics may return different top results, both Doc 2 and Doc 3 are valid
 		assert.Contains([]string{"Doc 2", "Doc 3"}, resp[0].DocumentName)
 
 		// Test explicit empty sparse vector (new caller style)
 		emptySparseVector := make(map[uint]float32)
-		resp = SendVectorsToKnowledgeDB([]float32{4, 5, 6, 7}, []string{}, false, collection, 1, 0, emptySparseVector)
+		resp = SendVectorsToKnowledgeDB(ctx, []float32{4, 5, 6, 7}, []string{}, false, collection, 1, 0, emptySparseVector)
 		require.Len(resp, 1, "expected 1 result but got %d", len(resp))
 		// Should return a valid result with dense-only search
 	}
@@ -148,7 +148,7 @@ func TestCreateAndListCollections(t *testing.T) {
 	config.GlobalConfig = &setup.config
 	logging.InitLogger(&setup.config)
 
-	colls := GetListCollections()
+	colls := GetListCollections(ctx)
 	require.Len(colls, 0, "should be 0 collections initially")
 
 	collReqs := map[string]struct {
@@ -161,10 +161,10 @@ func TestCreateAndListCollections(t *testing.T) {
 		"mycollection4": {1524, "dot"},
 	}
 	for collName, params := range collReqs {
-		CreateCollectionRequest(collName, params.size, params.distance)
+		CreateCollectionRequest(ctx, collName, params.size, params.distance)
 	}
 
-	colls = GetListCollections()
+	colls = GetListCollections(ctx)
 	assert.Len(colls, len(collReqs))
 
 	for expName := range maps.Keys(collReqs) {
@@ -202,7 +202,7 @@ func TestGeneralQuery(t *testing.T) {
 	assert.False(collExists, "collection %q shouldn't exist before running", COLLECTIONNAME)
 
 	// now create collection
-	QdrantCreateCollection(COLLECTIONNAME, 4, "cosine")
+	QdrantCreateCollection(ctx, COLLECTIONNAME, 4, "cosine")
 
 	// now check collection is there
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -252,12 +252,12 @@ func TestGeneralQuery(t *testing.T) {
 			"tags":          []any{"tag2", "tag1"},
 		},
 	}
-	QdrantInsertData(COLLECTIONNAME, data, "id", "vector")
+	QdrantInsertData(ctx, COLLECTIONNAME, data, "id", "vector")
 
 	// create index
-	QdrantCreateIndex(COLLECTIONNAME, "document_name", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "keywords", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "level", "keyword", true)
+	QdrantCreateIndex(ctx, COLLECTIONNAME, "document_name", "keyword", true)
+	QdrantCreateIndex(ctx, COLLECTIONNAME, "keywords", "keyword", true)
+	QdrantCreateIndex(ctx, COLLECTIONNAME, "level", "keyword", true)
 
 	// do search
 	filters := sharedtypes.DbFilters{
@@ -273,7 +273,7 @@ func TestGeneralQuery(t *testing.T) {
 		},
 	}
 
-	resp := GeneralQuery(COLLECTIONNAME, 100, []string{"document_name", "level", "keywords", "tags"}, filters)
+	resp := GeneralQuery(ctx, COLLECTIONNAME, 100, []string{"document_name", "level", "keywords", "tags"}, filters)
 	require.Len(resp, 1, "expected 1 result but got %d", len(resp))
 	assert.Equal("title", resp[0].DocumentName)
 	assert.Equal("middle", resp[0].Level)
@@ -311,7 +311,7 @@ func TestSimilaritySearch(t *testing.T) {
 	assert.False(collExists, "collection %q shouldn't exist before running", COLLECTIONNAME)
 
 	// now create collection
-	QdrantCreateCollection(COLLECTIONNAME, 4, "cosine")
+	QdrantCreateCollection(ctx, COLLECTIONNAME, 4, "cosine")
 
 	// now check collection is there
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -388,15 +388,16 @@ func TestSimilaritySearch(t *testing.T) {
 			"child_ids":           []any{uuids[1]},
 		},
 	}
-	QdrantInsertData(COLLECTIONNAME, data, "id", "vector")
+	QdrantInsertData(ctx, COLLECTIONNAME, data, "id", "vector")
 
 	// create index
-	QdrantCreateIndex(COLLECTIONNAME, "document_name", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "keywords", "keyword", true)
-	QdrantCreateIndex(COLLECTIONNAME, "level", "keyword", true)
+	QdrantCreateIndex(ctx, COLLECTIONNAME, "document_name", "keyword", true)
+	QdrantCreateIndex(ctx, COLLECTIONNAME, "keywords", "keyword", true)
+	QdrantCreateIndex(ctx, COLLECTIONNAME, "level", "keyword", true)
 
 	// do search
 	resp := SimilaritySearch(
+		ctx,
 		COLLECTIONNAME,
 		[]float32{4, 5, 6, 7},
 		1,
@@ -460,7 +461,7 @@ func TestAddDataRequest(t *testing.T) {
 	assert.False(collExists, "collection %q shouldn't exist before running", COLLECTIONNAME)
 
 	// now create collection
-	QdrantCreateCollection(COLLECTIONNAME, 4, "cosine")
+	QdrantCreateCollection(ctx, COLLECTIONNAME, 4, "cosine")
 
 	// now check collection is there
 	collExists, err = qdrantClient.CollectionExists(ctx, COLLECTIONNAME)
@@ -524,7 +525,7 @@ func TestAddDataRequest(t *testing.T) {
 			Embedding:    []float32{0, 1, 2, 3},
 		},
 	}
-	AddDataRequest(COLLECTIONNAME, data)
+	AddDataRequest(ctx, COLLECTIONNAME, data)
 
 	// check theres some points in there
 	points, err := qdrantClient.Query(ctx, &qdrant.QueryPoints{
@@ -582,13 +583,13 @@ func TestRetrieveDependencies(t *testing.T) {
 	// now test deps
 	// relationshipName := []string{"NextSibling", "NextParent", "HasFirstChild", "HasChild"}
 	// relationshipDirection := []string{"in", "out", "both"}
-	depIds := RetrieveDependencies(DBNAME, "NextParent", "out", "3b", sharedtypes.DbArrayFilter{}, 2)
+	depIds := RetrieveDependencies(ctx, DBNAME, "NextParent", "out", "3b", sharedtypes.DbArrayFilter{}, 2)
 	assert.EqualValues([]string{"4"}, depIds)
 
-	depIds = RetrieveDependencies(DBNAME, "NextSibling", "in", "3b", sharedtypes.DbArrayFilter{}, 2)
+	depIds = RetrieveDependencies(ctx, DBNAME, "NextSibling", "in", "3b", sharedtypes.DbArrayFilter{}, 2)
 	assert.EqualValues([]string{"3a"}, depIds)
 
-	depIds = RetrieveDependencies(DBNAME, "HasChild", "both", "3b", sharedtypes.DbArrayFilter{}, 2)
+	depIds = RetrieveDependencies(ctx, DBNAME, "HasChild", "both", "3b", sharedtypes.DbArrayFilter{}, 2)
 	expected := []string{"3", "3b1", "3b2"}
 	assert.Len(depIds, len(expected))
 	for _, exp := range expected {
@@ -642,7 +643,7 @@ func TestGeneralGraphDbQuery(t *testing.T) {
 	require.NoError(driver.CreateUserGuideSectionRelationships(DBNAME, data))
 
 	// now make a query
-	res := GeneralGraphDbQuery(DBNAME, "MATCH (n {parent:'1'}) RETURN n.name AS name", nil)
+	res := GeneralGraphDbQuery(ctx, DBNAME, "MATCH (n {parent:'1'}) RETURN n.name AS name", nil)
 	expected := []string{"1a", "1b"}
 	require.Len(res, len(expected))
 	for _, r := range res {
@@ -701,7 +702,7 @@ func TestGeneralGraphDbQueryWithParameters(t *testing.T) {
 	AddGraphDbParameter(paramMap, "parent", "1", "string")
 
 	// now make a query
-	res := GeneralGraphDbQuery(DBNAME, "MATCH (n {parent:$parent}) RETURN n.name AS name", paramMap)
+	res := GeneralGraphDbQuery(ctx, DBNAME, "MATCH (n {parent:$parent}) RETURN n.name AS name", paramMap)
 	expected := []string{"1a", "1b"}
 	require.Len(res, len(expected))
 	for _, r := range res {
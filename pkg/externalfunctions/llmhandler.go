@@ -23,11 +23,11 @@
 package externalfunctions
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
@@ -40,21 +40,21 @@ import (
 //   - @displayName: Embeddings
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input string
 //   - includeSparse: flag to include sparse vectors (false for dense-only, true for hybrid search)
 //
 // Returns:
 //   - embeddedVector: the embedded vector in float32 format
 //   - sparseVector: the sparse embedded vector as term_id->weight map (only when includeSparse=true)
-func PerformVectorEmbeddingRequest(input string, includeSparse bool) (embeddedVector []float32, sparseVector map[uint]float32) {
+func PerformVectorEmbeddingRequest(ctx context.Context, input string, includeSparse bool) (embeddedVector []float32, sparseVector map[uint]float32) {
 	// Use the provided parameter directly
 	shouldIncludeSparse := includeSparse
 
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Use hybrid embeddings if requested, otherwise use existing dense-only logic
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, shouldIncludeSparse, nil)
-	defer close(responseChannel)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, shouldIncludeSparse, nil)
 
 	var denseEmbedding []float32
 	var sparseEmbedding map[uint]float32
@@ -62,7 +62,7 @@ func PerformVectorEmbeddingRequest(input string, includeSparse bool) (embeddedVe
 
 	for response := range responseChannel {
 		if response.Type == "error" {
-			panic(response.Error)
+			panic(errorFromLLMResponse(response.Error))
 		}
 
 		fmt.Printf("Received embeddings response.")
@@ -72,13 +72,13 @@ func PerformVectorEmbeddingRequest(input string, includeSparse bool) (embeddedVe
 		if !ok {
 			errMessage := "error converting embedded data to interface array"
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(nil, "%s", errMessage))
 		}
 		denseEmbedding, err = convertToFloat32Slice(interfaceArray)
 		if err != nil {
 			errMessage := fmt.Sprintf("error converting embedded data to float32 slice: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		// Process sparse embedding if available (added functionality)
@@ -110,19 +110,22 @@ func PerformVectorEmbeddingRequest(input string, includeSparse bool) (embeddedVe
 //   - @displayName: Embeddings with Token Limit Catch
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input string
+//   - tokenLimitMessage: the message to return if the token limit is reached
 //
 // Returns:
 //   - embeddedVector: the embedded vector in float32 format
-func PerformVectorEmbeddingRequestWithTokenLimitCatch(input string, tokenLimitMessage string) (embeddedVector []float32, tokenLimitReached bool, responseMessage string) {
+//   - tokenLimitReached: true if the token limit is reached, false otherwise
+//   - responseMessage: the token limit message if the token limit is reached, empty otherwise
+func PerformVectorEmbeddingRequestWithTokenLimitCatch(ctx context.Context, input string, tokenLimitMessage string) (embeddedVector []float32, tokenLimitReached bool, responseMessage string) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send embeddings request
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, false, nil)
-	defer close(responseChannel)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, false, nil)
 
-	// Process the first response and close the channel
+	// Process the first response
 	var embedding32 []float32
 	var err error
 	for response := range responseChannel {
@@ -131,7 +134,7 @@ func PerformVectorEmbeddingRequestWithTokenLimitCatch(input string, tokenLimitMe
 			if strings.Contains(response.Error.Message, "tokens") {
 				return nil, true, tokenLimitMessage
 			} else {
-				panic(response.Error)
+				panic(errorFromLLMResponse(response.Error))
 			}
 		}
 
@@ -143,13 +146,13 @@ func PerformVectorEmbeddingRequestWithTokenLimitCatch(input string, tokenLimitMe
 		if !ok {
 			errMessage := "error converting embedded data to interface array"
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(nil, "%s", errMessage))
 		}
 		embedding32, err = convertToFloat32Slice(interfaceArray)
 		if err != nil {
 			errMessage := fmt.Sprintf("error converting embedded data to float32 slice: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		// Mark that the first response has been received
@@ -170,24 +173,24 @@ func PerformVectorEmbeddingRequestWithTokenLimitCatch(input string, tokenLimitMe
 //   - @displayName: Batch Embeddings
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input strings
 //
 // Returns:
 //   - embeddedVectors: the embedded vectors in float32 format
-func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32) {
+func PerformBatchEmbeddingRequest(ctx context.Context, input []string) (embeddedVectors [][]float32) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send embeddings request
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, false, nil)
-	defer close(responseChannel)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, false, nil)
 
-	// Process the first response and close the channel
+	// Process the first response
 	embedding32Array := make([][]float32, len(input))
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			panic(errorFromLLMResponse(response.Error))
 		}
 
 		// Log LLM response
@@ -198,7 +201,7 @@ func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32)
 		if !ok {
 			errMessage := "error converting embedded data to interface array"
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(nil, "%s", errMessage))
 		}
 
 		for i, interfaceArrayElement := range interfaceArray {
@@ -206,13 +209,13 @@ func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32)
 			if !ok {
 				errMessage := "error converting embedded data to interface array"
 				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				panic(NewInternalError(nil, "%s", errMessage))
 			}
 			embedding32, err := convertToFloat32Slice(lowerInterfaceArray)
 			if err != nil {
 				errMessage := fmt.Sprintf("error converting embedded data to float32 slice: %v", err)
 				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				panic(errMessage)
+				panic(NewInternalError(err, "%s", errMessage))
 			}
 			embedding32Array[i] = embedding32
 		}
@@ -236,12 +239,14 @@ func PerformBatchEmbeddingRequest(input []string) (embeddedVectors [][]float32)
 //   - @displayName: Batch Hybrid Embeddings
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input strings
+//   - maxBatchSize: the maximum number of strings sent in a single request
 //
 // Returns:
 //   - denseEmbeddings: the dense embeddings in float32 format
 //   - sparseEmbeddings: the sparse embeddings in map format
-func PerformBatchHybridEmbeddingRequest(input []string, maxBatchSize int) (denseEmbeddings [][]float32, sparseEmbeddings []map[uint]float32) {
+func PerformBatchHybridEmbeddingRequest(ctx context.Context, input []string, maxBatchSize int) (denseEmbeddings [][]float32, sparseEmbeddings []map[uint]float32) {
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -255,11 +260,11 @@ func PerformBatchHybridEmbeddingRequest(input []string, maxBatchSize int) (dense
 		batchTextToEmbed := input[i:end]
 
 		// Send http request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
-			errMessage := fmt.Sprintf("Error performing batch embedding request: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			logging.Log.Errorf(&logging.ContextMap{}, "Error performing batch embedding request: %v", err)
+			// errors of aali-llm are already function errors, anything else is converted to an internal error
+			panic(err)
 		}
 
 		// Add the embeddings to the list
@@ -279,25 +284,25 @@ func PerformBatchHybridEmbeddingRequest(input []string, maxBatchSize int) (dense
 //   - @displayName: Keyword Extraction
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input string
 //   - maxKeywordsSearch: the maximum number of keywords to search for
 //
 // Returns:
 //   - keywords: the keywords extracted from the input string as a slice of strings
-func PerformKeywordExtractionRequest(input string, maxKeywordsSearch uint32) (keywords []string) {
+func PerformKeywordExtractionRequest(ctx context.Context, input string, maxKeywordsSearch uint32) (keywords []string) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequestNoHistory(input, "keywords", maxKeywordsSearch, llmHandlerEndpoint, nil, nil)
-	defer close(responseChannel)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "keywords", maxKeywordsSearch, llmHandlerEndpoint, nil, nil)
 
 	// Process all responses
 	var responseAsStr string
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			panic(errorFromLLMResponse(response.Error))
 		}
 
 		// Accumulate the responses
@@ -316,7 +321,7 @@ func PerformKeywordExtractionRequest(input string, maxKeywordsSearch uint32) (ke
 	if err != nil {
 		errMessage := fmt.Sprintf("Error unmarshalling keywords response from aali-llm: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewUpstreamError(UpstreamLLM, err, "%s", errMessage))
 	}
 
 	// Return the response
@@ -329,24 +334,24 @@ func PerformKeywordExtractionRequest(input string, maxKeywordsSearch uint32) (ke
 //   - @displayName: Summary
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input string
 //
 // Returns:
 //   - summary: the summary extracted from the input string
-func PerformSummaryRequest(input string) (summary string) {
+func PerformSummaryRequest(ctx context.Context, input string) (summary string) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequestNoHistory(input, "summary", 1, llmHandlerEndpoint, nil, nil)
-	defer close(responseChannel)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "summary", 1, llmHandlerEndpoint, nil, nil)
 
 	// Process all responses
 	var responseAsStr string
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			panic(errorFromLLMResponse(response.Error))
 		}
 
 		// Accumulate the responses
@@ -364,12 +369,76 @@ func PerformSummaryRequest(input string) (summary string) {
 	return responseAsStr
 }
 
+// PerformLLMRequest performs a general request to the LLM and waits for the complete response.
+// The options select the models, the model options, the images, the system prompt and the history of the request.
+// The input and output tokens are counted with the OpenAI tokenizer of options.tokenCountModelName, they are 0 without it.
+//
+// Tags:
+//   - @displayName: LLM Request
+//
+// Parameters:
+//   - ctx: the request context
+//   - input: the user input
+//   - options: the options of the request
+//
+// Returns:
+//   - message: the response message
+//   - inputTokenCount: the input token count
+//   - outputTokenCount: the output token count
+func PerformLLMRequest(ctx context.Context, input string, options LLMRequestOptions) (message string, inputTokenCount int, outputTokenCount int) {
+	// Set up WebSocket connection with LLM, send chat request and process all responses
+	message, err := receiveLLMResponse(ctx, sendLLMRequest(ctx, input, options))
+	if err != nil {
+		panic(err)
+	}
+
+	// Count the tokens if requested
+	if options.TokenCountModelName != "" {
+		inputTokenCount, outputTokenCount = llmRequestTokenCount(options.TokenCountModelName, input, options, message)
+		logging.Log.Debugf(&logging.ContextMap{}, "Input token count: %d; Output token count: %d", inputTokenCount, outputTokenCount)
+	}
+
+	// Return the response
+	return message, inputTokenCount, outputTokenCount
+}
+
+// PerformLLMStreamRequest performs a general request to the LLM and streams the response.
+// The options are the same as for PerformLLMRequest, the tokens are not counted.
+//
+// Tags:
+//   - @displayName: LLM Request (Stream)
+//
+// Parameters:
+//   - ctx: the request context
+//   - input: the user input
+//   - options: the options of the request
+//
+// Returns:
+//   - stream: the stream channel
+func PerformLLMStreamRequest(ctx context.Context, input string, options LLMRequestOptions) (stream *chan string) {
+	// Set up WebSocket connection with LLM and send chat request
+	responseChannel := sendLLMRequest(ctx, input, options)
+
+	// Create a stream channel
+	streamChannel := make(chan string, 400)
+
+	// Start a goroutine to transfer the data from the response channel to the stream channel
+	go transferDatafromResponseToStreamChannel(ctx, &responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+
+	// Return the stream channel
+	return &streamChannel
+}
+
 // PerformGeneralRequest performs a general chat completion request to LLM
 //
+// Deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request
+//   - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input string
 //   - history: the conversation history
 //   - isStream: the stream flag
@@ -378,54 +447,23 @@ func PerformSummaryRequest(input string) (summary string) {
 // Returns:
 //   - message: the generated message
 //   - stream: the stream channel
-func PerformGeneralRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string) (message string, stream *chan string) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil, nil)
-	// If isStream is true, create a stream channel and return asap
-	if isStream {
-		// Create a stream channel
-		streamChannel := make(chan string, 400)
-
-		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
-
-		// Return the stream channel
-		return "", &streamChannel
-	}
-
-	// Close the response channel
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// Return the response
-	return responseAsStr, nil
+func PerformGeneralRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string) (message string, stream *chan string) {
+	return generalLLMRequest(ctx, input, isStream, LLMRequestOptions{
+		History:      history,
+		SystemPrompt: systemPrompt,
+	})
 }
 
 // PerformGeneralRequestWithImages performs a general request to LLM with images
 //
+// Deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (with Images)
+//   - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
@@ -435,292 +473,141 @@ func PerformGeneralRequest(input string, history []sharedtypes.HistoricMessage,
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestWithImages(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, images []string) (message string, stream *chan string) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil, images)
-	// If isStream is true, create a stream channel and return asap
-	if isStream {
-		// Create a stream channel
-		streamChannel := make(chan string, 400)
-
-		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
-
-		// Return the stream channel
-		return "", &streamChannel
-	}
-
-	// Close the response channel
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// Return the response
-	return responseAsStr, nil
+func PerformGeneralRequestWithImages(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, images []string) (message string, stream *chan string) {
+	return generalLLMRequest(ctx, input, isStream, LLMRequestOptions{
+		History:      history,
+		SystemPrompt: systemPrompt,
+		Images:       images,
+	})
 }
 
 // PerformGeneralModelSpecificationRequest performs a specified request to LLM with a configured model and Systemprompt.
 //
+// Deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (Specified System Prompt)
+//   - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralModelSpecificationRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt map[string]string, modelIds []string) (message string, stream *chan string) {
-	// get the LLM handler endpoint
-	fmt.Printf("[%s] type of alpsRequest inside modelspecification %T\n", time.Now().Format("2006-01-02 15:04:05.000"), systemPrompt)
-	logging.Log.Infof(&logging.ContextMap{}, "[%s] type of alpsRequest inside modelspecification %T\n", time.Now().Format("2006-01-02 15:04:05.000"), systemPrompt)
-
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil, nil)
-
-	// If isStream is true, create a stream channel and return asap
-	if isStream {
-		// Create a stream channel
-		streamChannel := make(chan string, 400)
-
-		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
-
-		// Return the stream channel
-		return "", &streamChannel
-	}
-
-	// Close the response channel
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// Return the response
-	return responseAsStr, nil
+func PerformGeneralModelSpecificationRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt map[string]string, modelIds []string) (message string, stream *chan string) {
+	return generalLLMRequest(ctx, input, isStream, LLMRequestOptions{
+		History:      history,
+		SystemPrompt: systemPrompt,
+		ModelIds:     modelIds,
+	})
 }
 
 // PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model
 //
+// Deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (Specific Models)
+//   - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestSpecificModel(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string) (message string, stream *chan string) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil, nil)
-
-	// If isStream is true, create a stream channel and return asap
-	if isStream {
-		// Create a stream channel
-		streamChannel := make(chan string, 400)
-
-		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
-
-		// Return the stream channel
-		return "", &streamChannel
-	}
-
-	// Close the response channel
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// Return the response
-	return responseAsStr, nil
+func PerformGeneralRequestSpecificModel(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string) (message string, stream *chan string) {
+	return generalLLMRequest(ctx, input, isStream, LLMRequestOptions{
+		History:      history,
+		SystemPrompt: systemPrompt,
+		ModelIds:     modelIds,
+	})
 }
 
 // PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model
 //
+// Deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (Specific Models & Model Options)
+//   - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs
 //   - modelOptions: the model options
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestSpecificModelAndModelOptions(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions) (message string, stream *chan string) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, &modelOptions, nil)
-
-	// If isStream is true, create a stream channel and return asap
-	if isStream {
-		// Create a stream channel
-		streamChannel := make(chan string, 400)
-
-		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
-
-		// Return the stream channel
-		return "", &streamChannel
-	}
-
-	// Close the response channel
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// Return the response
-	return responseAsStr, nil
+func PerformGeneralRequestSpecificModelAndModelOptions(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions) (message string, stream *chan string) {
+	return generalLLMRequest(ctx, input, isStream, LLMRequestOptions{
+		History:      history,
+		SystemPrompt: systemPrompt,
+		ModelIds:     modelIds,
+		ModelOptions: &modelOptions,
+	})
 }
 
 // PerformGeneralRequestSpecificModelModelOptionsAndImages performs a general request to LLM with a specific model including model options and images
 //
+// Deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (Specific Models, Model Options & Images)
+//   - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - isStream: the flag to indicate whether the response should be streamed
 //   - systemPrompt: the system prompt
-//   - modelId: the model ID
+//   - modelIds: the model IDs
 //   - modelOptions: the model options
 //   - images: the images to include in the request
+//   - modelCategory: the model categories
 //
 // Returns:
 //   - message: the response message
 //   - stream: the stream channel
-func PerformGeneralRequestSpecificModelModelOptionsAndImages(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, images []string, modelCategory []string) (message string, stream *chan string) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, modelCategory, &modelOptions, images)
-
-	// If isStream is true, create a stream channel and return asap
-	if isStream {
-		// Create a stream channel
-		streamChannel := make(chan string, 400)
-
-		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
-
-		// Return the stream channel
-		return "", &streamChannel
-	}
-
-	// Close the response channel
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// Return the response
-	return responseAsStr, nil
+func PerformGeneralRequestSpecificModelModelOptionsAndImages(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, images []string, modelCategory []string) (message string, stream *chan string) {
+	return generalLLMRequest(ctx, input, isStream, LLMRequestOptions{
+		History:       history,
+		SystemPrompt:  systemPrompt,
+		ModelIds:      modelIds,
+		ModelCategory: modelCategory,
+		ModelOptions:  &modelOptions,
+		Images:        images,
+	})
 }
 
 // PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model
 // and returns the token count using OpenAI token count model. Does not stream the response.
 //
+// Deprecated: use PerformLLMRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (Specific Models, No Stream, OpenAI Token Output)
+//   - @deprecated: use PerformLLMRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
@@ -730,73 +617,27 @@ func PerformGeneralRequestSpecificModelModelOptionsAndImages(input string, histo
 // Returns:
 //   - message: the response message
 //   - tokenCount: the token count
-func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, tokenCountModelName string) (message string, tokenCount int) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, nil, nil)
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// get input token count
-	totalTokenCount, err := openAiTokenCount(tokenCountModelName, input+systemPrompt)
-	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting input token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
-	}
-
-	// get history token count
-	for _, message := range history {
-		historyTokenCount, err := openAiTokenCount(tokenCountModelName, message.Content)
-		if err != nil {
-			errorMessage := fmt.Sprintf("Error getting history token count: %v", err)
-			logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-			panic(errorMessage)
-		}
-		totalTokenCount += historyTokenCount
-	}
-
-	// get the output token count
-	outputTokenCount, err := openAiTokenCount(tokenCountModelName, responseAsStr)
-	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting output token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
-	}
-	totalTokenCount += outputTokenCount
-
-	// log token count
-	logging.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
-
-	// Return the response
-	return responseAsStr, totalTokenCount
+func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, tokenCountModelName string) (message string, tokenCount int) {
+	message, inputTokenCount, outputTokenCount := PerformLLMRequest(ctx, input, LLMRequestOptions{
+		History:             history,
+		SystemPrompt:        systemPrompt,
+		ModelIds:            modelIds,
+		TokenCountModelName: tokenCountModelName,
+	})
+	return message, inputTokenCount + outputTokenCount
 }
 
 // PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model
 // and model options, and returns the token count using OpenAI token count model. Does not stream the response.
 //
+// Deprecated: use PerformLLMRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (Specific Models, Model Options, No Stream, OpenAI Token Output)
+//   - @deprecated: use PerformLLMRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
@@ -807,73 +648,28 @@ func PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(input strin
 // Returns:
 //   - message: the response message
 //   - tokenCount: the token count
-func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, tokenCountModelName string) (message string, tokenCount int) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, &modelOptions, nil)
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// get input token count
-	totalTokenCount, err := openAiTokenCount(tokenCountModelName, input+systemPrompt)
-	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting input token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
-	}
-
-	// get history token count
-	for _, message := range history {
-		historyTokenCount, err := openAiTokenCount(tokenCountModelName, message.Content)
-		if err != nil {
-			errorMessage := fmt.Sprintf("Error getting history token count: %v", err)
-			logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-			panic(errorMessage)
-		}
-		totalTokenCount += historyTokenCount
-	}
-
-	// get the output token count
-	outputTokenCount, err := openAiTokenCount(tokenCountModelName, responseAsStr)
-	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting output token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
-	}
-	totalTokenCount += outputTokenCount
-
-	// log token count
-	logging.Log.Debugf(&logging.ContextMap{}, "Total token count: %d", totalTokenCount)
-
-	// Return the response
-	return responseAsStr, totalTokenCount
+func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, tokenCountModelName string) (message string, tokenCount int) {
+	message, inputTokenCount, outputTokenCount := PerformLLMRequest(ctx, input, LLMRequestOptions{
+		History:             history,
+		SystemPrompt:        systemPrompt,
+		ModelIds:            modelIds,
+		ModelOptions:        &modelOptions,
+		TokenCountModelName: tokenCountModelName,
+	})
+	return message, inputTokenCount + outputTokenCount
 }
 
 // PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput performs a general request to LLM with a specific model
 // and model options, and returns the token count using OpenAI token count model. Does not stream the response.
 //
+// Deprecated: use PerformLLMRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (Specific Models, Model Options, No Stream, OpenAI Input & Output Token Output)
+//   - @deprecated: use PerformLLMRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the user input
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
@@ -885,62 +681,14 @@ func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOut
 //   - message: the response message
 //   - inputTokenCount: the input token count
 //   - outputTokenCount: the output token count
-func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput(input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, tokenCountModelName string) (message string, inputTokenCount int, outputTokenCount int) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, modelIds, nil, &modelOptions, nil)
-	defer close(responseChannel)
-
-	// else Process all responses
-	var responseAsStr string
-	for response := range responseChannel {
-		// Check if the response is an error
-		if response.Type == "error" {
-			panic(response.Error)
-		}
-
-		// Accumulate the responses
-		responseAsStr += *(response.ChatData)
-
-		// If we are at the last message, break the loop
-		if *(response.IsLast) {
-			break
-		}
-	}
-
-	// get input token count
-	var err error
-	inputTokenCount, err = openAiTokenCount(tokenCountModelName, input+systemPrompt)
-	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting input token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
-	}
-	for _, message := range history {
-		historyTokenCount, err := openAiTokenCount(tokenCountModelName, message.Content)
-		if err != nil {
-			errorMessage := fmt.Sprintf("Error getting history token count: %v", err)
-			logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-			panic(errorMessage)
-		}
-		inputTokenCount += historyTokenCount
-	}
-
-	// get the output token count
-	outputTokenCount, err = openAiTokenCount(tokenCountModelName, responseAsStr)
-	if err != nil {
-		errorMessage := fmt.Sprintf("Error getting output token count: %v", err)
-		logging.Log.Errorf(&logging.ContextMap{}, "%v", errorMessage)
-		panic(errorMessage)
-	}
-
-	// log token count
-	logging.Log.Debugf(&logging.ContextMap{}, "Input token count: %d; Output token count: %d", inputTokenCount, outputTokenCount)
-
-	// Return the response
-	return responseAsStr, inputTokenCount, outputTokenCount
+func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string, modelIds []string, modelOptions sharedtypes.ModelOptions, tokenCountModelName string) (message string, inputTokenCount int, outputTokenCount int) {
+	return PerformLLMRequest(ctx, input, LLMRequestOptions{
+		History:             history,
+		SystemPrompt:        systemPrompt,
+		ModelIds:            modelIds,
+		ModelOptions:        &modelOptions,
+		TokenCountModelName: tokenCountModelName,
+	})
 }
 
 // PerformCodeLLMRequest performs a code generation request to LLM
@@ -949,19 +697,21 @@ func PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOut
 //   - @displayName: Code LLM Request
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input string
 //   - history: the conversation history
 //   - isStream: the stream flag
+//   - validateCode: the flag to indicate whether the code should be validated
 //
 // Returns:
 //   - message: the generated code
 //   - stream: the stream channel
-func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, validateCode bool) (message string, stream *chan string) {
+func PerformCodeLLMRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, validateCode bool) (message string, stream *chan string) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request
-	responseChannel := sendChatRequest(input, "code", history, 0, "", llmHandlerEndpoint, nil, nil, nil, nil)
+	responseChannel := sendChatRequest(ctx, input, "code", history, 0, "", llmHandlerEndpoint, nil, nil, nil, nil)
 
 	// If isStream is true, create a stream channel and return asap
 	if isStream {
@@ -969,21 +719,18 @@ func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage,
 		streamChannel := make(chan string, 400)
 
 		// Start a goroutine to transfer the data from the response channel to the stream channel
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, validateCode, false, "", 0, 0, "", "", "", false, "")
+		go transferDatafromResponseToStreamChannel(ctx, &responseChannel, &streamChannel, validateCode, false, "", 0, 0, "", "", "", false, "")
 
 		// Return the stream channel
 		return "", &streamChannel
 	}
 
-	// Close the response channel
-	defer close(responseChannel)
-
 	// else Process all responses
 	var responseAsStr string
 	for response := range responseChannel {
 		// Check if the response is an error
 		if response.Type == "error" {
-			panic(response.Error)
+			panic(errorFromLLMResponse(response.Error))
 		}
 
 		// Accumulate the responses
@@ -1028,25 +775,26 @@ func PerformCodeLLMRequest(input string, history []sharedtypes.HistoricMessage,
 
 // PerformGeneralRequestNoStreaming performs a general chat completion request to LLM without streaming
 //
+// Deprecated: use PerformLLMRequest instead.
+//
 // Tags:
 //   - @displayName: General LLM Request (no streaming)
+//   - @deprecated: use PerformLLMRequest instead
 //
 // Parameters:
+//   - ctx: the request context
 //   - input: the input string
 //   - history: the conversation history
 //   - systemPrompt: the system prompt
 //
 // Returns:
 //   - message: the generated message
-func PerformGeneralRequestNoStreaming(input string, history []sharedtypes.HistoricMessage, systemPrompt string) (message string) {
-	// get the LLM handler endpoint
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request
-	responseString := sendChatRequestNoStreaming(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, nil, nil)
-
-	// Return the response
-	return responseString
+func PerformGeneralRequestNoStreaming(ctx context.Context, input string, history []sharedtypes.HistoricMessage, systemPrompt string) (message string) {
+	message, _, _ = PerformLLMRequest(ctx, input, LLMRequestOptions{
+		History:      history,
+		SystemPrompt: systemPrompt,
+	})
+	return message
 }
 
 // BuildLibraryContext builds the context string for the query
@@ -1156,7 +904,7 @@ func AppendMessageHistory(newMessage string, role AppendMessageHistoryRole, hist
 	default:
 		errMessage := fmt.Sprintf("Invalid role used for 'AppendMessageHistory': %v", role)
 		logging.Log.Warn(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInvalidInputError("role", "%s", errMessage))
 	}
 
 	// skip for empty messages
@@ -1228,14 +976,16 @@ func ShortenMessageHistory(history []sharedtypes.HistoricMessage, maxLength int)
 //   - query: the query string
 //   - tokenLimit: the token limit
 //   - modelName: the name of the model to check against
+//   - tokenLimitMessage: the message to return if the token limit is reached
 //
 // Returns:
 //   - tokenLimitReached: true if the token limit is reached, false otherwise
+//   - responseMessage: the token limit message if the token limit is reached, empty otherwise
 func CheckTokenLimitReached(query string, tokenLimit int, modelName string, tokenLimitMessage string) (tokenLimitReached bool, responseMessage string) {
 	// Check if the query exceeds the token limit
 	tokenCount, err := openAiTokenCount(modelName, query)
 	if err != nil {
-		panic(fmt.Sprintf("Error counting tokens: %v", err))
+		panic(NewInternalError(err, "Error counting tokens: %v", err))
 	}
 	if tokenCount > tokenLimit {
 		logging.Log.Warnf(&logging.ContextMap{}, "Query exceeds token limit: %d tokens, limit is %d tokens", tokenCount, tokenLimit)
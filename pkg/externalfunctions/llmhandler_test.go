@@ -23,11 +23,14 @@
 package externalfunctions
 
 import (
+	"context"
 	"strings"
 	"testing"
 
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestDbResponsePromptFormat(t *testing.T) {
@@ -120,3 +123,67 @@ func TestDbResponsePromptFormat(t *testing.T) {
 	}
 
 }
+
+func TestReceiveLLMResponse(t *testing.T) {
+	receive := func(ctx context.Context, responses ...sharedtypes.HandlerResponse) (string, error) {
+		responseChannel := make(chan sharedtypes.HandlerResponse, len(responses))
+		for _, response := range responses {
+			responseChannel <- response
+		}
+		close(responseChannel)
+		return receiveLLMResponse(ctx, responseChannel)
+	}
+
+	message, err := receive(context.Background(), chatResponse("Hello", false), sharedtypes.HandlerResponse{Type: "info"}, chatResponse(" world", true))
+	if err != nil || message != "Hello world" {
+		t.Errorf("receiveLLMResponse() = %q, %v, want the whole message", message, err)
+	}
+
+	_, err = receive(context.Background(), chatResponse("Hel", false), sharedtypes.HandlerResponse{Type: "error", Error: &sharedtypes.ErrorResponse{Message: "model overloaded"}})
+	if err == nil || !strings.Contains(err.Error(), "model overloaded") {
+		t.Errorf("receiveLLMResponse() error = %v, want the error of aali-llm", err)
+	}
+
+	// an abandoned request ends without its last response
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = receive(ctx, chatResponse("Hel", false))
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("receiveLLMResponse() error = %v, want a cancelled error", err)
+	}
+}
+
+func TestLLMRequestTokenCount(t *testing.T) {
+	count := func(text string) int {
+		tokens, err := openAiTokenCount("gpt-4o", text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tokens
+	}
+
+	options := LLMRequestOptions{
+		SystemPrompt: "You are a helpful assistant.",
+		History:      []sharedtypes.HistoricMessage{{Role: "user", Content: "What is a mesh?"}, {Role: "assistant", Content: "A discretized geometry."}},
+	}
+	inputTokens, outputTokens := llmRequestTokenCount("gpt-4o", "How fine should it be?", options, "It depends on the physics.")
+
+	wantInput := count("How fine should it be?You are a helpful assistant.") + count("What is a mesh?") + count("A discretized geometry.")
+	if inputTokens != wantInput || outputTokens != count("It depends on the physics.") {
+		t.Errorf("llmRequestTokenCount() = %d, %d, want %d, %d", inputTokens, outputTokens, wantInput, count("It depends on the physics."))
+	}
+
+	// the values of a system prompt map are counted, whether it is typed or decoded from JSON
+	historyTokens := count("What is a mesh?") + count("A discretized geometry.")
+	want := count("How fine should it be?") + count("You are a helpful assistant.") + count("Sei hilfsbereit.") + historyTokens
+	for _, systemPrompt := range []any{
+		map[string]string{"en": "You are a helpful assistant.", "de": "Sei hilfsbereit."},
+		map[string]any{"en": "You are a helpful assistant.", "de": "Sei hilfsbereit."},
+	} {
+		options.SystemPrompt = systemPrompt
+		inputTokens, _ = llmRequestTokenCount("gpt-4o", "How fine should it be?", options, "")
+		if inputTokens != want {
+			t.Errorf("llmRequestTokenCount() input with %T = %d, want %d", systemPrompt, inputTokens, want)
+		}
+	}
+}
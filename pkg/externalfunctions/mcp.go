@@ -35,14 +35,13 @@ import (
 //   - @displayName: List MCP Items
 //
 // Parameters:
+//   - ctx: the request context
 //   - serverURL: the WebSocket URL of the MCP server
 //
 // Returns:
 //   - result: a map with lists of tool/resource/prompt names categorized by type
 //   - error: any error that occurred during the process
-func ListAll(serverURL string) (map[string][]string, error) {
-	ctx := context.Background()
-
+func ListAll(ctx context.Context, serverURL string) (map[string][]string, error) {
 	conn, err := connectToMCP(ctx, serverURL)
 	if err != nil {
 		return nil, err
@@ -80,6 +79,7 @@ func ListAll(serverURL string) (map[string][]string, error) {
 //   - @displayName: Execute MCP Tool
 //
 // Parameters:
+//   - ctx: the request context
 //   - serverURL: the WebSocket URL of the MCP server
 //   - toolName: the name of the tool to execute
 //   - args: a map of arguments to pass to the tool
@@ -87,9 +87,7 @@ func ListAll(serverURL string) (map[string][]string, error) {
 // Returns:
 //   - result: the response from the tool execution
 //   - error: any error that occurred during execution
-func ExecuteTool(serverURL, toolName string, args map[string]interface{}) (map[string]interface{}, error) {
-	ctx := context.Background()
-
+func ExecuteTool(ctx context.Context, serverURL, toolName string, args map[string]interface{}) (map[string]interface{}, error) {
 	conn, err := connectToMCP(ctx, serverURL)
 	if err != nil {
 		return nil, err
@@ -110,15 +108,14 @@ func ExecuteTool(serverURL, toolName string, args map[string]interface{}) (map[s
 //   - @displayName: Get MCP Resource
 //
 // Parameters:
+//   - ctx: the request context
 //   - serverURL: the WebSocket URL of the MCP server
 //   - resourceName: the name of the resource to retrieve
 //
 // Returns:
 //   - result: the retrieved resource as a map
 //   - error: any error that occurred during the request
-func GetResource(serverURL, resourceName string) (map[string]interface{}, error) {
-	ctx := context.Background()
-
+func GetResource(ctx context.Context, serverURL, resourceName string) (map[string]interface{}, error) {
 	conn, err := connectToMCP(ctx, serverURL)
 	if err != nil {
 		return nil, err
@@ -138,15 +135,14 @@ func GetResource(serverURL, resourceName string) (map[string]interface{}, error)
 //   - @displayName: Get MCP Prompt
 //
 // Parameters:
+//   - ctx: the request context
 //   - serverURL: the WebSocket URL of the MCP server
 //   - promptName: the name of the system prompt to retrieve
 //
 // Returns:
 //   - promptStr: the text of the retrieved prompt
 //   - error: any error that occurred during the request
-func GetSystemPrompt(serverURL, promptName string) (string, error) {
-	ctx := context.Background()
-
+func GetSystemPrompt(ctx context.Context, serverURL, promptName string) (string, error) {
 	conn, err := connectToMCP(ctx, serverURL)
 	if err != nil {
 		return "", err
@@ -0,0 +1,43 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+// FunctionMetadata is the documentation of a function that does not fit in its definition
+// It is parsed from the docstring of the function with the registry, see FunctionsMetadata.
+type FunctionMetadata struct {
+	Inputs     []ParameterMetadata `json:"inputs"`
+	Outputs    []ParameterMetadata `json:"outputs"`
+	Deprecated string              `json:"deprecated,omitempty"`
+	Since      string              `json:"since,omitempty"`
+	Tags       []string            `json:"tags,omitempty"`
+}
+
+// ParameterMetadata is the documentation of an input or output of a function
+// Default and Example are the string values of the "@default(...)" and "@example(...)" markers.
+type ParameterMetadata struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Example     string `json:"example,omitempty"`
+}
@@ -31,20 +31,22 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"math/rand"
 	"net/http"
 	"os"
 	"os/exec"
-	"os/signal"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
-	"syscall"
 	"time"
 
 	"github.com/ansys/aali-flowkit/pkg/privatefunctions/codegeneration"
+	"github.com/ansys/aali-flowkit/pkg/privatefunctions/llmclient"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
@@ -61,16 +63,20 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
 // transferDatafromResponseToStreamChannel transfers the data from the response channel to the stream channel
 //
 // Parameters:
+//   - ctx: the request context, the transfer stops once it is done
 //   - responseChannel: the response channel
 //   - streamChannel: the stream channel
 //   - validateCode: the flag to indicate whether the code should be validated
 func transferDatafromResponseToStreamChannel(
+	ctx context.Context,
 	responseChannel *chan sharedtypes.HandlerResponse,
 	streamChannel *chan string,
 	validateCode bool,
@@ -90,18 +96,33 @@ func transferDatafromResponseToStreamChannel(
 		}
 	}()
 
-	// Defer the closing of the channels
-	defer close(*responseChannel)
+	// Defer the closing of the stream channel, the response channel is closed by the LLM client
 	defer close(*streamChannel)
 
-	// Loop through the response channel
+	// the messages are written in the stream format of the request
+	writer := newStreamWriter(ctx, streamChannel)
+
+	// Loop through the response channel until it is closed or the request is done
 	responseAsStr := ""
-	for response := range *responseChannel {
+	for {
+		var response sharedtypes.HandlerResponse
+		var ok bool
+		select {
+		case response, ok = <-*responseChannel:
+		case <-ctx.Done():
+			logging.Log.Debugf(&logging.ContextMap{}, "Stopping the stream, the request is done: %v", ctx.Err())
+			return
+		}
+		if !ok {
+			return
+		}
+
 		// Check if the response is an error
 		if response.Type == "error" {
-			logging.Log.Errorf(&logging.ContextMap{}, "Error in request %v: %v\n", response.InstructionGuid, response.Error.Message)
+			err := errorFromLLMResponse(response.Error)
+			logging.Log.Errorf(&logging.ContextMap{}, "Error in request %v: %v\n", response.InstructionGuid, err.Message)
 			// send the error message to the stream channel and exit function
-			*streamChannel <- fmt.Sprintf("$&$error$&$:$&$%v$&$", response.Error.Message)
+			writer.error(err)
 			return
 		}
 
@@ -109,12 +130,11 @@ func transferDatafromResponseToStreamChannel(
 		responseAsStr += *response.ChatData
 
 		// send the response to the stream channel
-		*streamChannel <- *response.ChatData
+		writer.token(*response.ChatData)
 
 		// check for last response
 		if *(response.IsLast) {
 
-			finalMessage := ""
 			// check for token count
 			if sendTokenCount {
 
@@ -122,8 +142,8 @@ func transferDatafromResponseToStreamChannel(
 				outputTokenCount, err := openAiTokenCount(tokenCountModelName, responseAsStr)
 				if err != nil {
 					logging.Log.Errorf(&logging.ContextMap{}, "Error getting token count: %v\n", err)
-					// send the error message to the stream channel and exit function
-					*streamChannel <- fmt.Sprintf("$&$error$&$:$&$Error getting token count: %v$&$", err)
+					// send the error message to the stream channel
+					writer.error(NewInternalError(err, "Error getting token count: %v", err))
 				}
 
 				// calculate the total token count
@@ -131,21 +151,19 @@ func transferDatafromResponseToStreamChannel(
 				totalOuputTokenCount := previousOutputTokenCount + outputTokenCount
 
 				// send the token count to the token count endpoint
-				err = sendTokenCountToEndpoint(jwtToken, tokenCountEndpoint, totalInputTokenCount, totalOuputTokenCount)
+				err = sendTokenCountToEndpoint(ctx, jwtToken, tokenCountEndpoint, totalInputTokenCount, totalOuputTokenCount)
 				if err != nil {
 					logging.Log.Errorf(&logging.ContextMap{}, "Error sending token count: %v\n", err)
-					// send the error message to the stream channel and exit function
-					*streamChannel <- fmt.Sprintf("$&$error$&$:$&$Error in updating token count: %v$&$", err)
+					// send the error message to the stream channel
+					writer.error(NewInternalError(err, "Error in updating token count: %v", err))
 				} else {
-					// append the token count message to the final message
-					finalMessage += fmt.Sprintf("$&$input_token_count$&$:$&$%d$&$;$&$output_token_count$&$:$&$%d$&$;", totalInputTokenCount, totalOuputTokenCount)
+					writer.usage(totalInputTokenCount, totalOuputTokenCount)
 				}
 			}
 
 			// check for contex
 			if sendContex {
-				// append context to the final message
-				finalMessage += fmt.Sprintf("$&$context$&$:$&$%s$&$;", contex)
+				writer.context(contex)
 			}
 
 			// check for code validation
@@ -163,21 +181,19 @@ func transferDatafromResponseToStreamChannel(
 					} else {
 						if valid {
 							if warnings {
-								finalMessage += "$&$code_validation$&$:$&$warning$&$;"
+								writer.validation(ValidationWarning)
 							} else {
-								finalMessage += "$&$code_validation$&$:$&$valid$&$;"
+								writer.validation(ValidationValid)
 							}
 						} else {
-							finalMessage += "$&$code_validation$&$:$&$invalid$&$;"
+							writer.validation(ValidationInvalid)
 						}
 					}
 				}
 			}
 
-			// send the final message to the stream channel
-			if finalMessage != "" {
-				*streamChannel <- finalMessage
-			}
+			// send the final message of the legacy format
+			writer.flush()
 
 			// exit the function
 			return
@@ -188,6 +204,7 @@ func transferDatafromResponseToStreamChannel(
 // sendTokenCount sends the token count to the token count endpoint
 //
 // Parameters:
+// - ctx: the request context
 // - userEmail: the email of the user
 // - tokenCountEndpoint: the endpoint to send the token count to
 // - inputTokenCount: the number of input tokens
@@ -195,7 +212,7 @@ func transferDatafromResponseToStreamChannel(
 //
 // Returns:
 // - err: an error if the request fails
-func sendTokenCountToEndpoint(jwtToken string, tokenCountEndpoint string, inputTokenCount int, ouputTokenCount int) (err error) {
+func sendTokenCountToEndpoint(ctx context.Context, jwtToken string, tokenCountEndpoint string, inputTokenCount int, ouputTokenCount int) (err error) {
 	defer func() {
 		r := recover()
 		if r != nil {
@@ -226,7 +243,7 @@ func sendTokenCountToEndpoint(jwtToken string, tokenCountEndpoint string, inputT
 	}
 
 	// Create a new HTTP request
-	request, err := http.NewRequest("POST", tokenCountEndpoint, bytes.NewBuffer(jsonData))
+	request, err := http.NewRequestWithContext(ctx, "POST", tokenCountEndpoint, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return fmt.Errorf("error creating request: %v", err)
 	}
@@ -257,6 +274,7 @@ func sendTokenCountToEndpoint(jwtToken string, tokenCountEndpoint string, inputT
 // sendChatRequestNoHistory sends a chat request to LLM without history
 //
 // Parameters:
+//   - ctx: the request context
 //   - data: the input string
 //   - chatRequestType: the chat request type
 //   - maxKeywordsSearch: the maximum number of keywords to search for
@@ -266,13 +284,15 @@ func sendTokenCountToEndpoint(jwtToken string, tokenCountEndpoint string, inputT
 //
 // Returns:
 //   - chan sharedtypes.HandlerResponse: the response channel
-func sendChatRequestNoHistory(data string, chatRequestType string, maxKeywordsSearch uint32, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions) chan sharedtypes.HandlerResponse {
-	return sendChatRequest(data, chatRequestType, nil, maxKeywordsSearch, "", llmHandlerEndpoint, modelIds, nil, options, nil)
+func sendChatRequestNoHistory(ctx context.Context, data string, chatRequestType string, maxKeywordsSearch uint32, llmHandlerEndpoint string, modelIds []string, options *sharedtypes.ModelOptions) chan sharedtypes.HandlerResponse {
+	return sendChatRequest(ctx, data, chatRequestType, nil, maxKeywordsSearch, "", llmHandlerEndpoint, modelIds, nil, options, nil)
 }
 
 // sendChatRequest sends a chat request to LLM
+// The response channel is closed by the LLM client after the last response.
 //
 // Parameters:
+//   - ctx: the request context
 //   - data: the input string
 //   - chatRequestType: the chat request type
 //   - history: the conversation history
@@ -284,61 +304,20 @@ func sendChatRequestNoHistory(data string, chatRequestType string, maxKeywordsSe
 //
 // Returns:
 //   - chan sharedtypes.HandlerResponse: the response channel
-func sendChatRequest(data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, llmHandlerEndpoint string, modelIds []string, modelCategory []string, options *sharedtypes.ModelOptions, images []string) chan sharedtypes.HandlerResponse {
-	// Initiate the channels
-	requestChannelChat := make(chan []byte, 400)
-	responseChannel := make(chan sharedtypes.HandlerResponse) // Create a channel for responses
-
-	c := initializeClient(llmHandlerEndpoint)
-	go shutdownHandler(c)
-	go listener(c, responseChannel, false)
-	go writer(c, requestChannelChat, responseChannel)
-	go sendRequest("chat", data, requestChannelChat, chatRequestType, "true", false, history, maxKeywordsSearch, systemPrompt, responseChannel, modelIds, modelCategory, options, images)
-
-	return responseChannel // Return the response channel
-}
-
-// sendChatRequestNoStreaming sends a chat request to LLM without streaming
-//
-// Parameters:
-//   - data: the input string
-//   - chatRequestType: the chat request type
-//   - history: the conversation history
-//   - maxKeywordsSearch: the maximum number of keywords to search for
-//   - systemPrompt: the system prompt
-//   - llmHandlerEndpoint: the LLM Handler endpoint
-//   - modelIds: the model IDs
-//   - options: the model options
-//
-// Returns:
-//   - string: the response
-func sendChatRequestNoStreaming(data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt string, llmHandlerEndpoint string, modelIds []string, modelCategory []string, options *sharedtypes.ModelOptions, images []string) string {
-	// Initiate the channels
-	requestChannelChat := make(chan []byte, 400)
-	responseChannel := make(chan sharedtypes.HandlerResponse) // Create a channel for responses
-
-	// Initialize the client, handlers and send the request
-	c := initializeClient(llmHandlerEndpoint)
-	go shutdownHandler(c)
-	go listener(c, responseChannel, true)
-	go writer(c, requestChannelChat, responseChannel)
-	go sendRequest("chat", data, requestChannelChat, chatRequestType, "false", false, history, maxKeywordsSearch, systemPrompt, responseChannel, modelIds, modelCategory, options, images)
-
-	// receive single answer from the response channel
-	response := <-responseChannel
-
-	// check for error
-	if response.Type == "error" {
-		logging.Log.Errorf(&logging.ContextMap{}, "Error in request %v: %v\n", response.InstructionGuid, response.Error.Message)
-		panic(response.Error.Message)
+func sendChatRequest(ctx context.Context, data string, chatRequestType string, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, llmHandlerEndpoint string, modelIds []string, modelCategory []string, options *sharedtypes.ModelOptions, images []string) chan sharedtypes.HandlerResponse {
+	request, err := createRequest("chat", data, chatRequestType, "true", false, history, maxKeywordsSearch, systemPrompt, modelIds, modelCategory, options, images)
+	if err != nil {
+		return errorResponseChannel(err.Error())
 	}
 
-	return *response.ChatData
+	return sendRequest(ctx, llmHandlerEndpoint, request, false)
 }
 
 // sendEmbeddingsRequest sends an embeddings request to LLM
+// The response channel is closed by the LLM client after the response.
 //
 // Parameters:
+//   - ctx: the request context
 //   - data: the input string
 //   - llmHandlerEndpoint: the LLM Handler endpoint
 //   - getSparseEmbeddings: the flag to indicate whether to get sparse embeddings
@@ -346,199 +325,47 @@ func sendChatRequestNoStreaming(data string, chatRequestType string, history []s
 //
 // Returns:
 //   - chan sharedtypes.HandlerResponse: the response channel
-func sendEmbeddingsRequest(data interface{}, llmHandlerEndpoint string, getSparseEmbeddings bool, modelIds []string) chan sharedtypes.HandlerResponse {
-	// Initiate the channels
-	requestChannelEmbeddings := make(chan []byte, 400)
-	responseChannel := make(chan sharedtypes.HandlerResponse) // Create a channel for responses
-
-	c := initializeClient(llmHandlerEndpoint)
-	go shutdownHandler(c)
-	go listener(c, responseChannel, false)
-	go writer(c, requestChannelEmbeddings, responseChannel)
-
-	go sendRequest("embeddings", data, requestChannelEmbeddings, "", "", getSparseEmbeddings, nil, 0, "", responseChannel, modelIds, nil, nil, nil)
-	return responseChannel // Return the response channel
-}
-
-// initializeClient initializes the LLM Handler client
-//
-// Returns:
-//   - *websocket.Conn: the websocket connection
-func initializeClient(llmHandlerEndpoint string) *websocket.Conn {
-	url := llmHandlerEndpoint
-
-	c, _, err := websocket.Dial(context.Background(), url, nil)
+func sendEmbeddingsRequest(ctx context.Context, data interface{}, llmHandlerEndpoint string, getSparseEmbeddings bool, modelIds []string) chan sharedtypes.HandlerResponse {
+	request, err := createRequest("embeddings", data, "", "", getSparseEmbeddings, nil, 0, "", modelIds, nil, nil, nil)
 	if err != nil {
-		errMessage := fmt.Sprintf("failed to connect to aali-llm: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		return errorResponseChannel(err.Error())
 	}
-	// Disable the read limit
-	c.SetReadLimit(-1)
 
-	// Get API key
-	apiKey := config.GlobalConfig.LLM_API_KEY
-
-	// Legacy authentication
-	if apiKey == "" {
-		apiKey = "testkey"
-	}
-
-	// Send apikey for authentication
-	err = c.Write(context.Background(), websocket.MessageText, []byte(apiKey))
-	if err != nil {
-		errMessage := fmt.Sprintf("failed to send authentication message to aali-llm: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
-	}
-
-	return c
+	return sendRequest(ctx, llmHandlerEndpoint, request, true)
 }
 
-// listener listens for messages from the LLM Handler
+// sendRequest sends a request to LLM over the pooled LLM client
 //
 // Parameters:
-//   - c: the websocket connection
-//   - responseChannel: the response channel
-func listener(c *websocket.Conn, responseChannel chan sharedtypes.HandlerResponse, singleRequest bool) {
-
-	// Close the connection when the function returns
-	defer c.Close(websocket.StatusNormalClosure, "")
-
-	// Boolean flag to stop the listener (and close the connection)
-	var stopListener bool
-
-	for {
-		// By default, stop the listener after receiving a message (most of them will be single messages)
-		stopListener = true
-		typ, message, err := c.Read(context.Background())
-		if err != nil {
-			errMessage := fmt.Sprintf("failed to read message from aali-llm: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			response := sharedtypes.HandlerResponse{
-				Type: "error",
-				Error: &sharedtypes.ErrorResponse{
-					Code:    4,
-					Message: errMessage,
-				},
-			}
-			responseChannel <- response
-			return
-		}
-		switch typ {
-		case websocket.MessageText, websocket.MessageBinary:
-			var response sharedtypes.HandlerResponse
-
-			err = json.Unmarshal(message, &response)
-			if err != nil {
-				// Check if it is the authentication message
-				msgAsStr := string(message)
-				if msgAsStr == "authentication successful" {
-					logging.Log.Debugf(&logging.ContextMap{}, "Authentication to LLM was successful.")
-					continue
-				} else {
-					errMessage := fmt.Sprintf("failed to unmarshal message from aali-llm: %v", err)
-					logging.Log.Error(&logging.ContextMap{}, errMessage)
-					response := sharedtypes.HandlerResponse{
-						Type: "error",
-						Error: &sharedtypes.ErrorResponse{
-							Code:    4,
-							Message: errMessage,
-						},
-					}
-					responseChannel <- response
-					return
-				}
-			}
-
-			if response.Type == "error" {
-				errMessage := fmt.Sprintf("error in request %v: %v (%v)\n", response.InstructionGuid, response.Error.Code, response.Error.Message)
-				logging.Log.Error(&logging.ContextMap{}, errMessage)
-				response := sharedtypes.HandlerResponse{
-					Type: "error",
-					Error: &sharedtypes.ErrorResponse{
-						Code:    4,
-						Message: errMessage,
-					},
-				}
-				responseChannel <- response
-				return
-			} else {
-				switch response.Type {
-				case "chat":
-					if !singleRequest && !*(response.IsLast) {
-						// If it is not the last message, continue listening
-						stopListener = false
-					} else {
-						// If it is the last message, stop listening
-						logging.Log.Debugf(&logging.ContextMap{}, "Chat response completely received from aali-llm.")
-					}
-				case "embeddings":
-					logging.Log.Debugf(&logging.ContextMap{}, "Embeddings received from aali-llm.")
-				case "info":
-					logging.Log.Infof(&logging.ContextMap{}, "Info %v: %v\n", response.InstructionGuid, *response.InfoMessage)
-					stopListener = false
-					continue
-				default:
-					logging.Log.Warn(&logging.ContextMap{}, "Response with unsupported value for 'Type' property received from aali-llm. Ignoring...")
-				}
-				// Send the response to the channel
-				responseChannel <- response
-			}
-		default:
-			logging.Log.Warnf(&logging.ContextMap{}, "Response with unsupported message type '%v'received from aali-llm. Ignoring...\n", typ)
-		}
-
-		// If stopListener is true, stop the listener
-		// This will happen when:
-		// - the chat response is the last one
-		// - the embeddings response is received
-		// - an unsupported adapter type is received
-		if stopListener {
-			logging.Log.Debugf(&logging.ContextMap{}, "Stopping listener for aali-llm request.")
-			return
-		}
-	}
-}
-
-// writer writes messages to the LLM Handler
+//   - ctx: the request context
+//   - llmHandlerEndpoint: the LLM Handler endpoint
+//   - request: the request
+//   - singleResponse: true if the request is answered with a single response
 //
-// Parameters:
-//   - c: the websocket connection
-//   - RequestChannel: the request channel
-func writer(c *websocket.Conn, RequestChannel chan []byte, responseChannel chan sharedtypes.HandlerResponse) {
-	defer close(RequestChannel)
-	for {
-		requestJSON := <-RequestChannel
-
-		err := c.Write(context.Background(), websocket.MessageBinary, requestJSON)
-		if err != nil {
-			errMessage := fmt.Sprintf("failed to write message to aali-llm: %v", err)
-			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			response := sharedtypes.HandlerResponse{
-				Type: "error",
-				Error: &sharedtypes.ErrorResponse{
-					Code:    4,
-					Message: errMessage,
-				},
-			}
-			responseChannel <- response
-			return
-		}
+// Returns:
+//   - chan sharedtypes.HandlerResponse: the response channel
+func sendRequest(ctx context.Context, llmHandlerEndpoint string, request sharedtypes.HandlerRequest, singleResponse bool) chan sharedtypes.HandlerResponse {
+	responseChannel, err := llmclient.Send(ctx, llmHandlerEndpoint, request, singleResponse)
+	if err != nil {
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamLLM, err, "%v", err))
 	}
+
+	return responseChannel
 }
 
-// sendRequest sends a request to LLM
+// createRequest creates a request to LLM
 //
 // Parameters:
 //   - adapter: the adapter type. Types: "chat", "embeddings"
 //   - data: the input string
-//   - RequestChannel: the request channel
 //   - chatRequestType: the chat request type. Types: "summary", "code", "keywords"
 //   - dataStream: the data stream flag
 //   - history: the conversation history
-//   - sc: the session context
-func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, chatRequestType string, dataStream string, getSparseEmbeddings bool, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, responseChannel chan sharedtypes.HandlerResponse, modelIds []string, modelCategory []string, options *sharedtypes.ModelOptions, images []string) {
+//
+// Returns:
+//   - sharedtypes.HandlerRequest: the request
+//   - error: an error if the request is invalid
+func createRequest(adapter string, data interface{}, chatRequestType string, dataStream string, getSparseEmbeddings bool, history []sharedtypes.HistoricMessage, maxKeywordsSearch uint32, systemPrompt interface{}, modelIds []string, modelCategory []string, options *sharedtypes.ModelOptions, images []string) (sharedtypes.HandlerRequest, error) {
 	request := sharedtypes.HandlerRequest{
 		Adapter:         adapter,
 		InstructionGuid: strings.Replace(uuid.New().String(), "-", "", -1),
@@ -570,30 +397,14 @@ func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, c
 		if chatRequestType == "" {
 			errMessage := "Property 'ChatRequestType' is required for 'Adapter' type 'chat' requests to aali-llm."
 			logging.Log.Warn(&logging.ContextMap{}, errMessage)
-			response := sharedtypes.HandlerResponse{
-				Type: "error",
-				Error: &sharedtypes.ErrorResponse{
-					Code:    4,
-					Message: errMessage,
-				},
-			}
-			responseChannel <- response
-			return
+			return request, errors.New(errMessage)
 		}
 		request.ChatRequestType = chatRequestType
 
 		if dataStream == "" {
 			errMessage := "Property 'DataStream' is required for for 'Adapter' type 'chat' requests to aali-llm."
 			logging.Log.Warn(&logging.ContextMap{}, errMessage)
-			response := sharedtypes.HandlerResponse{
-				Type: "error",
-				Error: &sharedtypes.ErrorResponse{
-					Code:    4,
-					Message: errMessage,
-				},
-			}
-			responseChannel <- response
-			return
+			return request, errors.New(errMessage)
 		}
 
 		if dataStream == "true" {
@@ -615,40 +426,27 @@ func sendRequest(adapter string, data interface{}, RequestChannel chan []byte, c
 		}
 	}
 
-	requestJSON, err := json.Marshal(request)
-	if err != nil {
-		errMessage := fmt.Sprintf("failed to marshal request to aali-llm: %v", err)
-		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		response := sharedtypes.HandlerResponse{
-			Type: "error",
-			Error: &sharedtypes.ErrorResponse{
-				Code:    4,
-				Message: errMessage,
-			},
-		}
-		responseChannel <- response
-		return
-	}
-
-	RequestChannel <- requestJSON
+	return request, nil
 }
 
-// shutdownHandler handles the shutdown of the LLM Handler
+// errorResponseChannel creates a closed response channel holding a single error response
 //
 // Parameters:
-//   - c: the websocket connection
-//   - RequestChannel: the request channel
-func shutdownHandler(c *websocket.Conn) {
-	signalCh := make(chan os.Signal, 1)
-	signal.Notify(signalCh, syscall.SIGINT)
-
-	sig := <-signalCh
-	logging.Log.Debugf(&logging.ContextMap{}, "Closing client. Received closing signal: %v\n", sig)
-
-	// close connection
-	c.Close(websocket.StatusNormalClosure, "Normal Closure")
-
-	os.Exit(0)
+//   - errMessage: the error message
+//
+// Returns:
+//   - chan sharedtypes.HandlerResponse: the response channel
+func errorResponseChannel(errMessage string) chan sharedtypes.HandlerResponse {
+	responseChannel := make(chan sharedtypes.HandlerResponse, 1)
+	responseChannel <- sharedtypes.HandlerResponse{
+		Type: "error",
+		Error: &sharedtypes.ErrorResponse{
+			Code:    llmclient.ErrorCodeRequest,
+			Message: errMessage,
+		},
+	}
+	close(responseChannel)
+	return responseChannel
 }
 
 // createDbArrayFilter creates an array filter for the KnowledgeDB.
@@ -801,6 +599,7 @@ func formatTemplate(template string, data map[string]string) string {
 // ansysGPTACSSemanticHybridSearch performs a semantic hybrid search in ACS
 //
 // Parameters:
+//   - ctx: the request context
 //   - query: the query string
 //   - embeddedQuery: the embedded query
 //   - indexName: the index name
@@ -813,6 +612,7 @@ func formatTemplate(template string, data map[string]string) string {
 // Returns:
 //   - output: the search results
 func ansysGPTACSSemanticHybridSearch(
+	ctx context.Context,
 	acsEndpoint string,
 	acsApiKey string,
 	acsApiVersion string,
@@ -917,7 +717,7 @@ func ansysGPTACSSemanticHybridSearch(
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		errMessage := fmt.Errorf("failed to create POST request for ACS: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
@@ -1006,7 +806,7 @@ func getFieldsAndReturnProperties(indexName string) (searchedEmbeddedFields stri
 	default:
 		errMessage := fmt.Sprintf("Index name not found: %s", indexName)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInvalidInputError("indexName", "%s", errMessage))
 	}
 	return searchedEmbeddedFields, returnedProperties
 }
@@ -1028,7 +828,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		if err != nil {
 			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStruct: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 		output = respObject.Value
 
@@ -1038,7 +838,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		if err != nil {
 			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStructALH: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		for _, item := range respObjectAlh.Value {
@@ -1065,7 +865,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		if err != nil {
 			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStructLSdyna: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		for _, item := range respObjectLsdyna.Value {
@@ -1090,7 +890,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 		if err != nil {
 			errMessage := fmt.Sprintf("failed to unmarshal response body from ACS to ACSSearchResponseStructCrtech: %v", err)
 			logging.Log.Error(&logging.ContextMap{}, errMessage)
-			panic(errMessage)
+			panic(NewInternalError(err, "%s", errMessage))
 		}
 
 		for _, item := range respObjectCrtech.Value {
@@ -1114,7 +914,7 @@ func extractAndConvertACSResponse(body []byte, indexName string) (output []share
 	default:
 		errMessage := fmt.Sprintf("Index name not found: %s", indexName)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
-		panic(errMessage)
+		panic(NewInvalidInputError("indexName", "%s", errMessage))
 	}
 
 	return output
@@ -1236,14 +1036,12 @@ func dataExtractionFilterGithubTreeEntries(tree *github.Tree, githubFilteredDire
 // dataExtractNewGithubClient initializes a new GitHub client with the given access token.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - githubAccessToken: the GitHub access token.
 //
 // Returns:
 //   - *github.Client: the GitHub client.
-//   - context.Context: the context.
-func dataExtractNewGithubClient(githubAccessToken string) (client *github.Client, ctx context.Context) {
-	ctx = context.Background()
-
+func dataExtractNewGithubClient(ctx context.Context, githubAccessToken string) (client *github.Client) {
 	// Setup OAuth2 token source with the GitHub access token.
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: githubAccessToken},
@@ -1255,7 +1053,7 @@ func dataExtractNewGithubClient(githubAccessToken string) (client *github.Client
 	// Initialize a new GitHub client with the OAuth2 client.
 	client = github.NewClient(tc)
 
-	return client, ctx
+	return client
 }
 
 // dataExtractionLocalFilepathExtractWalker is the walker function for the local file extraction.
@@ -1377,6 +1175,7 @@ func dataExtractionLocalFilepathExtractWalker(localPath string, localFileExtensi
 // dataExtractionDocumentLevelHandler handles the data extraction at document level.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - inputChannel: the input channel.
 //   - chunks: the document chunks.
 //   - documentId: the document ID.
@@ -1387,11 +1186,27 @@ func dataExtractionLocalFilepathExtractWalker(localPath string, localFileExtensi
 //
 // Returns:
 //   - orderedChildDataObjects: the ordered child data objects.
-func dataExtractionDocumentLevelHandler(inputChannel chan *DataExtractionLLMInputChannelItem, errorChannel chan error, chunks []string, documentId string, documentPath string, getSummary bool,
+func dataExtractionDocumentLevelHandler(ctx context.Context, inputChannel chan *DataExtractionLLMInputChannelItem, errorChannel chan error, chunks []string, documentId string, documentPath string, getSummary bool,
 	getKeywords bool, numKeywords uint32) (orderedChildDataObjects []*sharedtypes.DbData, err error) {
 	instructionSequenceWaitGroup := &sync.WaitGroup{}
 	orderedChildData := make([]*sharedtypes.DbData, 0, len(chunks))
 
+	// Count the LLM requests of the level for the progress of the document.
+	requestsPerChunk := 1
+	if getSummary {
+		requestsPerChunk++
+	}
+	if getKeywords {
+		requestsPerChunk++
+	}
+	requests := 0
+	for _, chunk := range chunks {
+		if len(chunk) > 0 {
+			requests += requestsPerChunk
+		}
+	}
+	ProgressReporterFromContext(ctx).AddTotal(requests)
+
 	for idx, chunk := range chunks {
 		// Create data child object.
 		childData := &sharedtypes.DbData{
@@ -1482,6 +1297,7 @@ func dataExtractionNewLlmInputChannelItem(data *sharedtypes.DbData, instructionS
 // dataExtractionLLMHandlerWorker is a worker function for the LLM Handler requests during data extraction.
 //
 // Parameters:
+//   - ctx: the request context
 //   - waitgroup: the wait group
 //   - inputChannel: the input channel
 //   - errorChannel: the error channel
@@ -1489,7 +1305,7 @@ func dataExtractionNewLlmInputChannelItem(data *sharedtypes.DbData, instructionS
 //
 // Returns:
 //   - error: an error if any
-func dataExtractionLLMHandlerWorker(waitgroup *sync.WaitGroup, inputChannel chan *DataExtractionLLMInputChannelItem, errorChannel chan error, embeddingsDimensions int) {
+func dataExtractionLLMHandlerWorker(ctx context.Context, waitgroup *sync.WaitGroup, inputChannel chan *DataExtractionLLMInputChannelItem, errorChannel chan error, embeddingsDimensions int) {
 	defer waitgroup.Done()
 	// Listen to Input Channel
 	for instruction := range inputChannel {
@@ -1507,13 +1323,13 @@ func dataExtractionLLMHandlerWorker(waitgroup *sync.WaitGroup, inputChannel chan
 		switch instruction.Adapter {
 		case "chat":
 			if instruction.ChatRequestType == "summary" {
-				res, err := llmHandlerPerformSummaryRequest(instruction.Data.Text)
+				res, err := llmHandlerPerformSummaryRequest(ctx, instruction.Data.Text)
 				if err != nil {
 					errorChannel <- err
 				}
 				instruction.Data.Summary = res
 			} else if instruction.ChatRequestType == "keywords" {
-				res, err := llmHandlerPerformKeywordExtractionRequest(instruction.Data.Text, instruction.MaxNumberOfKeywords)
+				res, err := llmHandlerPerformKeywordExtractionRequest(ctx, instruction.Data.Text, instruction.MaxNumberOfKeywords)
 				if err != nil {
 					errorChannel <- err
 				}
@@ -1524,6 +1340,7 @@ func dataExtractionLLMHandlerWorker(waitgroup *sync.WaitGroup, inputChannel chan
 
 		// Lower instruction sequence waitgroup counter
 		instruction.InstructionSequenceWaitGroup.Done()
+		ProgressReporterFromContext(ctx).Add(1)
 	}
 
 	logging.Log.Debugf(&logging.ContextMap{}, "LLM Handler Worker stopped.")
@@ -1532,12 +1349,13 @@ func dataExtractionLLMHandlerWorker(waitgroup *sync.WaitGroup, inputChannel chan
 // dataExtractionProcessBatchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - documentData: the document data.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func dataExtractionProcessBatchEmbeddings(documentData []*sharedtypes.DbData, maxBatchSize int) error {
+func dataExtractionProcessBatchEmbeddings(ctx context.Context, documentData []*sharedtypes.DbData, maxBatchSize int) error {
 	// Remove empty chunks (including root node if applicable)
 	nonEmptyDocumentData := make([]*sharedtypes.DbData, 0, len(documentData))
 	for _, data := range documentData {
@@ -1566,7 +1384,7 @@ func dataExtractionProcessBatchEmbeddings(documentData []*sharedtypes.DbData, ma
 		}
 
 		// Perform vector embedding request to LLM handler
-		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, false)
+		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, false)
 		if err != nil {
 			return fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -1583,25 +1401,27 @@ func dataExtractionProcessBatchEmbeddings(documentData []*sharedtypes.DbData, ma
 // llmHandlerPerformVectorEmbeddingRequest performs a vector embedding request to LLM Handler.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - input: slice of input strings.
 //
 // Returns:
 //   - embeddedVector: the embedded vectors.
 //   - error: an error if any.
-func llmHandlerPerformVectorEmbeddingRequest(input []string, sparse bool) (embeddedVectors [][]float32, sparseEmbeddings []map[uint]float32, err error) {
+func llmHandlerPerformVectorEmbeddingRequest(ctx context.Context, input []string, sparse bool) (embeddedVectors [][]float32, sparseEmbeddings []map[uint]float32, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send embeddings request.
-	responseChannel := sendEmbeddingsRequest(input, llmHandlerEndpoint, sparse, nil)
+	responseChannel := sendEmbeddingsRequest(ctx, input, llmHandlerEndpoint, sparse, nil)
 
-	// Process the first response and close the channel.
+	// Process the first response
 	embeddedVectors = make([][]float32, len(input))
 	sparseEmbeddings = make([]map[uint]float32, len(input))
 	for response := range responseChannel {
 		// Check if the response is an error.
 		if response.Type == "error" {
-			return nil, nil, fmt.Errorf("error in vector embedding request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			logging.Log.Errorf(&logging.ContextMap{}, "error in vector embedding request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			return nil, nil, errorFromLLMResponse(response.Error)
 		}
 
 		// Check if the response is an info message.
@@ -1677,33 +1497,32 @@ func llmHandlerPerformVectorEmbeddingRequest(input []string, sparse bool) (embed
 		}
 	}
 
-	// Close the response channel
-	close(responseChannel)
-
 	return embeddedVectors, sparseEmbeddings, nil
 }
 
 // llmHandlerPerformSummaryRequest performs a summary request to LLM Handler.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - input: the input string.
 //
 // Returns:
 //   - summary: the summary.
 //   - error: an error if any.
-func llmHandlerPerformSummaryRequest(input string) (summary string, err error) {
+func llmHandlerPerformSummaryRequest(ctx context.Context, input string) (summary string, err error) {
 	// get the LLM handler endpoint
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequestNoHistory(input, "summary", 1, llmHandlerEndpoint, nil, nil)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "summary", 1, llmHandlerEndpoint, nil, nil)
 
 	// Process all responses.
 	var responseAsStr string
 	for response := range responseChannel {
 		// Check if the response is an error.
 		if response.Type == "error" {
-			return "", fmt.Errorf("error in summary request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			logging.Log.Errorf(&logging.ContextMap{}, "error in summary request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			return "", errorFromLLMResponse(response.Error)
 		}
 
 		// Accumulate the responses.
@@ -1717,88 +1536,192 @@ func llmHandlerPerformSummaryRequest(input string) (summary string, err error) {
 
 	logging.Log.Debugf(&logging.ContextMap{}, "Received summary response.")
 
-	// Close the response channel.
-	close(responseChannel)
-
 	// Return the response.
 	return responseAsStr, nil
 }
 
-// performGeneralRequest performs a general chat completion request to LLM.
+// sendLLMRequest sends a general chat request to the LLM with the given options
 //
 // Parameters:
-//   - input: the input string.
-//   - history: the conversation history.
-//   - isStream: the stream flag.
-//   - systemPrompt: the system prompt.
+//   - ctx: the request context
+//   - input: the user input
+//   - options: the options of the request
 //
 // Returns:
-//   - message: the generated message.
-//   - stream: the stream channel.
-//   - err: the error.
-func performGeneralRequest(input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, options *sharedtypes.ModelOptions) (message string, stream *chan string, err error) {
-	// get the LLM handler endpoint.
-	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
-
-	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequest(input, "general", history, 0, systemPrompt, llmHandlerEndpoint, nil, nil, options, nil)
-
-	// If isStream is true, create a stream channel and return asap.
-	if isStream {
-		// Create a stream channel
-		streamChannel := make(chan string, 400)
-
-		// Start a goroutine to transfer the data from the response channel to the stream channel.
-		go transferDatafromResponseToStreamChannel(&responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
-
-		// Return the stream channel.
-		return "", &streamChannel, nil
+//   - chan sharedtypes.HandlerResponse: the response channel
+func sendLLMRequest(ctx context.Context, input string, options LLMRequestOptions) chan sharedtypes.HandlerResponse {
+	// aali-llm expects an empty system prompt rather than none
+	systemPrompt := options.SystemPrompt
+	if systemPrompt == nil {
+		systemPrompt = ""
 	}
 
-	// Process all responses.
-	var responseAsStr string
+	return sendChatRequest(ctx, input, "general", options.History, 0, systemPrompt, config.GlobalConfig.LLM_HANDLER_ENDPOINT, options.ModelIds, options.ModelCategory, options.ModelOptions, options.Images)
+}
+
+// receiveLLMResponse accumulates the responses of a general request to the LLM until the last one
+//
+// Parameters:
+//   - ctx: the request context
+//   - responseChannel: the response channel of the request
+//
+// Returns:
+//   - message: the response message
+//   - err: the error of the request, nil if the last response was received
+func receiveLLMResponse(ctx context.Context, responseChannel chan sharedtypes.HandlerResponse) (message string, err error) {
 	for response := range responseChannel {
-		// Check if the response is an error.
+		// Check if the response is an error
 		if response.Type == "error" {
-			return "", nil, fmt.Errorf("error in general llm request %v: %v (%v)", response.InstructionGuid, response.Error.Code, response.Error.Message)
+			err := errorFromLLMResponse(response.Error)
+			logging.Log.Errorf(&logging.ContextMap{}, "error in llm request %v: %v", response.InstructionGuid, err.Message)
+			return "", err
 		}
 
 		if response.Type == "info" {
-			logging.Log.Infof(&logging.ContextMap{}, "Received info message for general llm request: %v: %v", response.InstructionGuid, response.InfoMessage)
+			logging.Log.Infof(&logging.ContextMap{}, "Received info message for llm request: %v: %v", response.InstructionGuid, response.InfoMessage)
 			continue
 		}
 
-		// Accumulate the responses.
-		responseAsStr += *(response.ChatData)
+		// Accumulate the responses
+		message += *(response.ChatData)
 
-		// If we are at the last message, break the loop.
-		if *(response.IsLast) {
-			break
+		// If we are at the last message, stop
+		if response.IsLast != nil && *response.IsLast {
+			return message, nil
 		}
 	}
 
-	// Close the response channel
-	close(responseChannel)
+	// the LLM client closes the response channel early if the request was abandoned
+	if ctx.Err() != nil {
+		return "", errorFromContext(UpstreamLLM, ctx.Err(), "request to aali-llm aborted: %v", ctx.Err())
+	}
+	return message, nil
+}
 
-	// Return the response
-	return responseAsStr, nil, nil
+// generalLLMRequest performs a general request to the LLM, streamed or not, as the deprecated general request functions do
+//
+// Parameters:
+//   - ctx: the request context
+//   - input: the user input
+//   - isStream: the flag to indicate whether the response should be streamed
+//   - options: the options of the request
+//
+// Returns:
+//   - message: the response message, empty if streamed
+//   - stream: the stream channel, nil if not streamed
+func generalLLMRequest(ctx context.Context, input string, isStream bool, options LLMRequestOptions) (message string, stream *chan string) {
+	if isStream {
+		return "", PerformLLMStreamRequest(ctx, input, options)
+	}
+	message, _, _ = PerformLLMRequest(ctx, input, options)
+	return message, nil
+}
+
+// llmRequestTokenCount counts the input and output tokens of a request to the LLM with the OpenAI tokenizer of a model
+// The input tokens are the tokens of the input, of the system prompt and of the conversation history.
+// A system prompt given as a map of strings is counted as the sum of its values.
+//
+// Parameters:
+//   - modelName: the model name to use for token count
+//   - input: the user input
+//   - options: the options of the request
+//   - output: the response message
+//
+// Returns:
+//   - inputTokenCount: the input token count
+//   - outputTokenCount: the output token count
+func llmRequestTokenCount(modelName string, input string, options LLMRequestOptions, output string) (inputTokenCount int, outputTokenCount int) {
+	systemPrompt, prompts := "", []string{}
+	switch prompt := options.SystemPrompt.(type) {
+	case nil:
+	case string:
+		systemPrompt = prompt
+	case map[string]string:
+		for _, key := range slices.Sorted(maps.Keys(prompt)) {
+			prompts = append(prompts, prompt[key])
+		}
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(prompt)) {
+			value, isString := prompt[key].(string)
+			if !isString {
+				logPanicError(nil, NewInvalidInputError("options", "system prompt %q is not a string", key))
+			}
+			prompts = append(prompts, value)
+		}
+	default:
+		logPanicError(nil, NewInvalidInputError("options", "system prompt of type %T is neither a string nor a map of strings", prompt))
+	}
+
+	inputTokenCount, err := openAiTokenCount(modelName, input+systemPrompt)
+	if err != nil {
+		logPanicError(nil, NewInternalError(err, "error getting input token count: %v", err))
+	}
+	for _, prompt := range prompts {
+		promptTokenCount, err := openAiTokenCount(modelName, prompt)
+		if err != nil {
+			logPanicError(nil, NewInternalError(err, "error getting system prompt token count: %v", err))
+		}
+		inputTokenCount += promptTokenCount
+	}
+	for _, message := range options.History {
+		historyTokenCount, err := openAiTokenCount(modelName, message.Content)
+		if err != nil {
+			logPanicError(nil, NewInternalError(err, "error getting history token count: %v", err))
+		}
+		inputTokenCount += historyTokenCount
+	}
+
+	outputTokenCount, err = openAiTokenCount(modelName, output)
+	if err != nil {
+		logPanicError(nil, NewInternalError(err, "error getting output token count: %v", err))
+	}
+	return inputTokenCount, outputTokenCount
+}
+
+// performGeneralRequest performs a general chat completion request to LLM.
+// It is PerformLLMRequest or PerformLLMStreamRequest returning the error of the request instead of panicking.
+//
+// Parameters:
+//   - ctx: the request context.
+//   - input: the input string.
+//   - history: the conversation history.
+//   - isStream: the stream flag.
+//   - systemPrompt: the system prompt.
+//   - modelOptions: the model options, may be nil.
+//
+// Returns:
+//   - message: the generated message.
+//   - stream: the stream channel.
+//   - err: the error.
+func performGeneralRequest(ctx context.Context, input string, history []sharedtypes.HistoricMessage, isStream bool, systemPrompt string, modelOptions *sharedtypes.ModelOptions) (message string, stream *chan string, err error) {
+	options := LLMRequestOptions{
+		History:      history,
+		SystemPrompt: systemPrompt,
+		ModelOptions: modelOptions,
+	}
+	if isStream {
+		return "", PerformLLMStreamRequest(ctx, input, options), nil
+	}
+	message, err = receiveLLMResponse(ctx, sendLLMRequest(ctx, input, options))
+	return message, nil, err
 }
 
 // llmHandlerPerformKeywordExtractionRequest performs a keyword extraction request to LLM Handler.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - input: the input string.
 //   - numKeywords: the number of keywords.
 //
 // Returns:
 //   - keywords: the keywords.
 //   - error: an error if any.
-func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32) (keywords []string, err error) {
+func llmHandlerPerformKeywordExtractionRequest(ctx context.Context, input string, numKeywords uint32) (keywords []string, err error) {
 	// get the LLM handler endpoint.
 	llmHandlerEndpoint := config.GlobalConfig.LLM_HANDLER_ENDPOINT
 
 	// Set up WebSocket connection with LLM and send chat request.
-	responseChannel := sendChatRequestNoHistory(input, "keywords", numKeywords, llmHandlerEndpoint, nil, nil)
+	responseChannel := sendChatRequestNoHistory(ctx, input, "keywords", numKeywords, llmHandlerEndpoint, nil, nil)
 
 	// Process all responses.
 	var responseAsStr string
@@ -1819,9 +1742,6 @@ func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32)
 
 	logging.Log.Debugf(&logging.ContextMap{}, "Received keywords response.")
 
-	// Close the response channel.
-	close(responseChannel)
-
 	// Return the response.
 	return strings.Split(responseAsStr, ","), nil
 }
@@ -1829,6 +1749,7 @@ func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32)
 // dataExtractionPerformSplitterRequest performs a data extraction splitter request to the Python service.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - content: the content.
 //   - documentType: the document type.
 //   - chunkSize: the chunk size.
@@ -1837,7 +1758,7 @@ func llmHandlerPerformKeywordExtractionRequest(input string, numKeywords uint32)
 // Returns:
 //   - output: the output.
 //   - error: an error if any.
-func dataExtractionPerformSplitterRequest(content []byte, documentType string, chunkSize int, chunkOverlap int) (output []string, err error) {
+func dataExtractionPerformSplitterRequest(ctx context.Context, content []byte, documentType string, chunkSize int, chunkOverlap int) (output []string, err error) {
 	// Define the URL and headers.
 	url := config.GlobalConfig.FLOWKIT_PYTHON_ENDPOINT + "/splitter/" + documentType
 	headers := map[string]string{
@@ -1857,7 +1778,7 @@ func dataExtractionPerformSplitterRequest(content []byte, documentType string, c
 	}
 
 	// Send the request.
-	response, err := httpRequest("POST", url, headers, body)
+	response, err := httpRequest(ctx, "POST", url, headers, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1878,6 +1799,7 @@ func dataExtractionPerformSplitterRequest(content []byte, documentType string, c
 // httpRequest is a general function for making HTTP requests.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - method: HTTP method.
 //   - url: URL to make the request to.
 //   - headers: headers to include in the request.
@@ -1886,9 +1808,9 @@ func dataExtractionPerformSplitterRequest(content []byte, documentType string, c
 // Returns:
 //   - response body.
 //   - error.
-func httpRequest(method string, url string, headers map[string]string, body []byte) ([]byte, error) {
+func httpRequest(ctx context.Context, method string, url string, headers map[string]string, body []byte) ([]byte, error) {
 	// Create a new request using http.
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
@@ -1987,12 +1909,13 @@ func openAiTokenCount(modelName string, message string) (int, error) {
 // codeGenerationProcessBatchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - documentData: the document data.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func codeGenerationProcessBatchEmbeddings(elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (elementEmbeddings [][]float32, err error) {
+func codeGenerationProcessBatchEmbeddings(ctx context.Context, elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (elementEmbeddings [][]float32, err error) {
 	// Process data in batches
 	for i := 0; i < len(elements); i += maxBatchSize {
 		end := i + maxBatchSize
@@ -2008,7 +1931,7 @@ func codeGenerationProcessBatchEmbeddings(elements []sharedtypes.CodeGenerationE
 		}
 
 		// Perform vector embedding request to LLM handler
-		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, false)
+		batchEmbeddings, _, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2025,12 +1948,15 @@ func codeGenerationProcessBatchEmbeddings(elements []sharedtypes.CodeGenerationE
 // codeGenerationProcessHybridSearchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - elements: the elements.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+func codeGenerationProcessHybridSearchEmbeddings(ctx context.Context, elements []sharedtypes.CodeGenerationElement, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("embeddings", len(elements))
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -2048,7 +1974,7 @@ func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGene
 		}
 
 		// Send http request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2058,6 +1984,7 @@ func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGene
 		lexicalWeights = append(lexicalWeights, batchLexicalWeights...)
 
 		processedEmbeddings += len(batchData)
+		progress.Add(len(batchData))
 		logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
 	}
 
@@ -2067,12 +1994,15 @@ func codeGenerationProcessHybridSearchEmbeddings(elements []sharedtypes.CodeGene
 // codeGenerationProcessHybridSearchEmbeddings processes the data extraction batch embeddings.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - elements: the elements.
 //   - maxBatchSize: the max batch size.
 //
 // Returns:
 //   - error: an error if any
-func codeGenerationProcessHybridSearchEmbeddingsForExamples(elements []codegeneration.VectorDatabaseExample, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+func codeGenerationProcessHybridSearchEmbeddingsForExamples(ctx context.Context, elements []codegeneration.VectorDatabaseExample, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("embeddings", len(elements))
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -2090,7 +2020,7 @@ func codeGenerationProcessHybridSearchEmbeddingsForExamples(elements []codegener
 		}
 
 		// Send http request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2100,13 +2030,16 @@ func codeGenerationProcessHybridSearchEmbeddingsForExamples(elements []codegener
 		lexicalWeights = append(lexicalWeights, batchLexicalWeights...)
 
 		processedEmbeddings += len(batchData)
+		progress.Add(len(batchData))
 		logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
 	}
 
 	return denseEmbeddings, lexicalWeights, nil
 }
 
-func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(sections []codegeneration.VectorDatabaseUserGuideSection, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(ctx context.Context, sections []codegeneration.VectorDatabaseUserGuideSection, maxBatchSize int) (denseEmbeddings [][]float32, lexicalWeights []map[uint]float32, err error) {
+	progress := ProgressReporterFromContext(ctx)
+	progress.StartPhase("embeddings", len(sections))
 	processedEmbeddings := 0
 
 	// Process data in batches
@@ -2124,7 +2057,7 @@ func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(sections []
 		}
 
 		// Send embedding request
-		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(batchTextToEmbed, true)
+		batchDenseEmbeddings, batchLexicalWeights, err := llmHandlerPerformVectorEmbeddingRequest(ctx, batchTextToEmbed, true)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to perform vector embedding request: %w", err)
 		}
@@ -2134,6 +2067,7 @@ func codeGenerationProcessHybridSearchEmbeddingsForUserGuideSections(sections []
 		lexicalWeights = append(lexicalWeights, batchLexicalWeights...)
 
 		processedEmbeddings += len(batchData)
+		progress.Add(len(batchData))
 		logging.Log.Debugf(&logging.ContextMap{}, "Processed %d embeddings", processedEmbeddings)
 	}
 
@@ -2154,7 +2088,22 @@ type pythonEmbeddingResponse struct {
 	DenseVecs      [][]float32        `json:"dense_vecs"`
 }
 
-func CreateEmbeddings(dense bool, sparse bool, colbert bool, isDocument bool, passages []string) (dense_vector [][]float32, lexical_weights []map[uint]float32, colbert_vecs [][][]float32, func_error error) {
+// CreateEmbeddings creates the embeddings of passages with the local python helper server
+//
+// Parameters:
+//   - ctx: the request context
+//   - dense: the flag to indicate whether to return the dense vectors
+//   - sparse: the flag to indicate whether to return the lexical weights
+//   - colbert: the flag to indicate whether to return the colbert vectors
+//   - isDocument: the flag to indicate whether the passages are documents rather than queries
+//   - passages: the passages to embed
+//
+// Returns:
+//   - dense_vector: the dense vectors
+//   - lexical_weights: the lexical weights
+//   - colbert_vecs: the colbert vectors
+//   - func_error: an error if the request fails
+func CreateEmbeddings(ctx context.Context, dense bool, sparse bool, colbert bool, isDocument bool, passages []string) (dense_vector [][]float32, lexical_weights []map[uint]float32, colbert_vecs [][][]float32, func_error error) {
 	defer func() {
 		r := recover()
 		if r != nil {
@@ -2180,7 +2129,15 @@ func CreateEmbeddings(dense bool, sparse bool, colbert bool, isDocument bool, pa
 		return nil, nil, nil, err
 	}
 
-	resp, err := http.Post(url, "application/json", bytes.NewReader(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(jsonData))
+	if err != nil {
+		logging.Log.Errorf(&logging.ContextMap{}, "Error creating request: %v", err)
+		return nil, nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error sending request to python helper server extract-text: %v", err)
 		return nil, nil, nil, err
@@ -2205,7 +2162,7 @@ func CreateEmbeddings(dense bool, sparse bool, colbert bool, isDocument bool, pa
 
 }
 
-func dataExtractionTextSplitter(input string, chunkSize int, chunkOverlap int) (chunks []string, err error) {
+func dataExtractionTextSplitter(ctx context.Context, input string, chunkSize int, chunkOverlap int) (chunks []string, err error) {
 	var splittedChunks []schema.Document
 
 	// Creating a reader from the content of the file.
@@ -2218,7 +2175,7 @@ func dataExtractionTextSplitter(input string, chunkSize int, chunkOverlap int) (
 	splitter := textsplitter.NewTokenSplitter(splitterOptions...)
 
 	txtLoader := documentloaders.NewText(reader)
-	splittedChunks, err = txtLoader.LoadAndSplit(context.Background(), splitter)
+	splittedChunks, err = txtLoader.LoadAndSplit(ctx, splitter)
 	if err != nil {
 		errMessage := fmt.Sprintf("Error getting file content from github: %v", err)
 		logging.Log.Error(&logging.ContextMap{}, errMessage)
@@ -2326,6 +2283,7 @@ func getIndexNameFromToolName(toolName string) (indexName string, err error) {
 // downloadGithubFileContent downloads file content from github and returns checksum and content.
 //
 // Parameters:
+//   - ctx: the request context.
 //   - githubRepoName: name of the github repository.
 //   - githubRepoOwner: owner of the github repository.
 //   - githubRepoBranch: branch of the github repository.
@@ -2335,11 +2293,11 @@ func getIndexNameFromToolName(toolName string) (indexName string, err error) {
 // Returns:
 //   - checksum: checksum of file.
 //   - content: content of file.
-func downloadGithubFileContent(githubRepoName string, githubRepoOwner string,
+func downloadGithubFileContent(ctx context.Context, githubRepoName string, githubRepoOwner string,
 	githubRepoBranch string, gihubFilePath string, githubAccessToken string) (checksum string, content []byte, err error) {
 
-	// Create a new GitHub client and context.
-	client, ctx := dataExtractNewGithubClient(githubAccessToken)
+	// Create a new GitHub client.
+	client := dataExtractNewGithubClient(ctx, githubAccessToken)
 
 	// Retrieve the file content from the GitHub repository.
 	fileContent, _, _, err := client.Repositories.GetContents(ctx, githubRepoOwner, githubRepoName, gihubFilePath, &github.RepositoryContentGetOptions{Ref: githubRepoBranch})
@@ -2368,49 +2326,140 @@ func downloadGithubFileContent(githubRepoName string, githubRepoOwner string,
 	return checksum, content, nil
 }
 
-// mongoDbInitializeClient initializes the mongodb client
-// This function should be called at the beginning of the agent
-// to initialize the mongodb client
+// checkGraphDbContext panics with the error of the request context if it is already done
+// The graph db clients do not take a context, so the calls are at least skipped once the request is done.
+//
+// Parameters:
+//   - ctx: the request context.
+//   - operation: the graph db operation, used in the error message.
+func checkGraphDbContext(ctx context.Context, operation string) {
+	if ctx.Err() != nil {
+		logPanicError(nil, errorFromContext(UpstreamGraphDB, ctx.Err(), "request aborted before %s: %v", operation, ctx.Err()))
+	}
+}
+
+// startGraphDbSpan starts the client span of a call to aali-graphdb
+// The graph db client does not take a context, so the span is started and ended around the call.
+//
+// Parameters:
+//   - ctx: the request context, carrying the parent span.
+//   - operation: the graph db operation.
+//   - dbname: the name of the graph database.
+//   - query: the Cypher query, empty if the operation builds its own queries.
+//
+// Returns:
+//   - span: the span, to end with telemetry.EndSpan.
+func startGraphDbSpan(ctx context.Context, operation string, dbname string, query string) (span trace.Span) {
+	attributes := []attribute.KeyValue{
+		attribute.String("db.system", "aali-graphdb"),
+		attribute.String("db.namespace", dbname),
+		attribute.String("db.operation.name", operation),
+	}
+	if query != "" {
+		attributes = append(attributes, attribute.String("db.query.text", query))
+	}
+	_, span = telemetry.StartSpan(ctx, "aali-graphdb "+operation, attributes...)
+	return span
+}
+
+var (
+	mongoDbClientsMutex sync.Mutex
+	mongoDbClients      = map[string]*mongo.Client{}
+	mongoDbIsShutdown   bool
+)
+
+// mongoDbClient returns the MongoDB client of an endpoint
+// The client is connected on first use and shared by all functions, it must not be disconnected; see CloseMongoDbClients.
 //
 // Parameters:
+//   - ctx: The request context.
 //   - mongoDbEndpoint: The MongoDB endpoint.
-//   - databaseName: The name of the database.
 //
 // Returns:
-//   - mongoDbClient: The MongoDB client.
+//   - client: The MongoDB client.
 //   - err: An error if any.
-func mongoDbInitializeClient(mongoDbEndpoint string, databaseName string, collectionName string) (mongoDbContext *MongoDbContext, err error) {
+func mongoDbClient(ctx context.Context, mongoDbEndpoint string) (client *mongo.Client, err error) {
+	mongoDbClientsMutex.Lock()
+	defer mongoDbClientsMutex.Unlock()
+
+	if mongoDbIsShutdown {
+		return nil, fmt.Errorf("MongoDB clients are shut down")
+	}
+	client, exists := mongoDbClients[mongoDbEndpoint]
+	if exists {
+		return client, nil
+	}
+
 	// Set the server API options
 	serverAPI := options.ServerAPI(options.ServerAPIVersion1)
 	opts := options.Client().ApplyURI(mongoDbEndpoint).SetServerAPIOptions(serverAPI)
 
-	// create context
-	mongoDbCtx := context.Background()
-
 	// Create a new client and connect to the server
-	client, err := mongo.Connect(mongoDbCtx, opts)
+	client, err = mongo.Connect(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error in mongo.Connect: %v", err)
 	}
 
-	// Ping to verify connection
-	err = client.Ping(mongoDbCtx, readpref.Primary())
+	// Ping to verify connection, the client is dropped even if the request is already done
+	err = client.Ping(ctx, readpref.Primary())
 	if err != nil {
+		_ = client.Disconnect(context.WithoutCancel(ctx))
 		return nil, fmt.Errorf("failed to ping MongoDB: %v", err)
 	}
 
+	mongoDbClients[mongoDbEndpoint] = client
+	return client, nil
+}
+
+// CloseMongoDbClients disconnects the shared MongoDB clients, further MongoDB functions fail
+//
+// Parameters:
+//   - ctx: The context bounding the disconnection.
+func CloseMongoDbClients(ctx context.Context) {
+	mongoDbClientsMutex.Lock()
+	defer mongoDbClientsMutex.Unlock()
+
+	mongoDbIsShutdown = true
+	for endpoint, client := range mongoDbClients {
+		err := client.Disconnect(ctx)
+		if err != nil {
+			logging.Log.Warnf(&logging.ContextMap{}, "Error disconnecting MongoDB client: %v", err)
+		}
+		delete(mongoDbClients, endpoint)
+	}
+	logging.Log.Debugf(&logging.ContextMap{}, "Closed all MongoDB clients.")
+}
+
+// mongoDbInitializeClient initializes the mongodb context of a collection
+// The MongoDB client is shared by all calls, see mongoDbClient.
+//
+// Parameters:
+//   - ctx: The request context.
+//   - mongoDbEndpoint: The MongoDB endpoint.
+//   - databaseName: The name of the database.
+//
+// Returns:
+//   - mongoDbClient: The MongoDB client.
+//   - err: An error if any.
+func mongoDbInitializeClient(ctx context.Context, mongoDbEndpoint string, databaseName string, collectionName string) (mongoDbContext *MongoDbContext, err error) {
+	// get the shared client of the endpoint
+	client, err := mongoDbClient(ctx, mongoDbEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
 	// create database
 	database := client.Database(databaseName)
 
 	// check if collection exists
-	exists, err := mongoDbCollectionExists(database, collectionName)
+	exists, err := mongoDbCollectionExists(ctx, database, collectionName)
 	if err != nil {
 		logging.Log.Errorf(&logging.ContextMap{}, "Error checking if collection exists: %v", err)
-		panic(err)
+		panic(NewUpstreamError(UpstreamMongoDB, err, "error checking if collection exists: %v", err))
 	}
 	if !exists {
 		logging.Log.Errorf(&logging.ContextMap{}, "Collection %s does not exist", collectionName)
-		panic("Collection " + collectionName + " does not exist")
+		panic(NewNotFoundError(UpstreamMongoDB, "Collection %s does not exist", collectionName))
 	}
 
 	// create collection
@@ -2429,15 +2478,16 @@ func mongoDbInitializeClient(mongoDbEndpoint string, databaseName string, collec
 // mongoDbCollectionExists checks if a collection exists in the database
 //
 // Parameters:
+//   - ctx: The request context.
 //   - database: The MongoDB database.
 //   - collectionName: The name of the collection.
 //
 // Returns:
 //   - exists: True if the collection exists, false otherwise.
 //   - err: An error if any.
-func mongoDbCollectionExists(database *mongo.Database, collectionName string) (exists bool, err error) {
+func mongoDbCollectionExists(ctx context.Context, database *mongo.Database, collectionName string) (exists bool, err error) {
 	// Get the list of collections in the database
-	collections, err := database.ListCollectionNames(context.Background(), map[string]interface{}{})
+	collections, err := database.ListCollectionNames(ctx, map[string]interface{}{})
 	if err != nil {
 		return false, err
 	}
@@ -2456,6 +2506,7 @@ func mongoDbCollectionExists(database *mongo.Database, collectionName string) (e
 // the customer object from the database using the API key
 //
 // Parameters:
+//   - ctx: The request context.
 //   - mongoDbContext: The MongoDB context.
 //   - apiKey: The API key.
 //
@@ -2463,12 +2514,12 @@ func mongoDbCollectionExists(database *mongo.Database, collectionName string) (e
 //   - exists: True if the customer exists, false otherwise.
 //   - customer: The customer object.
 //   - err: An error if any.
-func mongoDbGetCustomerByApiKey(mongoDbContext *MongoDbContext, apiKey string) (exists bool, customer *MongoDbCustomerObject, err error) {
+func mongoDbGetCustomerByApiKey(ctx context.Context, mongoDbContext *MongoDbContext, apiKey string) (exists bool, customer *MongoDbCustomerObject, err error) {
 	// Create filter for API key
 	filter := bson.M{"api_key": apiKey}
 
 	// Find one document
-	err = mongoDbContext.Collection.FindOne(context.Background(), filter).Decode(&customer)
+	err = mongoDbContext.Collection.FindOne(ctx, filter).Decode(&customer)
 	if err != nil {
 		// No matching document found
 		if err == mongo.ErrNoDocuments {
@@ -2484,19 +2535,20 @@ func mongoDbGetCustomerByApiKey(mongoDbContext *MongoDbContext, apiKey string) (
 // mongoDbGetCreateCustomerByUserId retrieves or creates a customer object by user ID.
 //
 // Parameters:
+//   - ctx: The request context.
 //   - mongoDbContext: The MongoDB context.
 //   - userId: The user ID.
 //   - tokenLimitForNewUsers: The token limit for new users.
 //
 // Returns:
 //   - err: An error if any.
-func mongoDbGetCreateCustomerByUserId(mongoDbContext *MongoDbContext, userId string, temporaryTokenLimit int, hoursUntilTokenLimitReset int, modelId []string) (existingUser bool, customer *MongoDbCustomerObjectDisco, err error) {
+func mongoDbGetCreateCustomerByUserId(ctx context.Context, mongoDbContext *MongoDbContext, userId string, temporaryTokenLimit int, hoursUntilTokenLimitReset int, modelId []string) (existingUser bool, customer *MongoDbCustomerObjectDisco, err error) {
 	// Create filter for API key
 	filter := bson.M{"user_id": userId}
 
 	// Find one document
 	existingUser = true
-	err = mongoDbContext.Collection.FindOne(context.Background(), filter).Decode(&customer)
+	err = mongoDbContext.Collection.FindOne(ctx, filter).Decode(&customer)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			// No matching document found
@@ -2539,7 +2591,7 @@ func mongoDbGetCreateCustomerByUserId(mongoDbContext *MongoDbContext, userId str
 		}
 
 		// Insert the new customer document
-		_, err = mongoDbContext.Collection.InsertOne(context.Background(), customer)
+		_, err = mongoDbContext.Collection.InsertOne(ctx, customer)
 		if err != nil {
 			return false, customer, fmt.Errorf("failed to insert new customer: %v", err)
 		}
@@ -2581,7 +2633,7 @@ func mongoDbGetCreateCustomerByUserId(mongoDbContext *MongoDbContext, userId str
 			}
 
 			// Update the document
-			result, err := mongoDbContext.Collection.UpdateOne(context.Background(), filter, update)
+			result, err := mongoDbContext.Collection.UpdateOne(ctx, filter, update)
 			if err != nil {
 				return false, customer, fmt.Errorf("failed to update token usage: %v", err)
 			}
@@ -2599,13 +2651,14 @@ func mongoDbGetCreateCustomerByUserId(mongoDbContext *MongoDbContext, userId str
 // mongoDbAddToTotalTokenCount increments the total token count for a customer
 //
 // Parameters:
+//   - ctx: The request context.
 //   - mongoDbContext: The MongoDB context.
 //   - apiKey: The API key.
 //   - additionalTokenCount: The number of tokens to add.
 //
 // Returns:
 //   - err: An error if any.
-func mongoDbAddToTotalTokenCount(mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string, additionalTokenCount int) (err error) {
+func mongoDbAddToTotalTokenCount(ctx context.Context, mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string, additionalTokenCount int) (err error) {
 	// Create filter for API key & update for total token count
 	filter := bson.M{indetificationKey: indetificationValue}
 	update := bson.M{
@@ -2615,7 +2668,7 @@ func mongoDbAddToTotalTokenCount(mongoDbContext *MongoDbContext, indetificationK
 	}
 
 	// Update the document
-	result, err := mongoDbContext.Collection.UpdateOne(context.Background(), filter, update)
+	result, err := mongoDbContext.Collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("failed to update token usage: %v", err)
 	}
@@ -2631,6 +2684,7 @@ func mongoDbAddToTotalTokenCount(mongoDbContext *MongoDbContext, indetificationK
 // mongoDbAddToInputOutputTokenCount increments the input and output token count for a customer
 //
 // Parameters:
+//   - ctx: The request context.
 //   - mongoDbContext: The MongoDB context.
 //   - apiKey: The API key.
 //   - additionalInputTokenCount: The number of input tokens to add.
@@ -2638,13 +2692,13 @@ func mongoDbAddToTotalTokenCount(mongoDbContext *MongoDbContext, indetificationK
 //
 // Returns:
 //   - err: An error if any.
-func mongoDbAddToInputOutputTokenCountAndCheckLimit(mongoDbContext *MongoDbContext, userId string, additionalInputTokenCount int, additionalOutputTokenCount int, hoursUntilTokenLimitReset int, modelId []string) (tokenLimitReached bool, err error) {
+func mongoDbAddToInputOutputTokenCountAndCheckLimit(ctx context.Context, mongoDbContext *MongoDbContext, userId string, additionalInputTokenCount int, additionalOutputTokenCount int, hoursUntilTokenLimitReset int, modelId []string) (tokenLimitReached bool, err error) {
 	// Create filter for API key
 	filter := bson.M{"user_id": userId}
 
 	// Find one document
 	customer := &MongoDbCustomerObjectDisco{}
-	err = mongoDbContext.Collection.FindOne(context.Background(), filter).Decode(&customer)
+	err = mongoDbContext.Collection.FindOne(ctx, filter).Decode(&customer)
 	if err != nil {
 		return false, fmt.Errorf("error in finding mongoDb document: %v", err)
 	}
@@ -2683,7 +2737,7 @@ func mongoDbAddToInputOutputTokenCountAndCheckLimit(mongoDbContext *MongoDbConte
 	}
 
 	// Update the document
-	result, err := mongoDbContext.Collection.UpdateOne(context.Background(), filter, update)
+	result, err := mongoDbContext.Collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return false, fmt.Errorf("failed to update token usage: %v", err)
 	}
@@ -2704,12 +2758,13 @@ func mongoDbAddToInputOutputTokenCountAndCheckLimit(mongoDbContext *MongoDbConte
 // mongoDbUpdateAccessAndWarning updates the access_denied and warning_sent fields
 //
 // Parameters:
+//   - ctx: The request context.
 //   - mongoDbContext: The MongoDB context.
 //   - apiKey: The API key.
 //
 // Returns:
 //   - err: An error if any.
-func mongoDbUpdateAccessAndWarning(mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string) (err error) {
+func mongoDbUpdateAccessAndWarning(ctx context.Context, mongoDbContext *MongoDbContext, indetificationKey string, indetificationValue string) (err error) {
 	// Create filter for API key & update access_denied and warning_sent
 	filter := bson.M{indetificationKey: indetificationValue}
 	update := bson.M{
@@ -2720,7 +2775,7 @@ func mongoDbUpdateAccessAndWarning(mongoDbContext *MongoDbContext, indetificatio
 	}
 
 	// Update the document
-	result, err := mongoDbContext.Collection.UpdateOne(context.Background(), filter, update)
+	result, err := mongoDbContext.Collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return fmt.Errorf("failed to update token usage: %v", err)
 	}
@@ -2733,16 +2788,21 @@ func mongoDbUpdateAccessAndWarning(mongoDbContext *MongoDbContext, indetificatio
 	return nil
 }
 
+// logPanic logs an internal error and panics with it
+// The last error in the arguments is kept as the underlying error.
+//
+// Parameters:
+//   - ctx: the logging context, nil for an empty one
+//   - msg: the format of the error message
+//   - args: the arguments of the error message
 func logPanic(ctx *logging.ContextMap, msg string, args ...any) {
-	errMsg := fmt.Sprintf(msg, args...)
-	var logCtx *logging.ContextMap
-	if ctx == nil {
-		logCtx = &logging.ContextMap{}
-	} else {
-		logCtx = ctx
+	var err error
+	for _, arg := range args {
+		if argErr, ok := arg.(error); ok {
+			err = argErr
+		}
 	}
-	logging.Log.Error(logCtx, errMsg)
-	panic(errMsg)
+	logPanicError(ctx, NewInternalError(err, msg, args...))
 }
 
 // connectToMCP establishes a WebSocket connection to the MCP server.
@@ -2799,18 +2859,19 @@ func sendMCPRequest(ctx context.Context, conn *websocket.Conn, request interface
 // kvdbGetEntry retrieves the value from a specific key from the KVDB
 //
 // Parameters:
+//   - ctx: the request context.
 //   - key: The key to retrive the value for.
 //
 // Returns:
 //   - value: The value for the given key.
 //   - exists: A boolean indicating if the given key exists in the KVDB.
 //   - err: An error if marshaling, sending, or receiving fails.
-func kvdbGetEntry(kvdbEndpoint string, key string) (value string, exists bool, err error) {
+func kvdbGetEntry(ctx context.Context, kvdbEndpoint string, key string) (value string, exists bool, err error) {
 	// make GET request to the kvdb
 	url := kvdbEndpoint + "/entries/" + key
 
 	// Create the HTTP request
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return "", false, fmt.Errorf("error creating request: %v", err)
 	}
@@ -2856,12 +2917,13 @@ func kvdbGetEntry(kvdbEndpoint string, key string) (value string, exists bool, e
 // kvdbSetEntry sets the value for a given key in the KVDB
 //
 // Parameters:
+//   - ctx: the request context.
 //   - key: The key to retrive the value for.
 //   - value: The value for the given key.
 //
 // Returns:
 //   - err: An error if marshaling, sending, or receiving fails.
-func kvdbSetEntry(kvdbEndpoint string, key string, value string) (err error) {
+func kvdbSetEntry(ctx context.Context, kvdbEndpoint string, key string, value string) (err error) {
 
 	// make PUT request to the kvdb
 	url := kvdbEndpoint + "/entries/" + key
@@ -2878,7 +2940,7 @@ func kvdbSetEntry(kvdbEndpoint string, key string, value string) (err error) {
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		return fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -0,0 +1,170 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// progressNotifyInterval is the minimum interval between two notifications of the progress of a phase
+const progressNotifyInterval = 500 * time.Millisecond
+
+// Progress is the progress of a long-running function, such as an ingestion
+type Progress struct {
+	// Phase is the current step of the function, for example "embeddings"
+	Phase string `json:"phase,omitempty"`
+	// Done is the number of items of the phase already processed
+	Done int `json:"done"`
+	// Total is the number of items of the phase, 0 if unknown
+	Total int `json:"total,omitempty"`
+	// Rate is the number of items processed per second since the start of the phase
+	Rate    float64   `json:"rate"`
+	Updated time.Time `json:"updated"`
+}
+
+// ProgressReporter collects the progress reported by a function and forwards it to the caller
+// It is set by the gRPC server for streams and jobs that follow the progress, see ProgressReporterFromContext.
+// A nil reporter ignores the reports, so functions can report their progress unconditionally.
+type ProgressReporter struct {
+	mutex      sync.Mutex
+	progress   Progress
+	phaseStart time.Time
+	notified   time.Time
+	notify     func(Progress)
+}
+
+// NewProgressReporter creates a progress reporter
+//
+// Parameters:
+//   - notify: called with the progress when a phase starts, when it is done, and at most twice per second in between
+//
+// Returns:
+//   - *ProgressReporter: the reporter
+func NewProgressReporter(notify func(Progress)) *ProgressReporter {
+	return &ProgressReporter{notify: notify}
+}
+
+// progressReporterContextKey is the context key of the progress reporter
+type progressReporterContextKey struct{}
+
+// ContextWithProgressReporter returns a copy of the context carrying a progress reporter
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - reporter: the reporter of the function progress
+//
+// Returns:
+//   - context.Context: the context carrying the reporter
+func ContextWithProgressReporter(ctx context.Context, reporter *ProgressReporter) context.Context {
+	return context.WithValue(ctx, progressReporterContextKey{}, reporter)
+}
+
+// ProgressReporterFromContext returns the progress reporter of a function call
+// Functions that accept a context use it to report how far they have got.
+//
+// Parameters:
+//   - ctx: the context passed to the function
+//
+// Returns:
+//   - *ProgressReporter: the reporter, nil if nobody follows the progress of the call
+func ProgressReporterFromContext(ctx context.Context) *ProgressReporter {
+	reporter, _ := ctx.Value(progressReporterContextKey{}).(*ProgressReporter)
+	return reporter
+}
+
+// StartPhase starts a new phase of the function
+//
+// Parameters:
+//   - phase: the name of the phase
+//   - total: the number of items of the phase, 0 if unknown
+func (reporter *ProgressReporter) StartPhase(phase string, total int) {
+	if reporter == nil {
+		return
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	reporter.phaseStart = time.Now()
+	reporter.progress = Progress{Phase: phase, Total: total}
+	reporter.update(true)
+}
+
+// AddTotal adds items to the current phase, for phases whose items are discovered while it runs
+//
+// Parameters:
+//   - items: the number of added items
+func (reporter *ProgressReporter) AddTotal(items int) {
+	if reporter == nil {
+		return
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	reporter.progress.Total += items
+	reporter.update(false)
+}
+
+// Add reports processed items of the current phase
+//
+// Parameters:
+//   - items: the number of processed items
+func (reporter *ProgressReporter) Add(items int) {
+	if reporter == nil {
+		return
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	reporter.progress.Done += items
+	reporter.update(reporter.progress.Done == reporter.progress.Total)
+}
+
+// Progress returns the current progress
+//
+// Returns:
+//   - Progress: the progress, the zero value for a nil reporter
+func (reporter *ProgressReporter) Progress() Progress {
+	if reporter == nil {
+		return Progress{}
+	}
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	return reporter.progress
+}
+
+// update refreshes the rate of the progress and notifies it if needed
+// The mutex of the reporter must be held, so that the notifications are ordered.
+//
+// Parameters:
+//   - force: notify regardless of the interval since the last notification
+func (reporter *ProgressReporter) update(force bool) {
+	now := time.Now()
+	reporter.progress.Updated = now
+	if elapsed := now.Sub(reporter.phaseStart).Seconds(); elapsed > 0 {
+		reporter.progress.Rate = float64(reporter.progress.Done) / elapsed
+	}
+	if reporter.notify == nil || (!force && now.Sub(reporter.notified) < progressNotifyInterval) {
+		return
+	}
+	reporter.notified = now
+	reporter.notify(reporter.progress)
+}
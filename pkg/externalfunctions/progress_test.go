@@ -0,0 +1,69 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProgressReporter(t *testing.T) {
+	// functions report their progress unconditionally
+	reporter := ProgressReporterFromContext(context.Background())
+	if reporter != nil {
+		t.Fatalf("ProgressReporterFromContext() = %v, want nil without reporter", reporter)
+	}
+	reporter.StartPhase("embeddings", 10)
+	reporter.Add(1)
+
+	notified := []Progress{}
+	reporter = NewProgressReporter(func(progress Progress) {
+		notified = append(notified, progress)
+	})
+	ctx := ContextWithProgressReporter(context.Background(), reporter)
+	reporter = ProgressReporterFromContext(ctx)
+
+	reporter.StartPhase("embeddings", 3)
+	reporter.Add(1)
+	reporter.Add(1)
+	reporter.Add(1)
+	reporter.StartPhase("store", 0)
+	reporter.AddTotal(2)
+
+	// the updates in between are throttled, the start and the end of a phase are always notified
+	if len(notified) != 3 {
+		t.Fatalf("got %d notifications, want 3: %+v", len(notified), notified)
+	}
+	if notified[0].Phase != "embeddings" || notified[0].Done != 0 || notified[0].Total != 3 {
+		t.Errorf("phase start notification = %+v", notified[0])
+	}
+	if notified[1].Done != 3 || notified[1].Rate <= 0 {
+		t.Errorf("phase end notification = %+v", notified[1])
+	}
+	if notified[2].Phase != "store" {
+		t.Errorf("second phase notification = %+v", notified[2])
+	}
+	if progress := reporter.Progress(); progress.Phase != "store" || progress.Done != 0 || progress.Total != 2 {
+		t.Errorf("Progress() = %+v", progress)
+	}
+}
@@ -37,18 +37,17 @@ import (
 // Tags:
 //   - @displayName: Create Qdrant Collection
 //
-// Params:
-//   - collectionName (string): The name of the collection
-//   - vectorSize (uint64): The size of the vectors stored in this collection
-//   - vectorDistance (string): The distance metric to use of vector similarity search (cosine, dot, euclid, manhattan)
-func QdrantCreateCollection(collectionName string, vectorSize uint64, vectorDistance string) {
+// Parameters:
+//   - ctx: The request context
+//   - collectionName: The name of the collection
+//   - vectorSize: The size of the vectors stored in this collection
+//   - vectorDistance: The distance metric to use of vector similarity search (cosine, dot, euclid, manhattan)
+func QdrantCreateCollection(ctx context.Context, collectionName string, vectorSize uint64, vectorDistance string) {
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "unable to create qdrant client: %q", err)
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
-	ctx := context.TODO()
-
 	err = client.CreateCollection(ctx, &qdrant.CreateCollection{
 		CollectionName: collectionName,
 		VectorsConfig: qdrant.NewVectorsConfig(&qdrant.VectorParams{
@@ -57,7 +56,7 @@ func QdrantCreateCollection(collectionName string, vectorSize uint64, vectorDist
 		}),
 	})
 	if err != nil {
-		logPanic(nil, "failed to create collection: %q", err)
+		logPanicError(nil, NewUpstreamError(UpstreamQdrant, err, "failed to create collection: %q", err))
 	}
 }
 
@@ -66,12 +65,13 @@ func QdrantCreateCollection(collectionName string, vectorSize uint64, vectorDist
 // Tags:
 //   - @displayName: Insert Data into Qdrant
 //
-// Params:
-//   - collectionName (string): The name of the collection
-//   - data ([]interface{}): The data points to insert (func will fail if elements are not `map[string]any`)
-//   - idFieldName (string): The name of the field to use as the ID
-//   - vectorFieldName (string): The name of the field to use as the vector
-func QdrantInsertData(collectionName string, data []interface{}, idFieldName string, vectorFieldName string) {
+// Parameters:
+//   - ctx: The request context
+//   - collectionName: The name of the collection
+//   - data: The data points to insert (func will fail if elements are not `map[string]any`)
+//   - idFieldName: The name of the field to use as the ID
+//   - vectorFieldName: The name of the field to use as the vector
+func QdrantInsertData(ctx context.Context, collectionName string, data []interface{}, idFieldName string, vectorFieldName string) {
 	points := make([]*qdrant.PointStruct, len(data))
 	for i, d := range data {
 		dataMap := d.(map[string]any)
@@ -88,18 +88,16 @@ func QdrantInsertData(collectionName string, data []interface{}, idFieldName str
 
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "unable to create qdrant client: %q", err)
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
-	ctx := context.TODO()
-
 	resp, err := client.Upsert(ctx, &qdrant.UpsertPoints{
 		CollectionName: collectionName,
 		Points:         points,
 	})
 
 	if err != nil {
-		logPanic(nil, "failed to insert data: %q", err)
+		logPanicError(nil, NewUpstreamError(UpstreamQdrant, err, "failed to insert data: %q", err))
 	}
 	logging.Log.Debugf(&logging.ContextMap{}, "successfully upserted %d points into qdrant collection %q: %q", len(points), collectionName, resp.GetStatus())
 }
@@ -109,20 +107,21 @@ func QdrantInsertData(collectionName string, data []interface{}, idFieldName str
 // Tags:
 //   - @displayName: Create Qdrant Index
 //
-// Params:
-//   - collectionName (string): The name of the collection
-//   - fieldName (string): The name of the payload field to create an index on
-//   - fieldType (string): The qdrant type that the payload field is expected to be
-//   - wait (bool): Whether to wait for the index to be created or return immediately & continue indexing in background
-func QdrantCreateIndex(collectionName string, fieldName string, fieldType string, wait bool) {
+// Parameters:
+//   - ctx: The request context
+//   - collectionName: The name of the collection
+//   - fieldName: The name of the payload field to create an index on
+//   - fieldType: The qdrant type that the payload field is expected to be
+//   - wait: Whether to wait for the index to be created or return immediately & continue indexing in background
+func QdrantCreateIndex(ctx context.Context, collectionName string, fieldName string, fieldType string, wait bool) {
 	client, err := qdrant_utils.QdrantClient()
 	if err != nil {
-		logPanic(nil, "unable to create qdrant client: %q", err)
+		logPanicError(nil, NewUpstreamUnavailableError(UpstreamQdrant, err, "unable to create qdrant client: %q", err))
 	}
 
 	qdrantType, err := qdrantFieldType(fieldType)
 	if err != nil {
-		logPanic(nil, "could not create qdrant field type: %q", err)
+		logPanicError(nil, NewInvalidInputError("fieldType", "could not create qdrant field type: %q", err))
 	}
 
 	request := qdrant.CreateFieldIndexCollection{
@@ -132,9 +131,9 @@ func QdrantCreateIndex(collectionName string, fieldName string, fieldType string
 		Wait:           qdrant.PtrOf(wait),
 		// TODO: there is more customization here you can do, but specific to the field type
 	}
-	res, err := client.CreateFieldIndex(context.TODO(), &request)
+	res, err := client.CreateFieldIndex(ctx, &request)
 	if err != nil {
-		logPanic(nil, "failed to create index: %q", err)
+		logPanicError(nil, NewUpstreamError(UpstreamQdrant, err, "failed to create index: %q", err))
 	}
 	logging.Log.Debugf(&logging.ContextMap{}, "successfully created index: %v", res.Status)
 }
@@ -0,0 +1,8180 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by internal/gen/registry/gen.go; DO NOT EDIT.
+
+package externalfunctions
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aali_graphdb"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+)
+
+// FunctionDefinitions holds the definition of every function in ExternalFunctionsMap
+var FunctionDefinitions = map[string]*aaliflowkitgrpc.FunctionDefinition{
+	"AddAvailableAttributesToSystemPrompt": {
+		Name:        "AddAvailableAttributesToSystemPrompt",
+		DisplayName: "Add Available Attributes to System Prompt",
+		Description: "AddAvailableAttributesToSystemPrompt adds available attributes to the system prompt template.\n\nTags:\n  - @displayName: Add Available Attributes to System Prompt\n\nParameters:\n  - userDesignRequirements: design requirements provided by the user\n  - systemPromptTemplate: the prompt template string to modify\n  - allAvailableAttributes: the list of all available attributes\n  - availableSearchCriteria: the list of available search criteria (GUIDs)\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - fullSystemPrompt: the full system prompt to send to the LLM, including available attributes\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userDesignRequirements", Type: "string", GoType: "string"},
+			{Name: "systemPromptTemplate", Type: "string", GoType: "string"},
+			{Name: "allAvailableAttributes", Type: "json", GoType: "[]MaterialAttribute"},
+			{Name: "availableSearchCriteria", Type: "json", GoType: "[]string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "fullSystemPrompt", Type: "string", GoType: "string"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"AddDataRequest": {
+		Name:        "AddDataRequest",
+		DisplayName: "Add Data",
+		Description: "AddDataRequest sends a request to the add_data endpoint.\n\nTags:\n  - @displayName: Add Data\n\nParameters:\n  - ctx: the request context.\n  - collectionName: name of the collection the request is sent to.\n  - documentData: the data to add.\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string"},
+			{Name: "documentData", Type: "json", GoType: "[]DbData"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"AddGraphDbParameter": {
+		Name:        "AddGraphDbParameter",
+		DisplayName: "Add Graph DB Parameter",
+		Description: "AddGraphDbParameter adds a new GraphDbParameter to a map[string]GraphDbParameter\n\nTags:\n  - @displayName: Add Graph DB Parameter\n\nParameters:\n  - parameters: the existing collection of parameters\n  - name: the name of the new parameter\n  - value: the value of the new parameter\n  - paramType: the type of the new parameter\n\nReturns:\n  - The original parameters with the new one added\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "parameters", Type: "json", GoType: "ParameterMap"},
+			{Name: "name", Type: "string", GoType: "string"},
+			{Name: "value", Type: "string", GoType: "string"},
+			{Name: "paramType", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "ParameterMap", Type: "json", GoType: "ParameterMap"},
+		},
+	},
+	"AddGuidsToAttributes": {
+		Name:        "AddGuidsToAttributes",
+		DisplayName: "Add GUIDs to criteria suggestions",
+		Description: "AddGuidsToAttributes adds GUIDs to the attributes in the criteria\n\nTags:\n  - @displayName: Add GUIDs to criteria suggestions\n\nParameters:\n  - criteriaSuggestions: the list of criteria without identities\n  - availableAttributes: the list of available attributes with their identities\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - criteriaWithGuids: the list of criteria with their identities\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialLlmCriterion"},
+			{Name: "availableAttributes", Type: "json", GoType: "[]MaterialAttribute"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "criteriaWithGuids", Type: "json", GoType: "[]MaterialCriterionWithGuid"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"AecGetContextFromRetrieverModule": {
+		Name:        "AecGetContextFromRetrieverModule",
+		DisplayName: "AEC Get Context from Retriever Module",
+		Description: "AecGetContextFromRetrieverModule retrieves context from the Ansys GPT Retriever Module\n\nTags:\n  - @displayName: AEC Get Context from Retriever Module\n\nParameters:\n  - ctx: the request context\n  - retrieverModuleEndpoint: the endpoint of the retriever module\n  - userQuery: the user query\n  - dataSources: the data sources\n  - physics: the physics\n  - version: the versions to filter the results by\n  - product: the products to filter the results by\n  - topK: the number of results to be returned\n  - plattform: the platform\n  - retrieverModuleKey: the key for the retriever module\n\nReturns:\n  - context: the context retrieved from the retriever module\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "retrieverModuleEndpoint", Type: "string", GoType: "string"},
+			{Name: "userQuery", Type: "string", GoType: "string"},
+			{Name: "dataSources", Type: "json", GoType: "[]string"},
+			{Name: "physics", Type: "json", GoType: "[]string"},
+			{Name: "version", Type: "json", GoType: "[]string"},
+			{Name: "product", Type: "json", GoType: "[]string"},
+			{Name: "topK", Type: "number", GoType: "int"},
+			{Name: "plattform", Type: "string", GoType: "string"},
+			{Name: "retrieverModuleKey", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "context", Type: "json", GoType: "[]AnsysGPTRetrieverModuleChunk"},
+		},
+	},
+	"AecPerformLLMFinalRequest": {
+		Name:        "AecPerformLLMFinalRequest",
+		DisplayName: "AEC Final Request",
+		Description: "AecPerformLLMFinalRequest performs a final request to LLM\n\nTags:\n  - @displayName: AEC Final Request\n\nParameters:\n  - ctx: the request context\n  - systemTemplate: the system template for the final request\n  - userTemplate: the user template for the final request\n  - query: the user query\n  - history: the conversation history\n  - context: the context retrieved from the retriever module\n  - prohibitedWords: the list of prohibited words\n  - errorList1: the list of error words\n  - errorList2: the list of error words\n  - tokenCountEndpoint: the endpoint to send the token count to\n  - previousInputTokenCount: the input token count of the previous requests\n  - previousOutputTokenCount: the output token count of the previous requests\n  - tokenCountModelName: the model name to use for token count\n  - isStream: the stream flag\n  - userEmail: the email of the user\n  - jwtToken: the JWT token used to authenticate with the token count endpoint\n  - dontSendTokenCount: the flag to indicate whether sending the token count should be skipped\n\nReturns:\n  - message: the message from the llm, empty as the response is streamed\n  - stream: the stream channel\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "systemTemplate", Type: "string", GoType: "string"},
+			{Name: "userTemplate", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "context", Type: "json", GoType: "[]AnsysGPTRetrieverModuleChunk"},
+			{Name: "prohibitedWords", Type: "json", GoType: "[]string"},
+			{Name: "errorList1", Type: "json", GoType: "[]string"},
+			{Name: "errorList2", Type: "json", GoType: "[]string"},
+			{Name: "tokenCountEndpoint", Type: "string", GoType: "string"},
+			{Name: "previousInputTokenCount", Type: "number", GoType: "int"},
+			{Name: "previousOutputTokenCount", Type: "number", GoType: "int"},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "userEmail", Type: "string", GoType: "string"},
+			{Name: "jwtToken", Type: "string", GoType: "string"},
+			{Name: "dontSendTokenCount", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"AisAcsSemanticHybridSearchs": {
+		Name:        "AisAcsSemanticHybridSearchs",
+		DisplayName: "AIS ACS Semantic Hybrid Search",
+		Description: "AisAcsSemanticHybridSearchs performs a semantic hybrid search in ACS\n\nTags:\n  - @displayName: AIS ACS Semantic Hybrid Search\n\nParameters:\n  - ctx: the request context\n  - acsEndpoint: the ACS endpoint\n  - acsApiKey: the ACS API key\n  - acsApiVersion: the ACS API version\n  - query: the query string\n  - embeddedQuery: the embedded query\n  - indexList: the index list\n  - physics: the physics\n  - topK: the number of results to be returned\n\nReturns:\n  - output: the search results\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "acsEndpoint", Type: "string", GoType: "string"},
+			{Name: "acsApiKey", Type: "string", GoType: "string"},
+			{Name: "acsApiVersion", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "embeddedQuery", Type: "json", GoType: "[]float32"},
+			{Name: "indexList", Type: "json", GoType: "[]string"},
+			{Name: "physics", Type: "json", GoType: "[]string"},
+			{Name: "topK", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "[]ACSSearchResponse", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	"AisChangeAcsResponsesByFactor": {
+		Name:        "AisChangeAcsResponsesByFactor",
+		DisplayName: "Change ACS Responses By Factor",
+		Description: "AisChangeAcsResponsesByFactor changes the ACS responses by a factor\n\nTags:\n  - @displayName: Change ACS Responses By Factor\n\nParameters:\n  - factors: the factors\n  - semanticSearchOutput: the search response\n\nReturns:\n  - changedSemanticSearchOutput: the changed search response\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "factors", Type: "json", GoType: "map[string]float64"},
+			{Name: "semanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "changedSemanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	"AisPerformLLMRephraseRequest": {
+		Name:        "AisPerformLLMRephraseRequest",
+		DisplayName: "AIS Rephrase Request",
+		Description: "AisPerformLLMRephraseRequest performs a rephrase request to LLM\n\nTags:\n  - @displayName: AIS Rephrase Request\n\nParameters:\n  - ctx: the request context\n  - systemTemplate: the system template for the rephrase request\n  - userTemplate: the user template for the rephrase request\n  - query: the user query\n  - history: the conversation history\n  - tokenCountModelName: the model name to use for token count\n\nReturns:\n  - rephrasedQuery: the rephrased query\n  - inputTokenCount: the input token count\n  - outputTokenCount: the output token count\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "systemTemplate", Type: "string", GoType: "string"},
+			{Name: "userTemplate", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rephrasedQuery", Type: "string", GoType: "string"},
+			{Name: "inputTokenCount", Type: "number", GoType: "int"},
+			{Name: "outputTokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	"AisReturnIndexList": {
+		Name:        "AisReturnIndexList",
+		DisplayName: "Get AIS Index List",
+		Description: "AisReturnIndexList returns the index list for AIS\n\nTags:\n  - @displayName: Get AIS Index List\n\nParameters:\n  - accessPoint: the access point\n  - physics: the physics\n  - version: the versions, used to select the indexes of the 25R1 and 25R2 releases\n\nReturns:\n  - indexList: the index list\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "accessPoint", Type: "string", GoType: "string"},
+			{Name: "physics", Type: "json", GoType: "[]string"},
+			{Name: "version", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "indexList", Type: "json", GoType: "[]string"},
+		},
+	},
+	"AnsysGPTACSSemanticHybridSearchs": {
+		Name:        "AnsysGPTACSSemanticHybridSearchs",
+		DisplayName: "ACS Semantic Hybrid Search",
+		Description: "AnsysGPTACSSemanticHybridSearchs performs a semantic hybrid search in ACS\n\nTags:\n  - @displayName: ACS Semantic Hybrid Search\n\nParameters:\n  - ctx: the request context\n  - acsEndpoint: the ACS endpoint\n  - acsApiKey: the ACS API key\n  - acsApiVersion: the ACS API version\n  - query: the query string\n  - embeddedQuery: the embedded query\n  - indexList: the index list\n  - filter: the filter\n  - topK: the number of results to be returned from vector search\n\nReturns:\n  - output: the search results\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "acsEndpoint", Type: "string", GoType: "string"},
+			{Name: "acsApiKey", Type: "string", GoType: "string"},
+			{Name: "acsApiVersion", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "embeddedQuery", Type: "json", GoType: "[]float32"},
+			{Name: "indexList", Type: "json", GoType: "[]string"},
+			{Name: "filter", Type: "json", GoType: "map[string]string"},
+			{Name: "topK", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "output", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	"AnsysGPTBuildFinalQuery": {
+		Name:        "AnsysGPTBuildFinalQuery",
+		DisplayName: "Build Final Query",
+		Description: "AnsysGPTBuildFinalQuery builds the final query for Ansys GPT\n\nTags:\n  - @displayName: Build Final Query\n\nParameters:\n  - refrasedQuery: the refrased query\n  - context: the context\n\nReturns:\n  - finalQuery: the final query\n  - errorResponse: the error response, set if no context was found\n  - displayFixedMessageToUser: the flag to indicate whether the error response should be displayed to the user\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "refrasedQuery", Type: "string", GoType: "string"},
+			{Name: "context", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string"},
+			{Name: "errorResponse", Type: "string", GoType: "string"},
+			{Name: "displayFixedMessageToUser", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"AnsysGPTCheckProhibitedWords": {
+		Name:        "AnsysGPTCheckProhibitedWords",
+		DisplayName: "Check Prohibited Words",
+		Description: "AnsysGPTCheckProhibitedWords checks the user query for prohibited words\n\nTags:\n  - @displayName: Check Prohibited Words\n\nParameters:\n  - query: the user query\n  - prohibitedWords: the list of prohibited words\n  - errorResponseMessage: the error response message\n\nReturns:\n  - foundProhibited: the flag indicating whether prohibited words were found\n  - responseMessage: the response message\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "prohibitedWords", Type: "json", GoType: "[]string"},
+			{Name: "errorResponseMessage", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "foundProhibited", Type: "boolean", GoType: "bool"},
+			{Name: "responseMessage", Type: "string", GoType: "string"},
+		},
+	},
+	"AnsysGPTExtractFieldsFromQuery": {
+		Name:        "AnsysGPTExtractFieldsFromQuery",
+		DisplayName: "Extract Fields",
+		Description: "AnsysGPTExtractFieldsFromQuery extracts the fields from the user query\n\nTags:\n  - @displayName: Extract Fields\n\nParameters:\n  - query: the user query\n  - fieldValues: the field values that the user query can contain\n  - defaultFields: the default fields that the user query can contain\n\nReturns:\n  - fields: the extracted fields\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "fieldValues", Type: "json", GoType: "map[string][]string"},
+			{Name: "defaultFields", Type: "json", GoType: "[]AnsysGPTDefaultFields"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "fields", Type: "json", GoType: "map[string]string"},
+		},
+	},
+	"AnsysGPTGetSystemPrompt": {
+		Name:        "AnsysGPTGetSystemPrompt",
+		DisplayName: "Get System Prompt",
+		Description: "AnsysGPTGetSystemPrompt returns the system prompt for Ansys GPT\n\nTags:\n  - @displayName: Get System Prompt\n\nParameters:\n  - query: the user query\n  - prohibitedWords: the words the answer must not contain\n  - template: the template of the system prompt\n\nReturns:\n  - systemPrompt: the system prompt\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "prohibitedWords", Type: "json", GoType: "[]string"},
+			{Name: "template", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"AnsysGPTPerformLLMRephraseRequest": {
+		Name:        "AnsysGPTPerformLLMRephraseRequest",
+		DisplayName: "Rephrase Request",
+		Description: "AnsysGPTPerformLLMRephraseRequest performs a rephrase request to LLM\n\nTags:\n  - @displayName: Rephrase Request\n\nParameters:\n  - ctx: the request context\n  - userTemplate: the template for the rephrase request\n  - query: the user query\n  - history: the conversation history\n  - systemPrompt: the system prompt of the rephrase request\n\nReturns:\n  - rephrasedQuery: the rephrased query\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userTemplate", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rephrasedQuery", Type: "string", GoType: "string"},
+		},
+	},
+	"AnsysGPTPerformLLMRephraseRequestNew": {
+		Name:        "AnsysGPTPerformLLMRephraseRequestNew",
+		DisplayName: "Rephrase Request New",
+		Description: "AnsysGPTPerformLLMRephraseRequestNew performs a rephrase request to LLM\n\nTags:\n  - @displayName: Rephrase Request New\n\nParameters:\n  - ctx: the request context\n  - template: the template for the rephrase request\n  - query: the user query\n  - history: the conversation history\n\nReturns:\n  - rephrasedQuery: the rephrased query\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "template", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rephrasedQuery", Type: "string", GoType: "string"},
+		},
+	},
+	"AnsysGPTPerformLLMRequest": {
+		Name:        "AnsysGPTPerformLLMRequest",
+		DisplayName: "LLM Request",
+		Description: "AnsysGPTPerformLLMRequest performs a request to Ansys GPT\n\nTags:\n  - @displayName: LLM Request\n\nParameters:\n  - ctx: the request context\n  - finalQuery: the final query\n  - history: the conversation history\n  - systemPrompt: the system prompt\n  - isStream: the flag to indicate whether the response should be streamed\n\nReturns:\n  - message: the message from the llm, empty if the response is streamed\n  - stream: the stream channel\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"AnsysGPTReorderSearchResponseAndReturnOnlyTopK": {
+		Name:        "AnsysGPTReorderSearchResponseAndReturnOnlyTopK",
+		DisplayName: "Reorder Search Response",
+		Description: "AnsysGPTReorderSearchResponseAndReturnOnlyTopK reorders the search response\n\nTags:\n  - @displayName: Reorder Search Response\n\nParameters:\n  - semanticSearchOutput: the search response\n  - topK: the number of results to be returned\n\nReturns:\n  - reorderedSemanticSearchOutput: the reordered search response\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "semanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse"},
+			{Name: "topK", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "reorderedSemanticSearchOutput", Type: "json", GoType: "[]ACSSearchResponse"},
+		},
+	},
+	"AnsysGPTReturnIndexList": {
+		Name:        "AnsysGPTReturnIndexList",
+		DisplayName: "List Indexes",
+		Description: "AnsysGPTReturnIndexList returns the index list for Ansys GPT\n\nTags:\n  - @displayName: List Indexes\n\nParameters:\n  - indexGroups: the index groups\n\nReturns:\n  - indexList: the index list\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "indexGroups", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "indexList", Type: "json", GoType: "[]string"},
+		},
+	},
+	"AppendMeshPilotHistory": {
+		Name:        "AppendMeshPilotHistory",
+		DisplayName: "AppendMeshPilotHistory",
+		Description: "AppendMeshPilotHistory this function append mesh pilot history\n\nTags:\n  - @displayName: AppendMeshPilotHistory\n\nParameters:\n  - history: the tool history\n  - role: the tool id\n  - content: the tool name\n\nReturns:\n  - updatedHistory: the updated mesh pilot history\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "history", Type: "json", GoType: "[]map[string]string"},
+			{Name: "role", Type: "string", GoType: "string"},
+			{Name: "content", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedHistory", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	"AppendMessageHistory": {
+		Name:        "AppendMessageHistory",
+		DisplayName: "Append Message History",
+		Description: "AppendMessageHistory appends a new message to the conversation history\n\nTags:\n  - @displayName: Append Message History\n\nParameters:\n  - newMessage: the new message\n  - role: the role of the message\n  - history: the conversation history\n\nReturns:\n  - updatedHistory: the updated conversation history\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "newMessage", Type: "string", GoType: "string"},
+			{Name: "role", Type: "string", GoType: "string", Options: []string{"user", "assistant", "system"}},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedHistory", Type: "json", GoType: "[]HistoricMessage"},
+		},
+	},
+	"AppendStringSlices": {
+		Name:        "AppendStringSlices",
+		DisplayName: "Append String Slices",
+		Description: "AppendStringSlices creates a new slice by appending all elements of the provided slices.\n\nTags:\n  - @displayName: Append String Slices\n\nParameters:\n  - slice1: the first slice to append.\n  - slice2: the second slice to append.\n  - slice3: the third slice to append.\n  - slice4: the fourth slice to append.\n  - slice5: the fifth slice to append.\n\nReturns:\n  - result: a new slice with all elements appended.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "slice1", Type: "json", GoType: "[]string"},
+			{Name: "slice2", Type: "json", GoType: "[]string"},
+			{Name: "slice3", Type: "json", GoType: "[]string"},
+			{Name: "slice4", Type: "json", GoType: "[]string"},
+			{Name: "slice5", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "[]string", Type: "json", GoType: "[]string"},
+		},
+	},
+	"AppendToolHistory": {
+		Name:        "AppendToolHistory",
+		DisplayName: "AppendToolHistory",
+		Description: "AppendToolHistory this function append tool history\n\nTags:\n  - @displayName: AppendToolHistory\n\nParameters:\n  - toolHistory: the tool history\n  - toolId: the tool id\n  - toolName: the tool name\n  - toolArguments: the tool arguments\n  - toolResponse: the tool response\n\nReturns:\n  - updatedToolHistory: the updated tool history\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "toolHistory", Type: "json", GoType: "[]map[string]string"},
+			{Name: "toolId", Type: "string", GoType: "string"},
+			{Name: "toolName", Type: "string", GoType: "string"},
+			{Name: "toolArguments", Type: "string", GoType: "string"},
+			{Name: "toolResponse", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedToolHistory", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	"AssignStringToString": {
+		Name:        "AssignStringToString",
+		DisplayName: "Assign String to String",
+		Description: "AssignStringToString assigns a string to another string\n\nTags:\n  - @displayName: Assign String to String\n\nParameters:\n  - inputString: the input string\n\nReturns:\n  - outputString: the output string\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "inputString", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "outputString", Type: "string", GoType: "string"},
+		},
+	},
+	"BuildFinalQueryForCodeLLMRequest": {
+		Name:        "BuildFinalQueryForCodeLLMRequest",
+		DisplayName: "Final Query (Code LLM Request)",
+		Description: "BuildFinalQueryForCodeLLMRequest builds the final query for a code generation\nrequest to LLM. The final query is a markdown string that contains the\noriginal request and the code examples from the KnowledgeDB.\n\nTags:\n  - @displayName: Final Query (Code LLM Request)\n\nParameters:\n  - request: the original request\n  - knowledgedbResponse: the KnowledgeDB response\n\nReturns:\n  - finalQuery: the final query\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "request", Type: "string", GoType: "string"},
+			{Name: "knowledgedbResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string"},
+		},
+	},
+	"BuildFinalQueryForGeneralLLMRequest": {
+		Name:        "BuildFinalQueryForGeneralLLMRequest",
+		DisplayName: "Final Query (General LLM Request)",
+		Description: "BuildFinalQueryForGeneralLLMRequest builds the final query for a general\nrequest to LLM. The final query is a markdown string that contains the\noriginal request and the examples from the KnowledgeDB.\n\nTags:\n  - @displayName: Final Query (General LLM Request)\n\nParameters:\n  - request: the original request\n  - knowledgedbResponse: the KnowledgeDB response\n\nReturns:\n  - finalQuery: the final query\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "request", Type: "string", GoType: "string"},
+			{Name: "knowledgedbResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "finalQuery", Type: "string", GoType: "string"},
+		},
+	},
+	"BuildLibraryContext": {
+		Name:        "BuildLibraryContext",
+		DisplayName: "Library Context",
+		Description: "BuildLibraryContext builds the context string for the query\n\nTags:\n  - @displayName: Library Context\n\nParameters:\n  - message: the message string\n  - libraryContext: the library context string\n\nReturns:\n  - messageWithContext: the message with context\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "libraryContext", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "messageWithContext", Type: "string", GoType: "string"},
+		},
+	},
+	"CastAnyToBool": {
+		Name:        "CastAnyToBool",
+		DisplayName: "Cast Any to Bool",
+		Description: "CastAnyToBool casts data of type any to bool\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Bool\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - bool\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "bool", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"CastAnyToByte": {
+		Name:        "CastAnyToByte",
+		DisplayName: "Cast Any to Byte",
+		Description: "CastAnyToByte casts data of type any to byte\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Byte\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - byte\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "byte", Type: "json", GoType: "byte"},
+		},
+	},
+	"CastAnyToComplex128": {
+		Name:        "CastAnyToComplex128",
+		DisplayName: "Cast Any to Complex128",
+		Description: "CastAnyToComplex128 casts data of type any to complex128\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Complex128\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - complex128\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "complex128", Type: "json", GoType: "complex128"},
+		},
+	},
+	"CastAnyToComplex64": {
+		Name:        "CastAnyToComplex64",
+		DisplayName: "Cast Any to Complex64",
+		Description: "CastAnyToComplex64 casts data of type any to complex64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Complex64\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - complex64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "complex64", Type: "json", GoType: "complex64"},
+		},
+	},
+	"CastAnyToFloat32": {
+		Name:        "CastAnyToFloat32",
+		DisplayName: "Cast Any to Float32",
+		Description: "CastAnyToFloat32 casts data of type any to float32\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Float32\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - float32\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "float32", Type: "number", GoType: "float32"},
+		},
+	},
+	"CastAnyToFloat64": {
+		Name:        "CastAnyToFloat64",
+		DisplayName: "Cast Any to Float64",
+		Description: "CastAnyToFloat64 casts data of type any to float64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Float64\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - float64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "float64", Type: "number", GoType: "float64"},
+		},
+	},
+	"CastAnyToInt": {
+		Name:        "CastAnyToInt",
+		DisplayName: "Cast Any to Int",
+		Description: "CastAnyToInt casts data of type any to int\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - int\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int", Type: "number", GoType: "int"},
+		},
+	},
+	"CastAnyToInt16": {
+		Name:        "CastAnyToInt16",
+		DisplayName: "Cast Any to Int16",
+		Description: "CastAnyToInt16 casts data of type any to int16\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int16\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - int16\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int16", Type: "number", GoType: "int16"},
+		},
+	},
+	"CastAnyToInt32": {
+		Name:        "CastAnyToInt32",
+		DisplayName: "Cast Any to Int32",
+		Description: "CastAnyToInt32 casts data of type any to int32\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int32\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - int32\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int32", Type: "number", GoType: "int32"},
+		},
+	},
+	"CastAnyToInt64": {
+		Name:        "CastAnyToInt64",
+		DisplayName: "Cast Any to Int64",
+		Description: "CastAnyToInt64 casts data of type any to int64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int64\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - int64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int64", Type: "number", GoType: "int64"},
+		},
+	},
+	"CastAnyToInt8": {
+		Name:        "CastAnyToInt8",
+		DisplayName: "Cast Any to Int8",
+		Description: "CastAnyToInt8 casts data of type any to int8\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Int8\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - int8\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "int8", Type: "number", GoType: "int8"},
+		},
+	},
+	"CastAnyToInterface": {
+		Name:        "CastAnyToInterface",
+		DisplayName: "Cast any to interface{}",
+		Description: "CastAnyToInterface casts data of type any to interface {}\n\nTags:\n  - @displayName: Cast any to interface{}\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - interface {}\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "interface{}", Type: "json", GoType: "interface{}"},
+		},
+	},
+	"CastAnyToRune": {
+		Name:        "CastAnyToRune",
+		DisplayName: "Cast Any to Rune",
+		Description: "CastAnyToRune casts data of type any to rune\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Rune\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - rune\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rune", Type: "json", GoType: "rune"},
+		},
+	},
+	"CastAnyToString": {
+		Name:        "CastAnyToString",
+		DisplayName: "Cast Any to String",
+		Description: "CastAnyToString casts data of type any to string\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to String\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - string\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	"CastAnyToUint": {
+		Name:        "CastAnyToUint",
+		DisplayName: "Cast Any to Uint",
+		Description: "CastAnyToUint casts data of type any to uint\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - uint\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint", Type: "number", GoType: "uint"},
+		},
+	},
+	"CastAnyToUint16": {
+		Name:        "CastAnyToUint16",
+		DisplayName: "Cast Any to Uint16",
+		Description: "CastAnyToUint16 casts data of type any to uint16\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint16\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - uint16\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint16", Type: "number", GoType: "uint16"},
+		},
+	},
+	"CastAnyToUint32": {
+		Name:        "CastAnyToUint32",
+		DisplayName: "Cast Any to Uint32",
+		Description: "CastAnyToUint32 casts data of type any to uint32\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint32\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - uint32\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint32", Type: "number", GoType: "uint32"},
+		},
+	},
+	"CastAnyToUint64": {
+		Name:        "CastAnyToUint64",
+		DisplayName: "Cast Any to Uint64",
+		Description: "CastAnyToUint64 casts data of type any to uint64\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint64\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - uint64\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint64", Type: "number", GoType: "uint64"},
+		},
+	},
+	"CastAnyToUint8": {
+		Name:        "CastAnyToUint8",
+		DisplayName: "Cast Any to Uint8",
+		Description: "CastAnyToUint8 casts data of type any to uint8\n\nThis is done via a type assertion. Will panic if assertion fails.\n\nTags:\n  - @displayName: Cast Any to Uint8\n\nParameters:\n  - data: the any value to cast.\n\nReturns\n  - uint8\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uint8", Type: "number", GoType: "uint8"},
+		},
+	},
+	"CastArrayMapStringAnyToAny": {
+		Name:        "CastArrayMapStringAnyToAny",
+		DisplayName: "Cast []map[string]any to any",
+		Description: "CastArrayMapStringAnyToAny casts data of type []map[string]any to any\n\nTags:\n  - @displayName: Cast []map[string]any to any\n\nParameters:\n  - data: the []map[string]any value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "[]map[string]any"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastBoolToAny": {
+		Name:        "CastBoolToAny",
+		DisplayName: "Cast Bool to Any",
+		Description: "CastBoolToAny casts data of type bool to any\n\nTags:\n  - @displayName: Cast Bool to Any\n\nParameters:\n  - data: the bool value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastByteToAny": {
+		Name:        "CastByteToAny",
+		DisplayName: "Cast Byte to Any",
+		Description: "CastByteToAny casts data of type byte to any\n\nTags:\n  - @displayName: Cast Byte to Any\n\nParameters:\n  - data: the byte value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "byte"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastComplex128ToAny": {
+		Name:        "CastComplex128ToAny",
+		DisplayName: "Cast Complex128 to Any",
+		Description: "CastComplex128ToAny casts data of type complex128 to any\n\nTags:\n  - @displayName: Cast Complex128 to Any\n\nParameters:\n  - data: the complex128 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "complex128"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastComplex64ToAny": {
+		Name:        "CastComplex64ToAny",
+		DisplayName: "Cast Complex64 to Any",
+		Description: "CastComplex64ToAny casts data of type complex64 to any\n\nTags:\n  - @displayName: Cast Complex64 to Any\n\nParameters:\n  - data: the complex64 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "complex64"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastFloat32ToAny": {
+		Name:        "CastFloat32ToAny",
+		DisplayName: "Cast Float32 to Any",
+		Description: "CastFloat32ToAny casts data of type float32 to any\n\nTags:\n  - @displayName: Cast Float32 to Any\n\nParameters:\n  - data: the float32 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "float32"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastFloat64ToAny": {
+		Name:        "CastFloat64ToAny",
+		DisplayName: "Cast Float64 to Any",
+		Description: "CastFloat64ToAny casts data of type float64 to any\n\nTags:\n  - @displayName: Cast Float64 to Any\n\nParameters:\n  - data: the float64 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "float64"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastInt16ToAny": {
+		Name:        "CastInt16ToAny",
+		DisplayName: "Cast Int16 to Any",
+		Description: "CastInt16ToAny casts data of type int16 to any\n\nTags:\n  - @displayName: Cast Int16 to Any\n\nParameters:\n  - data: the int16 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int16"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastInt32ToAny": {
+		Name:        "CastInt32ToAny",
+		DisplayName: "Cast Int32 to Any",
+		Description: "CastInt32ToAny casts data of type int32 to any\n\nTags:\n  - @displayName: Cast Int32 to Any\n\nParameters:\n  - data: the int32 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int32"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastInt64ToAny": {
+		Name:        "CastInt64ToAny",
+		DisplayName: "Cast Int64 to Any",
+		Description: "CastInt64ToAny casts data of type int64 to any\n\nTags:\n  - @displayName: Cast Int64 to Any\n\nParameters:\n  - data: the int64 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int64"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastInt8ToAny": {
+		Name:        "CastInt8ToAny",
+		DisplayName: "Cast Int8 to Any",
+		Description: "CastInt8ToAny casts data of type int8 to any\n\nTags:\n  - @displayName: Cast Int8 to Any\n\nParameters:\n  - data: the int8 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int8"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastIntToAny": {
+		Name:        "CastIntToAny",
+		DisplayName: "Cast Int to Any",
+		Description: "CastIntToAny casts data of type int to any\n\nTags:\n  - @displayName: Cast Int to Any\n\nParameters:\n  - data: the int value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastInterfaceToAny": {
+		Name:        "CastInterfaceToAny",
+		DisplayName: "Cast interface{} to any",
+		Description: "CastInterfaceToAny casts data of type interface {} to any\n\nTags:\n  - @displayName: Cast interface{} to any\n\nParameters:\n  - data: the interface {} value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "interface{}"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastRuneToAny": {
+		Name:        "CastRuneToAny",
+		DisplayName: "Cast Rune to Any",
+		Description: "CastRuneToAny casts data of type rune to any\n\nTags:\n  - @displayName: Cast Rune to Any\n\nParameters:\n  - data: the rune value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "rune"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastStringToAny": {
+		Name:        "CastStringToAny",
+		DisplayName: "Cast String to Any",
+		Description: "CastStringToAny casts data of type string to any\n\nTags:\n  - @displayName: Cast String to Any\n\nParameters:\n  - data: the string value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastUint16ToAny": {
+		Name:        "CastUint16ToAny",
+		DisplayName: "Cast Uint16 to Any",
+		Description: "CastUint16ToAny casts data of type uint16 to any\n\nTags:\n  - @displayName: Cast Uint16 to Any\n\nParameters:\n  - data: the uint16 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint16"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastUint32ToAny": {
+		Name:        "CastUint32ToAny",
+		DisplayName: "Cast Uint32 to Any",
+		Description: "CastUint32ToAny casts data of type uint32 to any\n\nTags:\n  - @displayName: Cast Uint32 to Any\n\nParameters:\n  - data: the uint32 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint32"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastUint64ToAny": {
+		Name:        "CastUint64ToAny",
+		DisplayName: "Cast Uint64 to Any",
+		Description: "CastUint64ToAny casts data of type uint64 to any\n\nTags:\n  - @displayName: Cast Uint64 to Any\n\nParameters:\n  - data: the uint64 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint64"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastUint8ToAny": {
+		Name:        "CastUint8ToAny",
+		DisplayName: "Cast Uint8 to Any",
+		Description: "CastUint8ToAny casts data of type uint8 to any\n\nTags:\n  - @displayName: Cast Uint8 to Any\n\nParameters:\n  - data: the uint8 value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint8"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CastUintToAny": {
+		Name:        "CastUintToAny",
+		DisplayName: "Cast Uint to Any",
+		Description: "CastUintToAny casts data of type uint to any\n\nTags:\n  - @displayName: Cast Uint to Any\n\nParameters:\n  - data: the uint value to cast.\n\nReturns\n  - any\n",
+		Category:    "cast",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "number", GoType: "uint"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"CheckApiKeyAuthKvDb": {
+		Name:        "CheckApiKeyAuthKvDb",
+		DisplayName: "Verify API Key",
+		Description: "CheckApiKeyAuthKvDb checks if the provided API key is authenticated against the KVDB.\n\nTags:\n  - @displayName: Verify API Key\n\nParameters:\n  - ctx: the request context\n  - kvdbEndpoint: the KVDB endpoint\n  - apiKey: The API key to check\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - isAuthenticated: true if the API key is authenticated, false otherwise\n  - childSpanID: the child span ID created for this operation\n  - userID: the ID of the user the API key belongs to, empty if the API key is not authenticated\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "kvdbEndpoint", Type: "string", GoType: "string"},
+			{Name: "apiKey", Type: "string", GoType: "string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "isAuthenticated", Type: "boolean", GoType: "bool"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+			{Name: "userID", Type: "string", GoType: "string"},
+		},
+	},
+	"CheckApiKeyAuthMongoDb": {
+		Name:        "CheckApiKeyAuthMongoDb",
+		DisplayName: "Verify API Key",
+		Description: "CheckApiKeyAuthMongoDb checks if the given API key is valid and has access to the service.\n\nTags:\n  - @displayName: Verify API Key\n\nParameters:\n  - ctx: the request context.\n  - apiKey: The API key to check.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - isAuthenticated: A boolean indicating whether the API key is authenticated.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "apiKey", Type: "string", GoType: "string"},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string"},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string"},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "isAuthenticated", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"CheckCreateUserIdMongoDb": {
+		Name:        "CheckCreateUserIdMongoDb",
+		DisplayName: "Check and Create User ID",
+		Description: "CheckCreateUserIdMongoDb checks if a user ID exists in the MongoDB database and creates it if it doesn't.\n\nTags:\n  - @displayName: Check and Create User ID\n\nParameters:\n  - ctx: the request context.\n  - userId: The user ID to check.\n  - temporaryTokenLimit: The token limit for new users.\n  - hoursUntilTokenLimitReset: The number of hours until the token limit of new users is reset.\n  - modelId: The IDs of the models the new users can access.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - existingUser: A boolean indicating whether the user ID already exists.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userId", Type: "string", GoType: "string"},
+			{Name: "temporaryTokenLimit", Type: "number", GoType: "int"},
+			{Name: "hoursUntilTokenLimitReset", Type: "number", GoType: "int"},
+			{Name: "modelId", Type: "json", GoType: "[]string"},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string"},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string"},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "existingUser", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"CheckTokenLimitReached": {
+		Name:        "CheckTokenLimitReached",
+		DisplayName: "Check Token Limit Reached",
+		Description: "CheckTokenLimitReached checks if the query exceeds the token limit for the specified model\n\nTags:\n  - @displayName: Check Token Limit Reached\n\nParameters:\n  - query: the query string\n  - tokenLimit: the token limit\n  - modelName: the name of the model to check against\n  - tokenLimitMessage: the message to return if the token limit is reached\n\nReturns:\n  - tokenLimitReached: true if the token limit is reached, false otherwise\n  - responseMessage: the token limit message if the token limit is reached, empty otherwise\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "tokenLimit", Type: "number", GoType: "int"},
+			{Name: "modelName", Type: "string", GoType: "string"},
+			{Name: "tokenLimitMessage", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+			{Name: "responseMessage", Type: "string", GoType: "string"},
+		},
+	},
+	"CreateCollectionRequest": {
+		Name:        "CreateCollectionRequest",
+		DisplayName: "Create Collection",
+		Description: "CreateCollectionRequest sends a request to the collection endpoint.\n\nTags:\n  - @displayName: Create Collection\n\nParameters:\n  - ctx: the request context.\n  - collectionName: the name of the collection to create.\n  - vectorSize: the length of the vector S\n  - vectorDistance: the vector similarity distance algorithm to use for the vector index (cosine, dot, euclid, manhattan)\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string"},
+			{Name: "vectorSize", Type: "number", GoType: "uint64"},
+			{Name: "vectorDistance", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"CreateDbFilter": {
+		Name:        "CreateDbFilter",
+		DisplayName: "Create Filter",
+		Description: "CreateDbFilter creates a filter for the KnowledgeDB.\n\nThe function returns the filter.\n\nTags:\n  - @displayName: Create Filter\n\nParameters:\n  - guid: the guid filter\n  - documentId: the document ID filter\n  - documentName: the document name filter\n  - level: the level filter\n  - tags: the tags filter\n  - keywords: the keywords filter\n  - metadata: the metadata filter\n\nReturns:\n  - databaseFilter: the filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "guid", Type: "json", GoType: "[]string"},
+			{Name: "documentId", Type: "json", GoType: "[]string"},
+			{Name: "documentName", Type: "json", GoType: "[]string"},
+			{Name: "level", Type: "json", GoType: "[]string"},
+			{Name: "tags", Type: "json", GoType: "DbArrayFilter"},
+			{Name: "keywords", Type: "json", GoType: "DbArrayFilter"},
+			{Name: "metadata", Type: "json", GoType: "[]DbJsonFilter"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbFilters"},
+		},
+	},
+	"CreateGeneralDataExtractionDocumentObjects": {
+		Name:        "CreateGeneralDataExtractionDocumentObjects",
+		DisplayName: "Create General Data Extraction Document Objects",
+		Description: "CreateGeneralDataExtractionDocumentObjects creates general data extraction document objects from\nthe provided document chunks, dense embeddings, and sparse embeddings.\n\nTags:\n  - @displayName: Create General Data Extraction Document Objects\n\nParameters:\n  - documentName: name of the document.\n  - documentChunks: chunks of the document.\n  - denseEmbeddings: dense embeddings of the document.\n  - sparseEmbeddings: sparse embeddings of the document.\n\nReturns:\n  - extractionData: general data extraction document objects in interface format.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "documentName", Type: "string", GoType: "string"},
+			{Name: "documentChunks", Type: "json", GoType: "[]string"},
+			{Name: "denseEmbeddings", Type: "json", GoType: "[][]float32"},
+			{Name: "sparseEmbeddings", Type: "json", GoType: "[]map[uint]float32"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "extractionData", Type: "json", GoType: "[]interface{}"},
+		},
+	},
+	"CreateKeywordsDbFilter": {
+		Name:        "CreateKeywordsDbFilter",
+		DisplayName: "Keywords Filter",
+		Description: "CreateKeywordsDbFilter creates a keywords filter for the KnowledgeDB.\n\nThe function returns the keywords filter.\n\nTags:\n  - @displayName: Keywords Filter\n\nParameters:\n  - keywords: the keywords to be used for the filter\n  - needAll: flag to indicate whether all keywords are needed\n\nReturns:\n  - databaseFilter: the keywords filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "keywords", Type: "json", GoType: "[]string"},
+			{Name: "needAll", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbArrayFilter"},
+		},
+	},
+	"CreateMessageWithVariable": {
+		Name:        "CreateMessageWithVariable",
+		DisplayName: "Create Message with Variable",
+		Description: "CreateMessageWithVariable creates a message with a variable.\n\nTags:\n  - @displayName: Create Message with Variable\n\nParameters:\n  - message: The message to create.\n  - variable: The variable to insert into the message.\n\nReturns:\n  - updatedMessage: The updated message with the variable inserted.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "variable", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedMessage", Type: "string", GoType: "string"},
+		},
+	},
+	"CreateMetadataDbFilter": {
+		Name:        "CreateMetadataDbFilter",
+		DisplayName: "Metadata Filter",
+		Description: "CreateMetadataDbFilter creates a metadata filter for the KnowledgeDB.\n\nThe function returns the metadata filter.\n\nTags:\n  - @displayName: Metadata Filter\n\nParameters:\n  - fieldName: the name of the field\n  - fieldType: the type of the field\n  - filterData: the filter data\n  - needAll: flag to indicate whether all data is needed\n\nReturns:\n  - databaseFilter: the metadata filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "fieldName", Type: "string", GoType: "string"},
+			{Name: "fieldType", Type: "string", GoType: "string"},
+			{Name: "filterData", Type: "json", GoType: "[]string"},
+			{Name: "needAll", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbJsonFilter"},
+		},
+	},
+	"CreateTagsDbFilter": {
+		Name:        "CreateTagsDbFilter",
+		DisplayName: "Tags Filter",
+		Description: "CreateTagsDbFilter creates a tags filter for the KnowledgeDB.\n\nThe function returns the tags filter.\n\nTags:\n  - @displayName: Tags Filter\n\nParameters:\n  - tags: the tags to be used for the filter\n  - needAll: flag to indicate whether all tags are needed\n\nReturns:\n  - databaseFilter: the tags filter\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "tags", Type: "json", GoType: "[]string"},
+			{Name: "needAll", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseFilter", Type: "json", GoType: "DbArrayFilter"},
+		},
+	},
+	"CutLatestMessagesFromHistory": {
+		Name:        "CutLatestMessagesFromHistory",
+		DisplayName: "Cut Latest Messages",
+		Description: "CutLatestMessagesFromHistory cuts the latest messages from the conversation history\n\nTags:\n  - @displayName: Cut Latest Messages\n\nParameters:\n  - history: the conversation history\n  - numberOfMessages: the number of messages to cut from the end of the conversation history\n\nReturns:\n  - updatedHistory: the updated conversation history\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "numberOfMessages", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedHistory", Type: "json", GoType: "[]HistoricMessage"},
+		},
+	},
+	"DataPluginCheckQueryType": {
+		Name:        "DataPluginCheckQueryType",
+		DisplayName: "Data Plugin Check Query Type",
+		Description: "DataPluginCheckQueryType checks the query type for data plugin\n\nTags:\n  - @displayName: Data Plugin Check Query Type\n\nParameters:\n  - queryType: the query type\n  - errorResponseMessage: the message returned for gated queries\n\nReturns:\n  - isGreet: the flag indicating whether the query type is greet\n  - isGated: the flag indicating whether the query type is gated\n  - errorMessage: the error message\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "queryType", Type: "string", GoType: "string"},
+			{Name: "errorResponseMessage", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "isGreet", Type: "boolean", GoType: "bool"},
+			{Name: "isGated", Type: "boolean", GoType: "bool"},
+			{Name: "errorMessage", Type: "string", GoType: "string"},
+		},
+	},
+	"DataPluginConvertCitationsToContext": {
+		Name:        "DataPluginConvertCitationsToContext",
+		DisplayName: "Data Plugin Convert Citations to Context",
+		Description: "DataPluginConvertCitationsToContext converts citations to context\n\nTags:\n  - @displayName: Data Plugin Convert Citations to Context\n\nParameters:\n  - citations: the citations string\n\nReturns:\n  - context: the context extracted from the citations\n  - resetCitations: an empty string, used to reset the citations\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "citations", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "context", Type: "json", GoType: "[]AnsysGPTRetrieverModuleChunk"},
+			{Name: "resetCitations", Type: "string", GoType: "string"},
+		},
+	},
+	"DataPluginGetContext": {
+		Name:        "DataPluginGetContext",
+		DisplayName: "Data Plugin Get Context",
+		Description: "DataPluginGetContext retrieves context from a data plugin\n\nTags:\n  - @displayName: Data Plugin Get Context\n\nParameters:\n  - ctx: the request context\n  - userQuery: the user query\n  - apiUrl: the API URL of the data plugin\n  - username: the username for authentication at the data plugin\n  - password: the password for authentication at the data plugin\n  - topK: the number of results to be returned\n  - physics: the physics to be used as filter\n  - dataSource: the data sources to be used as filter\n\nReturns:\n  - context: the context retrieved from the data plugin\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userQuery", Type: "string", GoType: "string"},
+			{Name: "apiUrl", Type: "string", GoType: "string"},
+			{Name: "username", Type: "string", GoType: "string"},
+			{Name: "password", Type: "string", GoType: "string"},
+			{Name: "topK", Type: "number", GoType: "int"},
+			{Name: "physics", Type: "json", GoType: "[]string"},
+			{Name: "dataSource", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "context", Type: "json", GoType: "[]AnsysGPTRetrieverModuleChunk"},
+		},
+	},
+	"DataPluginGetFinalSystemPrompt": {
+		Name:        "DataPluginGetFinalSystemPrompt",
+		DisplayName: "Data Plugin Get Final System Prompt",
+		Description: "DataPluginGetFinalSystemPrompt returns the final system prompt for data plugin\n\nTags:\n  - @displayName: Data Plugin Get Final System Prompt\n\nParameters:\n  - responseType: the response type\n  - classicTemplate: the classic template\n  - listTemplate: the list template\n\nReturns:\n  - systemPrompt: the final system prompt\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "responseType", Type: "string", GoType: "string"},
+			{Name: "classicTemplate", Type: "string", GoType: "string"},
+			{Name: "listTemplate", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"DataPluginPerformLLMFinalRequest": {
+		Name:        "DataPluginPerformLLMFinalRequest",
+		DisplayName: "Data Plugin Final Request",
+		Description: "DataPluginPerformLLMFinalRequest performs a final request to LLM for data plugin\n\nTags:\n  - @displayName: Data Plugin Final Request\n\nParameters:\n  - ctx: the request context\n  - systemTemplate: the system template for the final request\n  - userTemplate: the user template for the final request\n  - query: the user query\n  - history: the conversation history\n  - context: the context retrieved from the retriever module\n  - prohibitedWords: the list of prohibited words\n  - isStream: the flag to define if the response should be streamed\n\nReturns:\n  - message: the message\n  - stream: the stream channel\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "systemTemplate", Type: "string", GoType: "string"},
+			{Name: "userTemplate", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "context", Type: "json", GoType: "[]AnsysGPTRetrieverModuleChunk"},
+			{Name: "prohibitedWords", Type: "json", GoType: "[]string"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"DataPluginPerformLLMRephraseRequest": {
+		Name:        "DataPluginPerformLLMRephraseRequest",
+		DisplayName: "Data Plugin Rephrase Request",
+		Description: "DataPluginPerformLLMRephraseRequest performs a rephrase request to LLM for data plugin\n\nTags:\n  - @displayName: Data Plugin Rephrase Request\n\nParameters:\n  - ctx: the request context\n  - systemTemplate: the system template for the rephrase request\n  - userTemplate: the user template for the rephrase request\n  - query: the user query\n  - history: the conversation history\n\nReturns:\n  - rephrasedQuery: the rephrased query\n",
+		Category:    "ansys_gpt",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "systemTemplate", Type: "string", GoType: "string"},
+			{Name: "userTemplate", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "rephrasedQuery", Type: "string", GoType: "string"},
+		},
+	},
+	"DenyCustomerAccessAndSendWarningKvDb": {
+		Name:        "DenyCustomerAccessAndSendWarningKvDb",
+		DisplayName: "Deny Customer Access and Send Warning",
+		Description: "DenyCustomerAccessAndSendWarningKvDb denies access to a customer and sends a warning if not already sent\n\nTags:\n  - @displayName: Deny Customer Access and Send Warning\n\nParameters:\n  - ctx: the request context\n  - kvdbEndpoint: the KVDB endpoint\n  - apiKey: The API key of the customer\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - customerName: The name of the customer\n  - sendWarning: true if a warning was sent, false if it was already sent\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "kvdbEndpoint", Type: "string", GoType: "string"},
+			{Name: "apiKey", Type: "string", GoType: "string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "customerName", Type: "string", GoType: "string"},
+			{Name: "sendWarning", Type: "boolean", GoType: "bool"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDb": {
+		Name:        "DenyCustomerAccessAndSendWarningMongoDb",
+		DisplayName: "Deny Customer Access",
+		Description: "DenyCustomerAccessAndSendWarningMongoDb denies access to the customer and sends a warning if necessary.\n\nTags:\n  - @displayName: Deny Customer Access\n\nParameters:\n  - ctx: the request context.\n  - apiKey: The API key of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - customerName: The name of the customer.\n  - sendWarning: A boolean indicating whether a warning should be sent to the customer.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "apiKey", Type: "string", GoType: "string"},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string"},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string"},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "customerName", Type: "string", GoType: "string"},
+			{Name: "sendWarning", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDbUserId": {
+		Name:        "DenyCustomerAccessAndSendWarningMongoDbUserId",
+		DisplayName: "Deny Customer Access by User ID",
+		Description: "DenyCustomerAccessAndSendWarningMongoDbUserId denies access to the customer by user ID and sends a warning if necessary.\n\nTags:\n  - @displayName: Deny Customer Access by User ID\n\nParameters:\n  - ctx: the request context.\n  - userId: The user ID of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n\nReturns:\n  - sendWarning: A boolean indicating whether a warning should be sent to the customer.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userId", Type: "string", GoType: "string"},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string"},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string"},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "sendWarning", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"DownloadGithubFileContent": {
+		Name:        "DownloadGithubFileContent",
+		DisplayName: "Download Github File Content",
+		Description: "DownloadGithubFileContent downloads file content from github and returns checksum and content.\n\nTags:\n  - @displayName: Download Github File Content\n\nParameters:\n  - ctx: the request context.\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - gihubFilePath: path to file in the github repository.\n  - githubAccessToken: access token for github.\n\nReturns:\n  - checksum: checksum of file.\n  - content: content of file.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "githubRepoName", Type: "string", GoType: "string"},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string"},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string"},
+			{Name: "gihubFilePath", Type: "string", GoType: "string"},
+			{Name: "githubAccessToken", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "checksum", Type: "string", GoType: "string"},
+			{Name: "content", Type: "json", GoType: "[]byte"},
+		},
+	},
+	"DownloadGithubFilesContent": {
+		Name:        "DownloadGithubFilesContent",
+		DisplayName: "Download Github Files Content",
+		Description: "DownloadGithubFilesContent downloads file content from github and returns checksum and content.\n\nTags:\n  - @displayName: Download Github Files Content\n\nParameters:\n  - ctx: the request context.\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - gihubFilePaths: paths to the files in the github repository.\n  - githubAccessToken: access token for github.\n\nReturns:\n  - filesMap: map of file paths to file content.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "githubRepoName", Type: "string", GoType: "string"},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string"},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string"},
+			{Name: "gihubFilePaths", Type: "json", GoType: "[]string"},
+			{Name: "githubAccessToken", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filesMap", Type: "json", GoType: "map[string][]byte"},
+		},
+	},
+	"ExecuteTool": {
+		Name:        "ExecuteTool",
+		DisplayName: "Execute MCP Tool",
+		Description: "ExecuteTool executes a specific tool via the MCP server with provided arguments.\n\nTags:\n  - @displayName: Execute MCP Tool\n\nParameters:\n  - ctx: the request context\n  - serverURL: the WebSocket URL of the MCP server\n  - toolName: the name of the tool to execute\n  - args: a map of arguments to pass to the tool\n\nReturns:\n  - result: the response from the tool execution\n  - error: any error that occurred during execution\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string"},
+			{Name: "toolName", Type: "string", GoType: "string"},
+			{Name: "args", Type: "json", GoType: "map[string]interface{}"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "map[string]interface{}", Type: "json", GoType: "map[string]interface{}"},
+			{Name: "error", Type: "json", GoType: "error"},
+		},
+	},
+	"ExtractCriteriaSuggestions": {
+		Name:        "ExtractCriteriaSuggestions",
+		DisplayName: "Extract criteria suggestions from LLM response",
+		Description: "ExtractCriteriaSuggestions extracts criteria suggestions from the LLM response text\n\nTags:\n  - @displayName: Extract criteria suggestions from LLM response\n\nParameters:\n  - llmResponse: the text response from the LLM containing JSON with criteria suggestions\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - criteriaSuggestions: the list of criteria suggestions extracted from the LLM response\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "llmResponse", Type: "string", GoType: "string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialLlmCriterion"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"ExtractDesignRequirementsAndSearchCriteria": {
+		Name:        "ExtractDesignRequirementsAndSearchCriteria",
+		DisplayName: "Extract Design Requirements and Search Criteria",
+		Description: "ExtractDesignRequirementsAndSearchCriteria parses the user input JSON and returns the design requirements string\nand the list of available search criteria GUIDs.\n\nTags:\n  - @displayName: Extract Design Requirements and Search Criteria\n\nParameters:\n  - userInput: the user input JSON string\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - designRequirements: the extracted design requirements string\n  - availableSearchCriteria: the extracted list of attribute GUIDs\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInput", Type: "string", GoType: "string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "designRequirements", Type: "string", GoType: "string"},
+			{Name: "availableSearchCriteria", Type: "json", GoType: "[]string"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"ExtractJSONStringField": {
+		Name:        "ExtractJSONStringField",
+		DisplayName: "Extract JSON String Field",
+		Description: "ExtractJSONStringField extracts a string field from a JSON string using a key path.\nThe key path is a dot-separated string that specifies the path to the field in the JSON object.\n\nTags:\n  - @displayName: Extract JSON String Field\n\nParameters:\n  - jsonStr: the JSON string to extract the field from\n  - keyPath: the dot-separated path to the field in the JSON object\n\nReturns:\n  - the value of the field as a string\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "jsonStr", Type: "string", GoType: "string"},
+			{Name: "keyPath", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	"FetchActionsPathFromPathDescription": {
+		Name:        "FetchActionsPathFromPathDescription",
+		DisplayName: "FetchActionsPathFromPathDescription",
+		Description: "FetchActionsPathFromPathDescription fetch actions from path description\n\nTags:\n  - @displayName: FetchActionsPathFromPathDescription\n\nParameters:\n  - ctx: the request context.\n  - db_name: the name of the database\n  - description: the desctiption of path\n  - nodeLabel: the label of the node\n\nReturns:\n  - actions: the list of actions to execute\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string"},
+			{Name: "description", Type: "string", GoType: "string"},
+			{Name: "nodeLabel", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "actions", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	"FetchNodeDescriptionsFromPathDescription": {
+		Name:        "FetchNodeDescriptionsFromPathDescription",
+		DisplayName: "FetchNodeDescriptionsFromPathDescription",
+		Description: "FetchNodeDescriptionsFromPathDescription get node descriptions from path description\n\nTags:\n  - @displayName: FetchNodeDescriptionsFromPathDescription\n\nParameters:\n  - ctx: the request context.\n  - db_name: the name of the database\n  - description: the desctiption of path\n\nReturns:\n  - actionDescriptions: action descriptions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string"},
+			{Name: "description", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "actionDescriptions", Type: "string", GoType: "string"},
+		},
+	},
+	"FetchPropertiesFromPathDescription": {
+		Name:        "FetchPropertiesFromPathDescription",
+		DisplayName: "FetchPropertiesFromPathDescription",
+		Description: "FetchPropertiesFromPathDescription get properties from path description\n\nTags:\n  - @displayName: FetchPropertiesFromPathDescription\n\nParameters:\n  - ctx: the request context.\n  - db_name: the name of the database\n  - description: the desctiption of path\n\nReturns:\n  - properties: the list of descriptions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string"},
+			{Name: "description", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "properties", Type: "json", GoType: "[]string"},
+		},
+	},
+	"FilterOutDuplicateAttributes": {
+		Name:        "FilterOutDuplicateAttributes",
+		DisplayName: "Filter out duplicate attributes",
+		Description: "FilterOutDuplicateAttributes filters out duplicate attributes from the criteria suggestions based on their names\n\nTags:\n  - @displayName: Filter out duplicate attributes\n\nParameters:\n  - criteriaSuggestions: current list of criteria suggestions\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - filtered: the list of criteria suggestions excluding duplicates based on attribute names\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialLlmCriterion"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filtered", Type: "json", GoType: "[]MaterialLlmCriterion"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"FilterOutNonExistingAttributes": {
+		Name:        "FilterOutNonExistingAttributes",
+		DisplayName: "Filter out non-existing attributes",
+		Description: "FilterOutNonExistingAttributes filters out criteria suggestions that do not match any of the available attributes based on their GUIDs\n\nTags:\n  - @displayName: Filter out non-existing attributes\n\nParameters:\n  - criteriaSuggestions: current list of criteria suggestions\n  - availableSearchCriteria: the list of available search criteria (GUIDs)\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - filtered: the list of criteria suggestions excluding those that do not match any of the available search criteria\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialCriterionWithGuid"},
+			{Name: "availableSearchCriteria", Type: "json", GoType: "[]string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filtered", Type: "json", GoType: "[]MaterialCriterionWithGuid"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"FinalizeMessage": {
+		Name:        "FinalizeMessage",
+		DisplayName: "FinalizeMessage",
+		Description: "FinalizeMessage this function takes message and generate response schema\n\nTags:\n  - @displayName: FinalizeMessage\n\nParameters:\n  - message: final message\n\nReturns:\n  - result: response schema sent to chat interface\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	"FinalizeResult": {
+		Name:        "FinalizeResult",
+		DisplayName: "FinalizeResult",
+		Description: "FinalizeResult converts actions to json string to send back data\n\nTags:\n  - @displayName: FinalizeResult\n\nParameters:\n  - actions: the executable actions\n  - toolName: tool name to create customize messages\n\nReturns:\n  - result: the actions in json format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "actions", Type: "json", GoType: "[]map[string]string"},
+			{Name: "toolName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	"FindRelevantPathDescription": {
+		Name:        "FindRelevantPathDescription",
+		DisplayName: "FindRelevantPathDescription",
+		Description: "FindRelevantPathDescription finds the relevant description by prompting\n\nTags:\n  - @displayName: FindRelevantPathDescription\n\nParameters:\n  - descriptions: the list of descriptions\n  - message: the message from llm\n\nReturns:\n  - relevantDescription: the relevant desctiption\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "descriptions", Type: "json", GoType: "[]string"},
+			{Name: "message", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "relevantDescription", Type: "string", GoType: "string"},
+		},
+	},
+	"FluentCodeGen": {
+		Name:        "FluentCodeGen",
+		DisplayName: "Fluent Code Gen",
+		Description: "FluentCodeGen sends a raw user message to the Fluent container and returns the response\n\nTags:\n  - @displayName: Fluent Code Gen\n\nParameters:\n  - ctx: the request context\n  - url: the URL of the Fluent container\n  - message: the raw user message to send to the container\n\nReturns:\n  - response: the response from the Fluent container as a string\n",
+		Category:    "fluent",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "url", Type: "string", GoType: "string"},
+			{Name: "message", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "response", Type: "string", GoType: "string"},
+		},
+	},
+	"GeneralGraphDbQuery": {
+		Name:        "GeneralGraphDbQuery",
+		DisplayName: "General Graph DB Query",
+		Description: "GeneralGraphDbQuery executes the given Cypher query and returns the response.\n\nThe function returns the graph db response.\n\nTags:\n  - @displayName: General Graph DB Query\n\nParameters:\n  - ctx: the request context.\n  - dbname: the name of the graphdb to target. If not provided, defaults to \"aali\".\n  - query: the Cypher query to be executed.\n  - parameters: parameters to pass to the query during execution\n\nReturns:\n  - databaseResponse: the graph db response\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dbname", Type: "string", GoType: "string"},
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "parameters", Type: "json", GoType: "ParameterMap"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "[]map[string]any", Type: "json", GoType: "[]map[string]any"},
+		},
+	},
+	"GeneralQuery": {
+		Name:        "GeneralQuery",
+		DisplayName: "Query",
+		Description: "GeneralQuery performs a general query in the KnowledgeDB.\n\nThe function returns the query results.\n\nTags:\n  - @displayName: Query\n\nParameters:\n  - ctx: the request context.\n  - collectionName: the name of the collection to which the data objects will be added.\n  - maxRetrievalCount: the maximum number of results to be retrieved.\n  - outputFields: the fields to be included in the output.\n  - filters: the filter for the query.\n\nReturns:\n  - databaseResponse: the query results\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string"},
+			{Name: "maxRetrievalCount", Type: "number", GoType: "int"},
+			{Name: "outputFields", Type: "json", GoType: "[]string"},
+			{Name: "filters", Type: "json", GoType: "DbFilters"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+	},
+	"GenerateActionsSubWorkflowPrompt": {
+		Name:        "GenerateActionsSubWorkflowPrompt",
+		DisplayName: "GenerateActionsSubWorkflowPrompt",
+		Description: "GenerateActionsSubWorkflowPrompt generates system and user prompts for subworkflow identification.\n\nTags:\n  - @displayName: GenerateActionsSubWorkflowPrompt\n\nParameters:\n  - userInstruction: user instruction\n\nReturns:\n  - systemPrompt: the system prompt\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"GenerateDocumentTree": {
+		Name:        "GenerateDocumentTree",
+		DisplayName: "Document Tree",
+		Description: "GenerateDocumentTree generates a tree structure from the document chunks.\n\nTags:\n  - @displayName: Document Tree\n\nParameters:\n  - ctx: the request context.\n  - documentName: name of the document.\n  - documentId: id of the document.\n  - documentChunks: chunks of the document.\n  - embeddingsDimensions: dimensions of the embeddings.\n  - getSummary: whether to get summary.\n  - getKeywords: whether to get keywords.\n  - numKeywords: number of keywords.\n  - chunkSize: size of the chunks.\n  - numLlmWorkers: number of llm workers.\n\nReturns:\n  - returnedDocumentData: tree structure of the document.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "documentName", Type: "string", GoType: "string"},
+			{Name: "documentId", Type: "string", GoType: "string"},
+			{Name: "documentChunks", Type: "json", GoType: "[]string"},
+			{Name: "embeddingsDimensions", Type: "number", GoType: "int"},
+			{Name: "getSummary", Type: "boolean", GoType: "bool"},
+			{Name: "getKeywords", Type: "boolean", GoType: "bool"},
+			{Name: "numKeywords", Type: "number", GoType: "int"},
+			{Name: "chunkSize", Type: "number", GoType: "int"},
+			{Name: "numLlmWorkers", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "returnedDocumentData", Type: "json", GoType: "[]DbData"},
+		},
+	},
+	"GenerateHelperSubWorkflowPrompt": {
+		Name:        "GenerateHelperSubWorkflowPrompt",
+		DisplayName: "GenerateHelperSubWorkflowPrompt",
+		Description: "GenerateHelperSubWorkflowPrompt generates system and user prompts for helper subworkflow identification.\n\nTags:\n  - @displayName: GenerateHelperSubWorkflowPrompt\n\nParameters:\n  - userInstruction: user instruction\n\nReturns:\n  - systemPrompt: the system prompt\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"GenerateMKSummariesforTags": {
+		Name:        "GenerateMKSummariesforTags",
+		DisplayName: "GenerateMKSummariesforTags",
+		Description: "GenerateMKSummariesforTags retrieves unique MK summaries for the provided tags from the graph database.\n\nTags:\n  - @displayName: GenerateMKSummariesforTags\n\nParameters:\n  - ctx: the request context.\n  - dbName: the name of the database\n  - tags: the list of tags\n  - GetTagIdByNameQuery: the query getting the ID of a tag by its name\n  - GetMKSummaryFromDBQuery: the query getting the MK summary of a tag ID\n\nReturns:\n  - allTagsSummaries: the list of unique MK summaries\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dbName", Type: "string", GoType: "string"},
+			{Name: "tags", Type: "json", GoType: "[]string"},
+			{Name: "GetTagIdByNameQuery", Type: "string", GoType: "string"},
+			{Name: "GetMKSummaryFromDBQuery", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "allTagsSummaries", Type: "json", GoType: "[]string"},
+		},
+	},
+	"GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt": {
+		Name:        "GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt",
+		DisplayName: "GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt",
+		Description: "GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt generates a user prompt for synthesizing an answer from meta knowledge.\n\nTags:\n  - @displayName: GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt\n\nParameters:\n  - SynthesizeAnswerUserPromptTemplate: the template string with placeholders for original query, expanded sub-queries, and retrieved Q&A pairs\n  - originalQuery: the user's original query\n  - expandedQueries: the expanded sub-queries\n  - retrievedQAPairs: the retrieved Q&A pairs\n\nReturns:\n  - userPrompt: the formatted user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "SynthesizeAnswerUserPromptTemplate", Type: "string", GoType: "string"},
+			{Name: "originalQuery", Type: "string", GoType: "string"},
+			{Name: "expandedQueries", Type: "json", GoType: "[]string"},
+			{Name: "retrievedQAPairs", Type: "json", GoType: "[]map[string]interface{}"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"GenerateUUID": {
+		Name:        "GenerateUUID",
+		DisplayName: "Generate UUID",
+		Description: "GenerateUUID generates a new UUID (Universally Unique Identifier).\n\nTags:\n  - @displayName: Generate UUID\n\nReturns:\n  - a string representation of the generated UUID\n",
+		Category:    "generic",
+		Input:       []*aaliflowkitgrpc.FunctionInputDefinition{},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	"GenerateUserPrompt": {
+		Name:        "GenerateUserPrompt",
+		DisplayName: "GenerateUserPrompt",
+		Description: "GenerateUserPrompt generates user instruction prompt based on the provided template.\n\nTags:\n  - @displayName: GenerateUserPrompt\n\nParameters:\n  - userInstruction: user instruction\n  - userPromptTemplate: user prompt template\n\nReturns:\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string"},
+			{Name: "userPromptTemplate", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"GenerateUserPromptWithContext": {
+		Name:        "GenerateUserPromptWithContext",
+		DisplayName: "GenerateUserPromptWithContext",
+		Description: "GenerateUserPromptWithContext generates user instruction prompt based on the provided template with instruction and context.\n\nTags:\n  - @displayName: GenerateUserPromptWithContext\n\nParameters:\n  - userInstruction: user instruction\n  - context: user context\n  - userPromptTemplate: user prompt template\n\nReturns:\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string"},
+			{Name: "context", Type: "string", GoType: "string"},
+			{Name: "userPromptTemplate", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"GenerateUserPromptWithList": {
+		Name:        "GenerateUserPromptWithList",
+		DisplayName: "GenerateUserPromptWithList",
+		Description: "GenerateUserPromptWithList generates user instruction prompt based on the provided template, instruction, list.\n\nTags:\n  - @displayName: GenerateUserPromptWithList\n\nParameters:\n  - userInstruction: user instruction\n  - userList: list of items to include in the prompt\n  - userPromptTemplate: user prompt template\n\nReturns:\n  - userPrompt: the user prompt\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInstruction", Type: "string", GoType: "string"},
+			{Name: "userList", Type: "json", GoType: "[]string"},
+			{Name: "userPromptTemplate", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "userPrompt", Type: "string", GoType: "string"},
+		},
+	},
+	"GetActionsFromConfig": {
+		Name:        "GetActionsFromConfig",
+		DisplayName: "GetActionsFromConfig",
+		Description: "FinalizeResult converts actions to json string to send back data\n\nTags:\n  - @displayName: GetActionsFromConfig\n\nParameters:\n  - toolName: tool name to create customize messages\n\nReturns:\n  - result: the actions in json format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "toolName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	"GetDocumentType": {
+		Name:        "GetDocumentType",
+		DisplayName: "Get Document Type",
+		Description: "GetDocumentType returns the document type of a file.\n\nTags:\n  - @displayName: Get Document Type\n\nParameters:\n  - filePath: path to file.\n\nReturns:\n  - documentType: file extension.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "filePath", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "documentType", Type: "string", GoType: "string"},
+		},
+	},
+	"GetGithubFilesToExtract": {
+		Name:        "GetGithubFilesToExtract",
+		DisplayName: "List Github Files",
+		Description: "GetGithubFilesToExtract gets all files from github that need to be extracted.\n\nTags:\n  - @displayName: List Github Files\n\nParameters:\n  - ctx: the request context.\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - githubAccessToken: access token for github.\n  - githubFileExtensions: github file extensions.\n  - githubFilteredDirectories: github filtered directories.\n  - githubExcludedDirectories: github excluded directories.\n\nReturns:\n  - githubFilesToExtract: github files to extract.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "githubRepoName", Type: "string", GoType: "string"},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string"},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string"},
+			{Name: "githubAccessToken", Type: "string", GoType: "string"},
+			{Name: "githubFileExtensions", Type: "json", GoType: "[]string"},
+			{Name: "githubFilteredDirectories", Type: "json", GoType: "[]string"},
+			{Name: "githubExcludedDirectories", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "githubFilesToExtract", Type: "json", GoType: "[]string"},
+		},
+	},
+	"GetListCollections": {
+		Name:        "GetListCollections",
+		DisplayName: "List Collections",
+		Description: "GetListCollections retrieves the list of collections from the KnowledgeDB.\n\nTags:\n  - @displayName: List Collections\n\nThe function returns the list of collections.\n\nParameters:\n  - ctx: the request context\n\nReturns:\n  - collectionsList: the list of collections\n",
+		Category:    "knowledge_db",
+		Input:       []*aaliflowkitgrpc.FunctionInputDefinition{},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "collectionsList", Type: "json", GoType: "[]string"},
+		},
+	},
+	"GetLocalFileContent": {
+		Name:        "GetLocalFileContent",
+		DisplayName: "Get Local File Content",
+		Description: "GetLocalFileContent reads local file and returns checksum and content.\n\nTags:\n  - @displayName: Get Local File Content\n\nParameters:\n  - localFilePath: path to file.\n\nReturns:\n  - checksum: checksum of file.\n  - content: content of file.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "localFilePath", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "checksum", Type: "string", GoType: "string"},
+			{Name: "content", Type: "json", GoType: "[]byte"},
+		},
+	},
+	"GetLocalFilesContent": {
+		Name:        "GetLocalFilesContent",
+		DisplayName: "Get Local Files Content",
+		Description: "GetLocalFilesContent reads local files and returns content.\n\nTags:\n  - @displayName: Get Local Files Content\n\nParameters:\n  - localFilePaths: paths to files.\n\nReturns:\n  - filesMap: map of file paths to file content.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "localFilePaths", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "filesMap", Type: "json", GoType: "map[string][]byte"},
+		},
+	},
+	"GetLocalFilesToExtract": {
+		Name:        "GetLocalFilesToExtract",
+		DisplayName: "List Local Files",
+		Description: "GetLocalFilesToExtract gets all files from local that need to be extracted.\n\nTags:\n  - @displayName: List Local Files\n\nParameters:\n  - localPath: path to the local directory.\n  - localFileExtensions: local file extensions.\n  - localFilteredDirectories: local filtered directories.\n  - localExcludedDirectories: local excluded directories.\n\nReturns:\n  - localFilesToExtract: local files to extract.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "localPath", Type: "string", GoType: "string"},
+			{Name: "localFileExtensions", Type: "json", GoType: "[]string"},
+			{Name: "localFilteredDirectories", Type: "json", GoType: "[]string"},
+			{Name: "localExcludedDirectories", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "localFilesToExtract", Type: "json", GoType: "[]string"},
+		},
+	},
+	"GetResource": {
+		Name:        "GetResource",
+		DisplayName: "Get MCP Resource",
+		Description: "GetResource retrieves a named resource from the MCP server.\n\nTags:\n  - @displayName: Get MCP Resource\n\nParameters:\n  - ctx: the request context\n  - serverURL: the WebSocket URL of the MCP server\n  - resourceName: the name of the resource to retrieve\n\nReturns:\n  - result: the retrieved resource as a map\n  - error: any error that occurred during the request\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string"},
+			{Name: "resourceName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "map[string]interface{}", Type: "json", GoType: "map[string]interface{}"},
+			{Name: "error", Type: "json", GoType: "error"},
+		},
+	},
+	"GetSelectedSolution": {
+		Name:        "GetSelectedSolution",
+		DisplayName: "GetSelectedSolution",
+		Description: "GetSelectedSolution get user selected solutions from the options provided\n\nTags:\n  - @displayName: GetSelectedSolution\n\nParameters:\n  - arguments: these are the arguments ReAct found based on user choice\n\nReturns:\n  - solution: the selected solution\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "arguments", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "solution", Type: "string", GoType: "string"},
+		},
+	},
+	"GetSolutionsToFixProblem": {
+		Name:        "GetSolutionsToFixProblem",
+		DisplayName: "GetSolutionsToFixProblem",
+		Description: "GetSolutionsToFixProblem do similarity search on path description\n\nTags:\n  - @displayName: GetSolutionsToFixProblem\n\nParameters:\n  - ctx: the request context.\n  - db_name: the name of the database\n  - fmFailureCode: FM failure Code\n  - primeMeshFailureCode: Prime Mesh Failure Code\n\nReturns:\n  - solutions: the list of solutions in json\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "db_name", Type: "string", GoType: "string"},
+			{Name: "fmFailureCode", Type: "string", GoType: "string"},
+			{Name: "primeMeshFailureCode", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "solutions", Type: "string", GoType: "string"},
+		},
+	},
+	"GetSystemPrompt": {
+		Name:        "GetSystemPrompt",
+		DisplayName: "Get MCP Prompt",
+		Description: "GetSystemPrompt retrieves a system prompt by name from the MCP server.\n\nTags:\n  - @displayName: Get MCP Prompt\n\nParameters:\n  - ctx: the request context\n  - serverURL: the WebSocket URL of the MCP server\n  - promptName: the name of the system prompt to retrieve\n\nReturns:\n  - promptStr: the text of the retrieved prompt\n  - error: any error that occurred during the request\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string"},
+			{Name: "promptName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+			{Name: "error", Type: "json", GoType: "error"},
+		},
+	},
+	"JsonPath": {
+		Name:        "JsonPath",
+		DisplayName: "JSON Path",
+		Description: "JsonPath extracts some data from an arbitrary data structure using a JSONPath pattern\n\nTags:\n  - @displayName: JSON Path\n\nParameters:\n  - pat: The JSON Path pattern\n  - data: The data to extract from\n  - oneResult: Whether you are expecting to extract 1 result or an array of results\n    If you set oneResult=true but there are not exactle 1 result in the output, you will\n    receive an error. This should only be set if the result is guaranteed to have length 1.\n\nReturns\n  - The extracted data. If oneResult=false, this will be an array of any.\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "pat", Type: "string", GoType: "string"},
+			{Name: "data", Type: "json", GoType: "any"},
+			{Name: "oneResult", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "any", Type: "json", GoType: "any"},
+		},
+	},
+	"LangchainSplitter": {
+		Name:        "LangchainSplitter",
+		DisplayName: "Split Content",
+		Description: "LangchainSplitter splits content into chunks using langchain.\n\nTags:\n  - @displayName: Split Content\n\nParameters:\n  - ctx: the request context.\n  - bytesContent: content to split.\n  - documentType: type of document.\n  - chunkSize: size of the chunks.\n  - chunkOverlap: overlap of the chunks.\n\nReturns:\n  - output: chunks as an slice of strings.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "bytesContent", Type: "json", GoType: "[]byte"},
+			{Name: "documentType", Type: "string", GoType: "string"},
+			{Name: "chunkSize", Type: "number", GoType: "int"},
+			{Name: "chunkOverlap", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "output", Type: "json", GoType: "[]string"},
+		},
+	},
+	"ListAll": {
+		Name:        "ListAll",
+		DisplayName: "List MCP Items",
+		Description: "ListAll retrieves all tools, resources, and prompts from the MCP server.\n\nTags:\n  - @displayName: List MCP Items\n\nParameters:\n  - ctx: the request context\n  - serverURL: the WebSocket URL of the MCP server\n\nReturns:\n  - result: a map with lists of tool/resource/prompt names categorized by type\n  - error: any error that occurred during the process\n",
+		Category:    "mcp",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "serverURL", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "map[string][]string", Type: "json", GoType: "map[string][]string"},
+			{Name: "error", Type: "json", GoType: "error"},
+		},
+	},
+	"LoadAndCheckExampleDependencies": {
+		Name:        "LoadAndCheckExampleDependencies",
+		DisplayName: "Load and Check Example Dependencies",
+		Description: "LoadAndCheckExampleDependencies loads and checks the dependencies of the examples.\n\nTags:\n  - @displayName: Load and Check Example Dependencies\n\nParameters:\n  - dependenciesContent: content of the dependencies file in []byte format.\n  - elements: code generation elements.\n  - instancesReplacementDict: dictionary of instances replacements.\n  - InstancesReplacementPriorityList: list of instances replacement priority.\n\nReturns:\n  - checkedDependenciesMap: checked dependencies.\n  - equivalencesMap: equivalences.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dependenciesContent", Type: "json", GoType: "[]byte"},
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement"},
+			{Name: "instancesReplacementDict", Type: "json", GoType: "map[string]string"},
+			{Name: "InstancesReplacementPriorityList", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "checkedDependenciesMap", Type: "json", GoType: "map[string][]string"},
+			{Name: "equivalencesMap", Type: "json", GoType: "map[string]map[string]string"},
+		},
+	},
+	"LoadCodeGenerationElements": {
+		Name:        "LoadCodeGenerationElements",
+		DisplayName: "Load Code Generation Elements",
+		Description: "LoadCodeGenerationElements loads code generation elements from an xml or json file.\n\nTags:\n  - @displayName: Load Code Generation Elements\n\nParameters:\n  - content: content of the file in []byte format.\n  - elementsFilePath: path to the file.\n\nReturns:\n  - elements: code generation elements.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "content", Type: "json", GoType: "[]byte"},
+			{Name: "elementsFilePath", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement"},
+		},
+	},
+	"LoadCodeGenerationExamples": {
+		Name:        "LoadCodeGenerationExamples",
+		DisplayName: "Load Code Generation Examples",
+		Description: "LoadCodeGenerationExamples loads code generation examples from the provided paths.\n\nTags:\n  - @displayName: Load Code Generation Examples\n\nParameters:\n  - ctx: the request context.\n  - source: source of the examples (local or github).\n  - examplesToExtract: paths to the examples.\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - githubAccessToken: access token for the github repository.\n  - dependencies: dependencies of the examples.\n  - equivalencesMap: equivalences of the examples.\n  - chunkSize: size of the chunks.\n  - chunkOverlap: overlap of the chunks.\n\nReturns:\n  - examples: code generation examples.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "source", Type: "string", GoType: "string"},
+			{Name: "examplesToExtract", Type: "json", GoType: "[]string"},
+			{Name: "githubRepoName", Type: "string", GoType: "string"},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string"},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string"},
+			{Name: "githubAccessToken", Type: "string", GoType: "string"},
+			{Name: "dependencies", Type: "json", GoType: "map[string][]string"},
+			{Name: "equivalencesMap", Type: "json", GoType: "map[string]map[string]string"},
+			{Name: "chunkSize", Type: "number", GoType: "int"},
+			{Name: "chunkOverlap", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "examples", Type: "json", GoType: "[]CodeGenerationExample"},
+		},
+	},
+	"LoadUserGuideSections": {
+		Name:        "LoadUserGuideSections",
+		DisplayName: "Load User Guide Sections",
+		Description: "LoadUserGuideSections loads user guide sections from the provided paths.\n\nTags:\n  - @displayName: Load User Guide Sections\n\nParameters:\n  - ctx: the request context.\n  - source: source of the sections (local or github).\n  - sectionFilePaths: paths to the sections.\n  - githubRepoName: name of the github repository.\n  - githubRepoOwner: owner of the github repository.\n  - githubRepoBranch: branch of the github repository.\n  - githubAccessToken: access token for the github repository.\n\nReturns:\n  - sections: user guide sections.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "source", Type: "string", GoType: "string"},
+			{Name: "sectionFilePaths", Type: "json", GoType: "[]string"},
+			{Name: "githubRepoName", Type: "string", GoType: "string"},
+			{Name: "githubRepoOwner", Type: "string", GoType: "string"},
+			{Name: "githubRepoBranch", Type: "string", GoType: "string"},
+			{Name: "githubAccessToken", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "sections", Type: "json", GoType: "[]CodeGenerationUserGuideSection"},
+		},
+	},
+	"LogRequestFailed": {
+		Name:        "LogRequestFailed",
+		DisplayName: "Log request failed",
+		Description: "LogRequestFailed writes a .Info log entry indicating that a request was not completed successfully.\n\nTags:\n  - @displayName: Log request failed\n\nParameters:\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"LogRequestFailedDebugWithMessage": {
+		Name:        "LogRequestFailedDebugWithMessage",
+		DisplayName: "Log request failed with message",
+		Description: "LogRequestFailedDebugWithMessage writes a .Debug log entry indicating that a request was not completed successfully with additional message.\n\nTags:\n  - @displayName: Log request failed with message\n\nParameters:\n  - msg1: the first part of the debug message\n  - msg2: the second part of the debug message\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "msg1", Type: "string", GoType: "string"},
+			{Name: "msg2", Type: "string", GoType: "string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"LogRequestSuccess": {
+		Name:        "LogRequestSuccess",
+		DisplayName: "Log request success",
+		Description: "LogRequestSuccess writes a .Info log entry indicating that a request was completed successfully.\n\nTags:\n  - @displayName: Log request success\n\nParameters:\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"MarkdownToHTML": {
+		Name:        "MarkdownToHTML",
+		DisplayName: "MarkdownToHTML",
+		Description: "MarkdownToHTML this function converts markdown to html\n\nTags:\n  - @displayName: MarkdownToHTML\n\nParameters:\n  - markdown: content in markdown format\n\nReturns:\n  - html: content in html format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "markdown", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "html", Type: "string", GoType: "string"},
+		},
+	},
+	"ParseHistory": {
+		Name:        "ParseHistory",
+		DisplayName: "ParseHistory",
+		Description: "ParseHistory this function parses history from json to map\n\nTags:\n  - @displayName: ParseHistory\n\nParameters:\n  - historyJson: history in json format\n\nReturns:\n  - history: the parsed history\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "historyJson", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "history", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	"ParseHistoryToHistoricMessages": {
+		Name:        "ParseHistoryToHistoricMessages",
+		DisplayName: "ParseHistoryToHistoricMessages",
+		Description: "ParseHistoryToHistoricMessages this function to convert chat history to historic messages\n\nTags:\n  - @displayName: ParseHistoryToHistoricMessages\n\nParameters:\n  - historyJson: chat history in json format\n\nReturns:\n  - history: the history in sharedtypes.HistoricMessage format\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "historyJson", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+		},
+	},
+	"ParseSlashCommand": {
+		Name:        "ParseSlashCommand",
+		DisplayName: "ParseSlashCommand",
+		Description: "ParseSlashCommand retrieves the Slash Input from the input string.\n\nTags:\n  - @displayName: ParseSlashCommand\n\nParameters:\n  - userInput: the input string containing the Slash Input message in JSON format\n\nReturns:\n  - slashCmd: the slash command if found, otherwise an empty string\n  - targetCmd: the target command if found, otherwise an empty string\n  - hasCmd: boolean indicating if a slash command or target command was found\n  - hasContext: boolean indicating if the input contains text besides the commands\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userInput", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "slashCmd", Type: "string", GoType: "string"},
+			{Name: "targetCmd", Type: "string", GoType: "string"},
+			{Name: "hasCmd", Type: "boolean", GoType: "bool"},
+			{Name: "hasContext", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"ParseSlashCommands": {
+		Name:        "ParseSlashCommands",
+		DisplayName: "Parse Slash Commands",
+		Description: "ParseSlashCommands parses slash commands from the input string and returns them as a map.\n\nTags:\n  - @displayName: Parse Slash Commands\n\nParameters:\n  - input: The input string containing slash commands.\n\nReturns:\n  - slashCommands: The parsed slash commands, each with its scope and command.\n    If no scope is provided, the scope will be 'global'.\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "slashCommands", Type: "json", GoType: "[]SlashCommand"},
+		},
+	},
+	"PerformBatchEmbeddingRequest": {
+		Name:        "PerformBatchEmbeddingRequest",
+		DisplayName: "Batch Embeddings",
+		Description: "PerformBatchEmbeddingRequest performs a batch vector embedding request to LLM\n\nTags:\n  - @displayName: Batch Embeddings\n\nParameters:\n  - ctx: the request context\n  - input: the input strings\n\nReturns:\n  - embeddedVectors: the embedded vectors in float32 format\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "embeddedVectors", Type: "json", GoType: "[][]float32"},
+		},
+	},
+	"PerformBatchHybridEmbeddingRequest": {
+		Name:        "PerformBatchHybridEmbeddingRequest",
+		DisplayName: "Batch Hybrid Embeddings",
+		Description: "PerformBatchHybridEmbeddingRequest performs a batch hybrid embedding request to LLM\nreturning the sparse and dense embeddings\n\nTags:\n  - @displayName: Batch Hybrid Embeddings\n\nParameters:\n  - ctx: the request context\n  - input: the input strings\n  - maxBatchSize: the maximum number of strings sent in a single request\n\nReturns:\n  - denseEmbeddings: the dense embeddings in float32 format\n  - sparseEmbeddings: the sparse embeddings in map format\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "json", GoType: "[]string"},
+			{Name: "maxBatchSize", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "denseEmbeddings", Type: "json", GoType: "[][]float32"},
+			{Name: "sparseEmbeddings", Type: "json", GoType: "[]map[uint]float32"},
+		},
+	},
+	"PerformCodeLLMRequest": {
+		Name:        "PerformCodeLLMRequest",
+		DisplayName: "Code LLM Request",
+		Description: "PerformCodeLLMRequest performs a code generation request to LLM\n\nTags:\n  - @displayName: Code LLM Request\n\nParameters:\n  - ctx: the request context\n  - input: the input string\n  - history: the conversation history\n  - isStream: the stream flag\n  - validateCode: the flag to indicate whether the code should be validated\n\nReturns:\n  - message: the generated code\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "validateCode", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformGeneralModelSpecificationRequest": {
+		Name:        "PerformGeneralModelSpecificationRequest",
+		DisplayName: "General LLM Request (Specified System Prompt)",
+		Description: "PerformGeneralModelSpecificationRequest performs a specified request to LLM with a configured model and Systemprompt.\n\nDeprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.\n\nTags:\n  - @displayName: General LLM Request (Specified System Prompt)\n  - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "systemPrompt", Type: "json", GoType: "map[string]string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformGeneralRequest": {
+		Name:        "PerformGeneralRequest",
+		DisplayName: "General LLM Request",
+		Description: "PerformGeneralRequest performs a general chat completion request to LLM\n\nDeprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.\n\nTags:\n  - @displayName: General LLM Request\n  - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the input string\n  - history: the conversation history\n  - isStream: the stream flag\n  - systemPrompt: the system prompt\n\nReturns:\n  - message: the generated message\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformGeneralRequestNoStreaming": {
+		Name:        "PerformGeneralRequestNoStreaming",
+		DisplayName: "General LLM Request (no streaming)",
+		Description: "PerformGeneralRequestNoStreaming performs a general chat completion request to LLM without streaming\n\nDeprecated: use PerformLLMRequest instead.\n\nTags:\n  - @displayName: General LLM Request (no streaming)\n  - @deprecated: use PerformLLMRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the input string\n  - history: the conversation history\n  - systemPrompt: the system prompt\n\nReturns:\n  - message: the generated message\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+		},
+	},
+	"PerformGeneralRequestSpecificModel": {
+		Name:        "PerformGeneralRequestSpecificModel",
+		DisplayName: "General LLM Request (Specific Models)",
+		Description: "PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model\n\nDeprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.\n\nTags:\n  - @displayName: General LLM Request (Specific Models)\n  - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptions": {
+		Name:        "PerformGeneralRequestSpecificModelAndModelOptions",
+		DisplayName: "General LLM Request (Specific Models & Model Options)",
+		Description: "PerformGeneralRequestSpecificModel performs a general request to LLM with a specific model\n\nDeprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.\n\nTags:\n  - @displayName: General LLM Request (Specific Models & Model Options)\n  - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs\n  - modelOptions: the model options\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+			{Name: "modelOptions", Type: "json", GoType: "ModelOptions"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput": {
+		Name:        "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput",
+		DisplayName: "General LLM Request (Specific Models, Model Options, No Stream, OpenAI Input & Output Token Output)",
+		Description: "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput performs a general request to LLM with a specific model\nand model options, and returns the token count using OpenAI token count model. Does not stream the response.\n\nDeprecated: use PerformLLMRequest instead.\n\nTags:\n  - @displayName: General LLM Request (Specific Models, Model Options, No Stream, OpenAI Input & Output Token Output)\n  - @deprecated: use PerformLLMRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs of the AI models to use\n  - modelOptions: the model options\n  - tokenCountModelName: the model name to use for token count\n\nReturns:\n  - message: the response message\n  - inputTokenCount: the input token count\n  - outputTokenCount: the output token count\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+			{Name: "modelOptions", Type: "json", GoType: "ModelOptions"},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "inputTokenCount", Type: "number", GoType: "int"},
+			{Name: "outputTokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput": {
+		Name:        "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput",
+		DisplayName: "General LLM Request (Specific Models, Model Options, No Stream, OpenAI Token Output)",
+		Description: "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model\nand model options, and returns the token count using OpenAI token count model. Does not stream the response.\n\nDeprecated: use PerformLLMRequest instead.\n\nTags:\n  - @displayName: General LLM Request (Specific Models, Model Options, No Stream, OpenAI Token Output)\n  - @deprecated: use PerformLLMRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs of the AI models to use\n  - modelOptions: the model options\n  - tokenCountModelName: the model name to use for token count\n\nReturns:\n  - message: the response message\n  - tokenCount: the token count\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+			{Name: "modelOptions", Type: "json", GoType: "ModelOptions"},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "tokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelModelOptionsAndImages": {
+		Name:        "PerformGeneralRequestSpecificModelModelOptionsAndImages",
+		DisplayName: "General LLM Request (Specific Models, Model Options & Images)",
+		Description: "PerformGeneralRequestSpecificModelModelOptionsAndImages performs a general request to LLM with a specific model including model options and images\n\nDeprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.\n\nTags:\n  - @displayName: General LLM Request (Specific Models, Model Options & Images)\n  - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs\n  - modelOptions: the model options\n  - images: the images to include in the request\n  - modelCategory: the model categories\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+			{Name: "modelOptions", Type: "json", GoType: "ModelOptions"},
+			{Name: "images", Type: "json", GoType: "[]string"},
+			{Name: "modelCategory", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput": {
+		Name:        "PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput",
+		DisplayName: "General LLM Request (Specific Models, No Stream, OpenAI Token Output)",
+		Description: "PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput performs a general request to LLM with a specific model\nand returns the token count using OpenAI token count model. Does not stream the response.\n\nDeprecated: use PerformLLMRequest instead.\n\nTags:\n  - @displayName: General LLM Request (Specific Models, No Stream, OpenAI Token Output)\n  - @deprecated: use PerformLLMRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - systemPrompt: the system prompt\n  - modelIds: the model IDs of the AI models to use\n  - tokenCountModelName: the model name to use for token count\n\nReturns:\n  - message: the response message\n  - tokenCount: the token count\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "tokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	"PerformGeneralRequestWithImages": {
+		Name:        "PerformGeneralRequestWithImages",
+		DisplayName: "General LLM Request (with Images)",
+		Description: "PerformGeneralRequestWithImages performs a general request to LLM with images\n\nDeprecated: use PerformLLMRequest or PerformLLMStreamRequest instead.\n\nTags:\n  - @displayName: General LLM Request (with Images)\n  - @deprecated: use PerformLLMRequest or PerformLLMStreamRequest instead\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - history: the conversation history\n  - isStream: the flag to indicate whether the response should be streamed\n  - systemPrompt: the system prompt\n  - images: the images\n\nReturns:\n  - message: the response message\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "isStream", Type: "boolean", GoType: "bool"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "images", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformKeywordExtractionRequest": {
+		Name:        "PerformKeywordExtractionRequest",
+		DisplayName: "Keyword Extraction",
+		Description: "PerformKeywordExtractionRequest performs a keywords extraction request to LLM\n\nTags:\n  - @displayName: Keyword Extraction\n\nParameters:\n  - ctx: the request context\n  - input: the input string\n  - maxKeywordsSearch: the maximum number of keywords to search for\n\nReturns:\n  - keywords: the keywords extracted from the input string as a slice of strings\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "maxKeywordsSearch", Type: "number", GoType: "uint32"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "keywords", Type: "json", GoType: "[]string"},
+		},
+	},
+	"PerformLLMRequest": {
+		Name:        "PerformLLMRequest",
+		DisplayName: "LLM Request",
+		Description: "PerformLLMRequest performs a general request to the LLM and waits for the complete response.\nThe options select the models, the model options, the images, the system prompt and the history of the request.\nThe input and output tokens are counted with the OpenAI tokenizer of options.tokenCountModelName, they are 0 without it.\n\nTags:\n  - @displayName: LLM Request\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - options: the options of the request\n\nReturns:\n  - message: the response message\n  - inputTokenCount: the input token count\n  - outputTokenCount: the output token count\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "options", Type: "json", GoType: "LLMRequestOptions"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "inputTokenCount", Type: "number", GoType: "int"},
+			{Name: "outputTokenCount", Type: "number", GoType: "int"},
+		},
+	},
+	"PerformLLMStreamRequest": {
+		Name:        "PerformLLMStreamRequest",
+		DisplayName: "LLM Request (Stream)",
+		Description: "PerformLLMStreamRequest performs a general request to the LLM and streams the response.\nThe options are the same as for PerformLLMRequest, the tokens are not counted.\n\nTags:\n  - @displayName: LLM Request (Stream)\n\nParameters:\n  - ctx: the request context\n  - input: the user input\n  - options: the options of the request\n\nReturns:\n  - stream: the stream channel\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "options", Type: "json", GoType: "LLMRequestOptions"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "stream", Type: "json", GoType: "*chan string"},
+		},
+	},
+	"PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput": {
+		Name:        "PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput",
+		DisplayName: "Multiple General LLM Requests (Specific Models, No Stream, Attribute Extraction, OpenAI Token Output)",
+		Description: "PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput performs multiple general LLM requests\nusing specific models, extracts structured attributes (criteria) from the responses, and returns the total token count\nusing the specified OpenAI token counting model. This version does not stream responses.\n\nTags:\n  - @displayName: Multiple General LLM Requests (Specific Models, No Stream, Attribute Extraction, OpenAI Token Output)\n\nParameters:\n  - ctx: the request context\n  - input: the user input string\n  - history: the conversation history for context\n  - systemPrompt: the system prompt to guide the LLM\n  - modelIds: the model IDs of the LLMs to query\n  - tokenCountModelName: the model name used for token count calculation\n  - n: number of parallel requests to perform\n  - temperature: the temperature setting for the LLM requests\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n  - userID: the ID of the user, logged with the token usage\n\nReturns:\n  - uniqueCriterion: a deduplicated list of extracted attributes (criteria) from all responses\n  - tokenCount: the total token count (input tokens × n + combined output tokens)\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "systemPrompt", Type: "string", GoType: "string"},
+			{Name: "modelIds", Type: "json", GoType: "[]string"},
+			{Name: "tokenCountModelName", Type: "string", GoType: "string"},
+			{Name: "n", Type: "number", GoType: "int"},
+			{Name: "temperature", Type: "number", GoType: "float64"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+			{Name: "userID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uniqueCriterion", Type: "json", GoType: "[]MaterialLlmCriterion"},
+			{Name: "tokenCount", Type: "number", GoType: "int"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"PerformSimilaritySearchForSubqueries": {
+		Name:        "PerformSimilaritySearchForSubqueries",
+		DisplayName: "PerformSimilaritySearchForSubqueries",
+		Description: "PerformSimilaritySearchForSubqueries performs similarity search for each sub-query and returns Q&A pairs\n\nTags:\n  - @displayName: PerformSimilaritySearchForSubqueries\n\nParameters:\n  - ctx: the request context\n  - subQueries: the list of expanded sub-queries\n  - collection: the vector database collection name\n  - similaritySearchResults: the number of similarity search results\n  - similaritySearchMinScore: the minimum similarity score threshold\n\nReturns:\n  - uniqueQAPairs: the unique Q&A pairs from similarity search results\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "subQueries", Type: "json", GoType: "[]string"},
+			{Name: "collection", Type: "string", GoType: "string"},
+			{Name: "similaritySearchResults", Type: "number", GoType: "int"},
+			{Name: "similaritySearchMinScore", Type: "number", GoType: "float64"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "uniqueQAPairs", Type: "json", GoType: "[]map[string]interface{}"},
+		},
+	},
+	"PerformSummaryRequest": {
+		Name:        "PerformSummaryRequest",
+		DisplayName: "Summary",
+		Description: "PerformSummaryRequest performs a summary request to LLM\n\nTags:\n  - @displayName: Summary\n\nParameters:\n  - ctx: the request context\n  - input: the input string\n\nReturns:\n  - summary: the summary extracted from the input string\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "summary", Type: "string", GoType: "string"},
+		},
+	},
+	"PerformVectorEmbeddingRequest": {
+		Name:        "PerformVectorEmbeddingRequest",
+		DisplayName: "Embeddings",
+		Description: "PerformVectorEmbeddingRequest performs a vector embedding request to LLM\n\nTags:N\n  - @displayName: Embeddings\n\nParameters:\n  - ctx: the request context\n  - input: the input string\n  - includeSparse: flag to include sparse vectors (false for dense-only, true for hybrid search)\n\nReturns:\n  - embeddedVector: the embedded vector in float32 format\n  - sparseVector: the sparse embedded vector as term_id->weight map (only when includeSparse=true)\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "includeSparse", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "embeddedVector", Type: "json", GoType: "[]float32"},
+			{Name: "sparseVector", Type: "json", GoType: "map[uint]float32"},
+		},
+	},
+	"PerformVectorEmbeddingRequestWithTokenLimitCatch": {
+		Name:        "PerformVectorEmbeddingRequestWithTokenLimitCatch",
+		DisplayName: "Embeddings with Token Limit Catch",
+		Description: "PerformVectorEmbeddingRequestWithTokenLimitCatch performs a vector embedding request to LLM\nand catches the token limit error message\n\nTags:\n  - @displayName: Embeddings with Token Limit Catch\n\nParameters:\n  - ctx: the request context\n  - input: the input string\n  - tokenLimitMessage: the message to return if the token limit is reached\n\nReturns:\n  - embeddedVector: the embedded vector in float32 format\n  - tokenLimitReached: true if the token limit is reached, false otherwise\n  - responseMessage: the token limit message if the token limit is reached, empty otherwise\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "input", Type: "string", GoType: "string"},
+			{Name: "tokenLimitMessage", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "embeddedVector", Type: "json", GoType: "[]float32"},
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+			{Name: "responseMessage", Type: "string", GoType: "string"},
+		},
+	},
+	"PrintFeedback": {
+		Name:        "PrintFeedback",
+		DisplayName: "Print Feedback",
+		Description: "PrintFeedback prints the feedback to the console in JSON format\n\nTags:\n  - @displayName: Print Feedback\n\nParameters:\n  - feedback: the feedback to print\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "feedback", Type: "json", GoType: "Feedback"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"ProcessJSONListOutput": {
+		Name:        "ProcessJSONListOutput",
+		DisplayName: "ProcessJSONListOutput",
+		Description: "ProcessJSONListOutput parses the response and returns the tags slice.\n\nTags:\n  - @displayName: ProcessJSONListOutput\n\nParameters:\n  - response: the JSON response string\n\nReturns:\n  - generatedList: the list of items extracted from the response\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "response", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "generatedList", Type: "json", GoType: "[]string"},
+		},
+	},
+	"ProcessMWWorkflowInfo": {
+		Name:        "ProcessMWWorkflowInfo",
+		DisplayName: "ProcessMWWorkflowInfo",
+		Description: "ProcessMWWorkflowInfo parses the mwWorkflowInfo string and logs its content.\n\nParameters:\n  - mwWorkflowInfo: the workflow information in string format\n\nReturns:\n  - None\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "mwWorkflowInfo", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"ProcessMainAgentOutput": {
+		Name:        "ProcessMainAgentOutput",
+		DisplayName: "ProcessMainAgentOutput",
+		Description: "ProcessMainAgentOutput this function process output of llm\n\nTags:\n  - @displayName: ProcessMainAgentOutput\n\nParameters:\n  - llmOutput: the llm output for main agent\n\nReturns:\n  - messageTo: send the message to this recipient\n  - message: message to send to the recipient\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "llmOutput", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "messageTo", Type: "string", GoType: "string"},
+			{Name: "message", Type: "string", GoType: "string"},
+		},
+	},
+	"ProcessSubworkflowIdentificationOutput": {
+		Name:        "ProcessSubworkflowIdentificationOutput",
+		DisplayName: "ProcessSubworkflowIdentificationOutput",
+		Description: "ProcessSubworkflowIdentificationOutput this function process output of llm\n\nTags:\n  - @displayName: ProcessSubworkflowIdentificationOutput\n\nParameters:\n  - llmOutput: the llm output for subworkflow identification\n\nReturns:\n  - status: status of processing\n  - workflowName: the identified subworkflow name\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "llmOutput", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "status", Type: "string", GoType: "string"},
+			{Name: "workflowName", Type: "string", GoType: "string"},
+		},
+	},
+	"QdrantCreateCollection": {
+		Name:        "QdrantCreateCollection",
+		DisplayName: "Create Qdrant Collection",
+		Description: "QdrantCreateCollection creates a collection in qdrant\n\nTags:\n  - @displayName: Create Qdrant Collection\n\nParameters:\n  - ctx: The request context\n  - collectionName: The name of the collection\n  - vectorSize: The size of the vectors stored in this collection\n  - vectorDistance: The distance metric to use of vector similarity search (cosine, dot, euclid, manhattan)\n",
+		Category:    "qdrant",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string"},
+			{Name: "vectorSize", Type: "number", GoType: "uint64"},
+			{Name: "vectorDistance", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"QdrantCreateIndex": {
+		Name:        "QdrantCreateIndex",
+		DisplayName: "Create Qdrant Index",
+		Description: "QdrantCreateIndex creates a field index on a qdrant collection\n\nTags:\n  - @displayName: Create Qdrant Index\n\nParameters:\n  - ctx: The request context\n  - collectionName: The name of the collection\n  - fieldName: The name of the payload field to create an index on\n  - fieldType: The qdrant type that the payload field is expected to be\n  - wait: Whether to wait for the index to be created or return immediately & continue indexing in background\n",
+		Category:    "qdrant",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string"},
+			{Name: "fieldName", Type: "string", GoType: "string"},
+			{Name: "fieldType", Type: "string", GoType: "string"},
+			{Name: "wait", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"QdrantInsertData": {
+		Name:        "QdrantInsertData",
+		DisplayName: "Insert Data into Qdrant",
+		Description: "QdrantInsertData inserts data into a collection in qdrant\n\nTags:\n  - @displayName: Insert Data into Qdrant\n\nParameters:\n  - ctx: The request context\n  - collectionName: The name of the collection\n  - data: The data points to insert (func will fail if elements are not `map[string]any`)\n  - idFieldName: The name of the field to use as the ID\n  - vectorFieldName: The name of the field to use as the vector\n",
+		Category:    "qdrant",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string"},
+			{Name: "data", Type: "json", GoType: "[]interface{}"},
+			{Name: "idFieldName", Type: "string", GoType: "string"},
+			{Name: "vectorFieldName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"ResetTokenCountIfNewMonth": {
+		Name:        "ResetTokenCountIfNewMonth",
+		DisplayName: "Reset Token Count if new month",
+		Description: "ResetTokenCountIfNewMonth resets the token count for a customer if the last update was from a different month or year.\nFor new customers (LastUpdated = 0), it sets the initial timestamp without resetting tokens.\n\nTags:\n  - @displayName: Reset Token Count if new month\n\nParameters:\n  - ctx: the request context\n  - kvdbEndpoint: the KVDB endpoint\n  - apiKey: The API key of the customer\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "kvdbEndpoint", Type: "string", GoType: "string"},
+			{Name: "apiKey", Type: "string", GoType: "string"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"RetrieveDependencies": {
+		Name:        "RetrieveDependencies",
+		DisplayName: "Retrieve Dependencies",
+		Description: "RetrieveDependencies retrieves the dependencies of the specified source node.\n\nThe function returns the list of dependencies.\n\nTags:\n  - @displayName: Retrieve Dependencies\n\nParameters:\n  - ctx: the request context.\n  - dbname: the name of the graphdb to target. If not provided, defaults to \"aali\".\n  - relationshipName: the name of the relationship to retrieve dependencies for.\n  - relationshipDirection: the direction of the relationship to retrieve dependencies for.\n  - sourceDocumentId: the document ID of the source node.\n  - nodeTypesFilter: filter based on node types.\n  - maxHopsNumber: maximum number of hops to traverse.\n\nReturns:\n  - dependenciesIds: the list of dependencies\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dbname", Type: "string", GoType: "string"},
+			{Name: "relationshipName", Type: "string", GoType: "string"},
+			{Name: "relationshipDirection", Type: "string", GoType: "string"},
+			{Name: "sourceDocumentId", Type: "string", GoType: "string"},
+			{Name: "nodeTypesFilter", Type: "json", GoType: "DbArrayFilter"},
+			{Name: "maxHopsNumber", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "dependenciesIds", Type: "json", GoType: "[]string"},
+		},
+	},
+	"SelectedSolution": {
+		Name:        "SelectedSolution",
+		DisplayName: "SelectedSolution",
+		Description: "SelectedSolution this function parse the selected solution\n\nTags:\n  - @displayName: SelectedSolution\n\nParameters:\n  - selectedSolution: selected solution found by the LLM\n\nReturns:\n  - solution: parsed solution\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "selectedSolution", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "solution", Type: "string", GoType: "string"},
+		},
+	},
+	"SendLogicAppNotificationEmail": {
+		Name:        "SendLogicAppNotificationEmail",
+		DisplayName: "Send Email Notification",
+		Description: "SendLogicAppNotificationEmail sends a POST request to the email service.\n\nTags:\n  - @displayName: Send Email Notification\n\nParameters:\n  - ctx: the request context.\n  - logicAppEndpoint: The email service endpoint.\n  - email: The email address.\n  - subject: The email subject.\n  - content: The email content.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "logicAppEndpoint", Type: "string", GoType: "string"},
+			{Name: "email", Type: "string", GoType: "string"},
+			{Name: "subject", Type: "string", GoType: "string"},
+			{Name: "content", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"SendLogicAppNotificationEmailToMultipleEmails": {
+		Name:        "SendLogicAppNotificationEmailToMultipleEmails",
+		DisplayName: "Send Email to multiple email addresses",
+		Description: "SendLogicAppNotificationEmailToMultipleEmails sends the same email to multiple email addresses\n\nTags:\n  - @displayName: Send Email to multiple email addresses\n\nParameters:\n  - ctx: the request context\n  - logicAppEndpoint: The Logic App email service endpoint\n  - emails: Array of email addresses to send to\n  - subject: The email subject\n  - content: The email content\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "logicAppEndpoint", Type: "string", GoType: "string"},
+			{Name: "emails", Type: "json", GoType: "[]string"},
+			{Name: "subject", Type: "string", GoType: "string"},
+			{Name: "content", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"SendRestAPICall": {
+		Name:        "SendRestAPICall",
+		DisplayName: "REST Call",
+		Description: "SendAPICall sends an API call to the specified URL with the specified headers and query parameters.\n\nTags:\n  - @displayName: REST Call\n\nParameters:\n  - ctx: the request context\n  - requestType: the type of the request (GET, POST, PUT, PATCH, DELETE)\n  - endpoint: the URL to send the request to\n  - header: the headers to include in the request\n  - query: the query parameters to include in the request\n  - jsonBody: the body of the request as a JSON string\n\nReturns:\n  - success: a boolean indicating whether the request was successful\n  - returnJsonBody: the JSON body of the response as a string\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "requestType", Type: "string", GoType: "string"},
+			{Name: "endpoint", Type: "string", GoType: "string"},
+			{Name: "header", Type: "json", GoType: "map[string]string"},
+			{Name: "query", Type: "json", GoType: "map[string]string"},
+			{Name: "jsonBody", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "success", Type: "boolean", GoType: "bool"},
+			{Name: "returnJsonBody", Type: "string", GoType: "string"},
+		},
+	},
+	"SendVectorsToKnowledgeDB": {
+		Name:        "SendVectorsToKnowledgeDB",
+		DisplayName: "Similarity Search",
+		Description: "SendVectorsToKnowledgeDB sends the given vector to the KnowledgeDB and returns the most relevant data\n\nTags:\n  - @displayName: Similarity Search\n\nParameters:\n  - ctx: the request context\n  - vector: the vector to be sent to the KnowledgeDB\n  - keywords: the keywords to be used to filter the results\n  - keywordsSearch: the flag to enable the keywords search\n  - collection: the collection name\n  - similaritySearchResults: the number of results to be returned\n  - similaritySearchMinScore: the minimum score for the results\n  - sparseVector: optional sparse vector for hybrid search (pass empty map for dense-only search)\n\nReturns:\n  - databaseResponse: an array of the most relevant data\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "vector", Type: "json", GoType: "[]float32"},
+			{Name: "keywords", Type: "json", GoType: "[]string"},
+			{Name: "keywordsSearch", Type: "boolean", GoType: "bool"},
+			{Name: "collection", Type: "string", GoType: "string"},
+			{Name: "similaritySearchResults", Type: "number", GoType: "int"},
+			{Name: "similaritySearchMinScore", Type: "number", GoType: "float64"},
+			{Name: "sparseVector", Type: "json", GoType: "map[uint]float32"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+	},
+	"SerializeResponse": {
+		Name:        "SerializeResponse",
+		DisplayName: "Serialize response for clients",
+		Description: "SerializeResponse formats the criteria to a response suitable for the UI clients in string format\n\nTags:\n  - @displayName: Serialize response for clients\n\nParameters:\n  - criteriaSuggestions: the suggested criteria to serialize\n  - tokens: tokens consumed by the request\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - result: string representation of the response in JSON format\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "criteriaSuggestions", Type: "json", GoType: "[]MaterialCriterionWithGuid"},
+			{Name: "tokens", Type: "number", GoType: "int"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"SetCopilotGenerateRequestJsonBody": {
+		Name:        "SetCopilotGenerateRequestJsonBody",
+		DisplayName: "Copilot Generate Request JSON Body",
+		Description: "SetCopilotGenerateRequestJsonBody creates a JSON body for the generate request to RHSC Copilot.\nIt takes various parameters to configure the request and returns the JSON string.\n\nTags:\n  - @displayName: Copilot Generate Request JSON Body\n\nParameters:\n  - query: the query string for the request.\n  - sessionID: the session ID for the request.\n  - mode: the mode of operation for the request.\n  - timeout: the timeout for the request in seconds.\n  - priority: the priority of the request.\n  - agentPreference: the preferred agent for the request.\n  - saveIntermediate: whether to save intermediate results.\n  - similarityTopK: the number of top similar results to consider.\n  - noCritique: whether to disable critique.\n  - maxIterations: the maximum number of iterations for the request.\n  - forceAzure: whether to force the use of Azure for the request.\n\nReturns:\n  - jsonBody: the JSON body of the request.\n",
+		Category:    "rhsc",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "query", Type: "string", GoType: "string"},
+			{Name: "sessionID", Type: "string", GoType: "string"},
+			{Name: "mode", Type: "string", GoType: "string"},
+			{Name: "timeout", Type: "number", GoType: "int"},
+			{Name: "priority", Type: "number", GoType: "int"},
+			{Name: "agentPreference", Type: "string", GoType: "string"},
+			{Name: "saveIntermediate", Type: "boolean", GoType: "bool"},
+			{Name: "similarityTopK", Type: "number", GoType: "int"},
+			{Name: "noCritique", Type: "boolean", GoType: "bool"},
+			{Name: "maxIterations", Type: "number", GoType: "int"},
+			{Name: "forceAzure", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "jsonBody", Type: "string", GoType: "string"},
+		},
+	},
+	"ShortenMessageHistory": {
+		Name:        "ShortenMessageHistory",
+		DisplayName: "Shorten History",
+		Description: "ShortenMessageHistory shortens the conversation history to a maximum length.\nIt will retain only the most recent messages and older messages will be\nremoved.\n\nTags:\n  - @displayName: Shorten History\n\nParameters:\n  - history: the conversation history\n  - maxLength: the maximum length of the conversation history\n\nReturns:\n  - updatedHistory: the updated conversation history\n",
+		Category:    "llm_handler",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "history", Type: "json", GoType: "[]HistoricMessage"},
+			{Name: "maxLength", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedHistory", Type: "json", GoType: "[]HistoricMessage"},
+		},
+	},
+	"SimilaritySearch": {
+		Name:        "SimilaritySearch",
+		DisplayName: "Similarity Search (Filtered)",
+		Description: "SimilaritySearch performs a similarity search in the KnowledgeDB.\n\nThe function returns the similarity search results.\n\nTags:\n  - @displayName: Similarity Search (Filtered)\n\nParameters:\n  - ctx: the request context.\n  - collectionName: the name of the collection to which the data objects will be added.\n  - embeddedVector: the embedded vector used for searching.\n  - maxRetrievalCount: the maximum number of results to be retrieved.\n  - filters: the filter for the query.\n  - minScore: the minimum score filter.\n  - getLeafNodes: flag to indicate whether to retrieve all the leaf nodes in the result node branch.\n  - getSiblings: flag to indicate whether to retrieve the previous and next node to the result nodes.\n  - getParent: flag to indicate whether to retrieve the parent object.\n  - getChildren: flag to indicate whether to retrieve the children objects.\n\nReturns:\n  - databaseResponse: the similarity search results\n",
+		Category:    "knowledge_db",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "collectionName", Type: "string", GoType: "string"},
+			{Name: "embeddedVector", Type: "json", GoType: "[]float32"},
+			{Name: "maxRetrievalCount", Type: "number", GoType: "int"},
+			{Name: "filters", Type: "json", GoType: "DbFilters"},
+			{Name: "minScore", Type: "number", GoType: "float64"},
+			{Name: "getLeafNodes", Type: "boolean", GoType: "bool"},
+			{Name: "getSiblings", Type: "boolean", GoType: "bool"},
+			{Name: "getParent", Type: "boolean", GoType: "bool"},
+			{Name: "getChildren", Type: "boolean", GoType: "bool"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "databaseResponse", Type: "json", GoType: "[]DbResponse"},
+		},
+	},
+	"SimilartitySearchOnPathDescriptions": {
+		Name:        "SimilartitySearchOnPathDescriptions",
+		DisplayName: "SimilartitySearchOnPathDescriptions",
+		Description: "SimilartitySearchOnPathDescriptions do similarity search on path description\n\nTags:\n  - @displayName: SimilartitySearchOnPathDescriptions\n\nParameters:\n  - ctx: the request context\n  - instruction: the user query\n  - toolName: the tool name\n\nReturns:\n  - descriptions: the list of descriptions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "instruction", Type: "string", GoType: "string"},
+			{Name: "toolName", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "descriptions", Type: "json", GoType: "[]string"},
+		},
+	},
+	"SimilartitySearchOnPathDescriptionsQdrant": {
+		Name:        "SimilartitySearchOnPathDescriptionsQdrant",
+		DisplayName: "SimilartitySearchOnPathDescriptions (Qdrant)",
+		Description: "SimilartitySearchOnPathDescriptions (Qdrant) do similarity search on path description\n\nTags:\n  - @displayName: SimilartitySearchOnPathDescriptions (Qdrant)\n\nParameters:\n  - ctx: the request context\n  - vector: the embedded user query\n  - collection: the collection to search in\n  - similaritySearchResults: the number of results to return\n  - similaritySearchMinScore: the minimum score of the results\n\nReturns:\n  - descriptions: the list of descriptions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "vector", Type: "json", GoType: "[]float32"},
+			{Name: "collection", Type: "string", GoType: "string"},
+			{Name: "similaritySearchResults", Type: "number", GoType: "int"},
+			{Name: "similaritySearchMinScore", Type: "number", GoType: "float64"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "descriptions", Type: "json", GoType: "[]string"},
+		},
+	},
+	"StartTrace": {
+		Name:        "StartTrace",
+		DisplayName: "Start new trace",
+		Description: "StartTrace generates a new trace ID and span ID for tracing\n\nTags:\n  - @displayName: Start new trace\n\nReturns:\n  - traceID: a 128-bit trace ID in decimal format\n  - spanID: a 64-bit span ID in decimal format\n",
+		Category:    "ansys_materials",
+		Input:       []*aaliflowkitgrpc.FunctionInputDefinition{},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+	},
+	"StoreElementsInGraphDatabase": {
+		Name:        "StoreElementsInGraphDatabase",
+		DisplayName: "Store Elements in Graph Database",
+		Description: "StoreElementsInGraphDatabase stores elements in the graph database.\n\nTags:\n  - @displayName: Store Elements in Graph Database\n\nParameters:\n  - ctx: the request context.\n  - dbname: the name of the graphdb to target. If not provided, defaults to \"aali\".\n  - elements: code generation elements.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dbname", Type: "string", GoType: "string"},
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"StoreElementsInVectorDatabase": {
+		Name:        "StoreElementsInVectorDatabase",
+		DisplayName: "Store Elements in Vector Database",
+		Description: "StoreElementsInVectorDatabase stores elements in the vector database.\n\nTags:\n  - @displayName: Store Elements in Vector Database\n\nParameters:\n  - ctx: the request context.\n  - elements: code generation elements.\n  - elementsCollectionName: name of the collection.\n  - batchSize: batch size for embeddings.\n  - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "elements", Type: "json", GoType: "[]CodeGenerationElement"},
+			{Name: "elementsCollectionName", Type: "string", GoType: "string"},
+			{Name: "batchSize", Type: "number", GoType: "int"},
+			{Name: "vectorDistance", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"StoreExamplesInGraphDatabase": {
+		Name:        "StoreExamplesInGraphDatabase",
+		DisplayName: "Store Examples in Graph Database",
+		Description: "StoreExamplesInGraphDatabase stores examples in the graph database.\n\nTags:\n  - @displayName: Store Examples in Graph Database\n\nParameters:\n  - ctx: the request context.\n  - dbname: the name of the graphdb to target. If not provided, defaults to \"aali\".\n  - examples: code generation examples.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dbname", Type: "string", GoType: "string"},
+			{Name: "examples", Type: "json", GoType: "[]CodeGenerationExample"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"StoreExamplesInVectorDatabase": {
+		Name:        "StoreExamplesInVectorDatabase",
+		DisplayName: "Store Examples in Vector Database",
+		Description: "StoreExamplesInVectorDatabase stores examples in the vector database.\n\nTags:\n  - @displayName: Store Examples in Vector Database\n\nParameters:\n  - ctx: the request context.\n  - examples: code generation examples.\n  - examplesCollectionName: name of the collection.\n  - batchSize: batch size for embeddings.\n  - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "examples", Type: "json", GoType: "[]CodeGenerationExample"},
+			{Name: "examplesCollectionName", Type: "string", GoType: "string"},
+			{Name: "batchSize", Type: "number", GoType: "int"},
+			{Name: "vectorDistance", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"StoreUserGuideSectionsInGraphDatabase": {
+		Name:        "StoreUserGuideSectionsInGraphDatabase",
+		DisplayName: "Store User Guide Sections in Graph Database",
+		Description: "StoreUserGuideSectionsInGraphDatabase stores user guide sections in the graph database.\n\nTags:\n  - @displayName: Store User Guide Sections in Graph Database\n\nParameters:\n  - ctx: the request context.\n  - dbname: the name of the graphdb to target. If not provided, defaults to \"aali\".\n  - sections: user guide sections.\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "dbname", Type: "string", GoType: "string"},
+			{Name: "sections", Type: "json", GoType: "[]CodeGenerationUserGuideSection"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"StoreUserGuideSectionsInVectorDatabase": {
+		Name:        "StoreUserGuideSectionsInVectorDatabase",
+		DisplayName: "Store User Guide Sections in Vector Database",
+		Description: "StoreUserGuideSectionsInVectorDatabase stores user guide sections in the vector database.\n\nTags:\n  - @displayName: Store User Guide Sections in Vector Database\n\nParameters:\n  - ctx: the request context.\n  - sections: user guide sections.\n  - userGuideCollectionName: name of the collection.\n  - batchSize: batch size for embeddings.\n  - chunkSize: size of the chunks.\n  - chunkOverlap: overlap of the chunks.\n  - vectorDistance: the distance metric to use for the vector index (cosine, dot, euclid, manhattan)\n",
+		Category:    "data_extraction",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "sections", Type: "json", GoType: "[]CodeGenerationUserGuideSection"},
+			{Name: "userGuideCollectionName", Type: "string", GoType: "string"},
+			{Name: "batchSize", Type: "number", GoType: "int"},
+			{Name: "chunkSize", Type: "number", GoType: "int"},
+			{Name: "chunkOverlap", Type: "number", GoType: "int"},
+			{Name: "vectorDistance", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{},
+	},
+	"StringConcat": {
+		Name:        "StringConcat",
+		DisplayName: "Concatenate Strings",
+		Description: "StringConcat concatenates 2 strings together, with an optional separator.\n\nTags:\n  - @displayName: Concatenate Strings\n\nParameters:\n  - a: the first string\n  - b: the second string\n  - separator: the separator string. If not provided, will be an empty string.\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "a", Type: "string", GoType: "string"},
+			{Name: "b", Type: "string", GoType: "string"},
+			{Name: "separator", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	"StringFormat": {
+		Name:        "StringFormat",
+		DisplayName: "Format data as string",
+		Description: "StringFormat formats any data as a string.\n\nUse this to turn non-string data into a string representation. This uses go's `fmt.Sprintf` under the hood.\n\nTags:\n  - @displayName: Format data as string\n\nParameters:\n  - data: the data to format as a string\n  - format: the format specifier to use. If not provided will default to \"%v\".\n    See the [go fmt docs](https://pkg.go.dev/fmt) for details.\n",
+		Category:    "generic",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "data", Type: "json", GoType: "any"},
+			{Name: "format", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "string", Type: "string", GoType: "string"},
+		},
+	},
+	"SynthesizeActions": {
+		Name:        "SynthesizeActions",
+		DisplayName: "SynthesizeActions",
+		Description: "SynthesizeActions update action as per user instruction\n\nTags:\n  - @displayName: SynthesizeActions\n\nParameters:\n  - message: the message from the llm\n  - properties: the list of properties\n  - actions: the list of actions\n\nReturns:\n  - updatedActions: the list of synthesized actions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "properties", Type: "json", GoType: "[]string"},
+			{Name: "actions", Type: "json", GoType: "[]map[string]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedActions", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	"SynthesizeActionsTool11": {
+		Name:        "SynthesizeActionsTool11",
+		DisplayName: "SynthesizeActionsTool11",
+		Description: "SynthesizeActionsTool11 synthesize actions based on user instruction\n\nTags:\n  - @displayName: SynthesizeActionsTool11\n\nParameters:\n  - content: the llm content\n\nReturns:\n  - result: the synthesized string\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "content", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	"SynthesizeActionsTool12": {
+		Name:        "SynthesizeActionsTool12",
+		DisplayName: "SynthesizeActionsTool12",
+		Description: "SynthesizeActionsTool12 synthesize actions based on user instruction\n\nTags:\n  - @displayName: SynthesizeActionsTool12\n\nParameters:\n  - content: the llm content\n\nReturns:\n  - result: the synthesized string\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "content", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	"SynthesizeActionsTool17": {
+		Name:        "SynthesizeActionsTool17",
+		DisplayName: "SynthesizeActionsTool17",
+		Description: "SynthesizeActionsTool17 synthesize actions based on user instruction\n\nTags:\n  - @displayName: SynthesizeActionsTool17\n\nParameters:\n  - content: the llm content\n\nReturns:\n  - result: the synthesized string\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "content", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	"SynthesizeActionsTool2": {
+		Name:        "SynthesizeActionsTool2",
+		DisplayName: "SynthesizeActionsTool2",
+		Description: "SynthesizeActionsTool2 update action as per user instruction\n\nTags:\n  - @displayName: SynthesizeActionsTool2\n\nParameters:\n  - message: the message from the llm\n  - actions: the list of actions\n\nReturns:\n  - updatedActions: the list of synthesized actions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message", Type: "string", GoType: "string"},
+			{Name: "actions", Type: "json", GoType: "[]map[string]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedActions", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	"SynthesizeActionsTool3": {
+		Name:        "SynthesizeActionsTool3",
+		DisplayName: "SynthesizeActionsTool3",
+		Description: "SynthesizeActionsTool3 update action as per user instruction\n// Tags:\n  - @displayName: SynthesizeActionsTool3\n\nParameters:\n  - message_1: the first message from the llm\n  - message_2: the second message from the llm\n  - target_object: the target object of the actions\n  - actions: the list of actions\n\nReturns:\n  - updatedActions: the list of synthesized actions\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "message_1", Type: "string", GoType: "string"},
+			{Name: "message_2", Type: "string", GoType: "string"},
+			{Name: "target_object", Type: "string", GoType: "string"},
+			{Name: "actions", Type: "json", GoType: "[]map[string]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "updatedActions", Type: "json", GoType: "[]map[string]string"},
+		},
+	},
+	"SynthesizeSlashCommand": {
+		Name:        "SynthesizeSlashCommand",
+		DisplayName: "SynthesizeSlashCommand",
+		Description: "SynthesizeSlashCommand synthesize actions based on user instruction\n\nTags:\n  - @displayName: SynthesizeSlashCommand\n\nParameters:\n  - slashCmd: the slash command\n  - targetCmd: the target command\n  - finalizeResult: the finalize result from previous step\n\nReturns:\n  - result: the synthesized string\n",
+		Category:    "ansys_mesh_pilot",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "slashCmd", Type: "string", GoType: "string"},
+			{Name: "targetCmd", Type: "string", GoType: "string"},
+			{Name: "finalizeResult", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "result", Type: "string", GoType: "string"},
+		},
+	},
+	"UpdateTotalTokenCountForCustomerKvDb": {
+		Name:        "UpdateTotalTokenCountForCustomerKvDb",
+		DisplayName: "Update Customer Token Count",
+		Description: "UpdateTotalTokenCountForCustomerKvDb updates the total token count for a customer in the KVDB\n\nTags:\n  - @displayName: Update Customer Token Count\n\nParameters:\n  - ctx: the request context\n  - kvdbEndpoint: the KVDB endpoint\n  - apiKey: The API key of the customer\n  - additionalTokenCount: The number of tokens to add to the customer's total token count\n  - traceID: the trace ID in decimal format\n  - spanID: the span ID in decimal format\n\nReturns:\n  - tokenLimitReached: true if the new total token count exceeds the customer's token limit, false otherwise\n  - childSpanID: the child span ID created for this operation\n",
+		Category:    "ansys_materials",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "kvdbEndpoint", Type: "string", GoType: "string"},
+			{Name: "apiKey", Type: "string", GoType: "string"},
+			{Name: "additionalTokenCount", Type: "number", GoType: "int"},
+			{Name: "traceID", Type: "string", GoType: "string"},
+			{Name: "spanID", Type: "string", GoType: "string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+			{Name: "childSpanID", Type: "string", GoType: "string"},
+		},
+	},
+	"UpdateTotalTokenCountForCustomerMongoDb": {
+		Name:        "UpdateTotalTokenCountForCustomerMongoDb",
+		DisplayName: "Update Total Token Count",
+		Description: "UpdateTotalTokenCountForCustomerMongoDb updates the total token count for the given customer in the MongoDB database.\n\nTags:\n  - @displayName: Update Total Token Count\n\nParameters:\n  - ctx: the request context.\n  - apiKey: The API key of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n  - additionalTokenCount: The number of additional tokens to add to the total token count.\n\nReturns:\n  - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "apiKey", Type: "string", GoType: "string"},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string"},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string"},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string"},
+			{Name: "additionalTokenCount", Type: "number", GoType: "int"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+		},
+	},
+	"UpdateTotalTokenCountForUserIdMongoDb": {
+		Name:        "UpdateTotalTokenCountForUserIdMongoDb",
+		DisplayName: "Update Total Token Count by User ID",
+		Description: "UpdateTotalTokenCountForUserIdMongoDb updates the total token count for the given user ID in the MongoDB database.\n\nTags:\n  - @displayName: Update Total Token Count by User ID\n\nParameters:\n  - ctx: the request context.\n  - userId: The user ID of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - userId: The user ID of the customer.\n  - mongoDbUrl: The URL of the MongoDB database.\n  - mongoDatabaseName: The name of the MongoDB database.\n  - mongoDbCollectionName: The name of the MongoDB collection.\n  - additionalInputTokenCount: The number of input tokens to add to the token count.\n  - additionalOutputTokenCount: The number of output tokens to add to the token count.\n  - hoursUntilTokenLimitReset: The number of hours until the token limit is reset.\n  - modelId: The IDs of the models the tokens were used for.\n\nReturns:\n  - tokenLimitReached: A boolean indicating whether the customer has reached the token limit.\n",
+		Category:    "auth",
+		Input: []*aaliflowkitgrpc.FunctionInputDefinition{
+			{Name: "userId", Type: "string", GoType: "string"},
+			{Name: "mongoDbUrl", Type: "string", GoType: "string"},
+			{Name: "mongoDatabaseName", Type: "string", GoType: "string"},
+			{Name: "mongoDbCollectionName", Type: "string", GoType: "string"},
+			{Name: "additionalInputTokenCount", Type: "number", GoType: "int"},
+			{Name: "additionalOutputTokenCount", Type: "number", GoType: "int"},
+			{Name: "hoursUntilTokenLimitReset", Type: "number", GoType: "int"},
+			{Name: "modelId", Type: "json", GoType: "[]string"},
+		},
+		Output: []*aaliflowkitgrpc.FunctionOutputDefinition{
+			{Name: "tokenLimitReached", Type: "boolean", GoType: "bool"},
+		},
+	},
+}
+
+// FunctionsMetadata holds the documentation of every function in ExternalFunctionsMap that does not fit in its definition
+var FunctionsMetadata = map[string]FunctionMetadata{
+	"AddAvailableAttributesToSystemPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "userDesignRequirements", Description: "design requirements provided by the user"},
+			{Name: "systemPromptTemplate", Description: "the prompt template string to modify"},
+			{Name: "allAvailableAttributes", Description: "the list of all available attributes"},
+			{Name: "availableSearchCriteria", Description: "the list of available search criteria (GUIDs)"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "fullSystemPrompt", Description: "the full system prompt to send to the LLM, including available attributes"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"AddDataRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "collectionName", Description: "name of the collection the request is sent to."},
+			{Name: "documentData", Description: "the data to add."},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"AddGraphDbParameter": {
+		Inputs: []ParameterMetadata{
+			{Name: "parameters", Description: "the existing collection of parameters"},
+			{Name: "name", Description: "the name of the new parameter"},
+			{Name: "value", Description: "the value of the new parameter"},
+			{Name: "paramType", Description: "the type of the new parameter"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "ParameterMap"},
+		},
+	},
+	"AddGuidsToAttributes": {
+		Inputs: []ParameterMetadata{
+			{Name: "criteriaSuggestions", Description: "the list of criteria without identities"},
+			{Name: "availableAttributes", Description: "the list of available attributes with their identities"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "criteriaWithGuids", Description: "the list of criteria with their identities"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"AecGetContextFromRetrieverModule": {
+		Inputs: []ParameterMetadata{
+			{Name: "retrieverModuleEndpoint", Description: "the endpoint of the retriever module"},
+			{Name: "userQuery", Description: "the user query"},
+			{Name: "dataSources", Description: "the data sources"},
+			{Name: "physics", Description: "the physics"},
+			{Name: "version", Description: "the versions to filter the results by"},
+			{Name: "product", Description: "the products to filter the results by"},
+			{Name: "topK", Description: "the number of results to be returned"},
+			{Name: "plattform", Description: "the platform"},
+			{Name: "retrieverModuleKey", Description: "the key for the retriever module"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "context", Description: "the context retrieved from the retriever module"},
+		},
+	},
+	"AecPerformLLMFinalRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "systemTemplate", Description: "the system template for the final request"},
+			{Name: "userTemplate", Description: "the user template for the final request"},
+			{Name: "query", Description: "the user query"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "context", Description: "the context retrieved from the retriever module"},
+			{Name: "prohibitedWords", Description: "the list of prohibited words"},
+			{Name: "errorList1", Description: "the list of error words"},
+			{Name: "errorList2", Description: "the list of error words"},
+			{Name: "tokenCountEndpoint", Description: "the endpoint to send the token count to"},
+			{Name: "previousInputTokenCount", Description: "the input token count of the previous requests"},
+			{Name: "previousOutputTokenCount", Description: "the output token count of the previous requests"},
+			{Name: "tokenCountModelName", Description: "the model name to use for token count"},
+			{Name: "isStream", Description: "the stream flag"},
+			{Name: "userEmail", Description: "the email of the user"},
+			{Name: "jwtToken", Description: "the JWT token used to authenticate with the token count endpoint"},
+			{Name: "dontSendTokenCount", Description: "the flag to indicate whether sending the token count should be skipped"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the message from the llm, empty as the response is streamed"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"AisAcsSemanticHybridSearchs": {
+		Inputs: []ParameterMetadata{
+			{Name: "acsEndpoint", Description: "the ACS endpoint"},
+			{Name: "acsApiKey", Description: "the ACS API key"},
+			{Name: "acsApiVersion", Description: "the ACS API version"},
+			{Name: "query", Description: "the query string"},
+			{Name: "embeddedQuery", Description: "the embedded query"},
+			{Name: "indexList", Description: "the index list"},
+			{Name: "physics", Description: "the physics"},
+			{Name: "topK", Description: "the number of results to be returned"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "[]ACSSearchResponse"},
+		},
+	},
+	"AisChangeAcsResponsesByFactor": {
+		Inputs: []ParameterMetadata{
+			{Name: "factors", Description: "the factors"},
+			{Name: "semanticSearchOutput", Description: "the search response"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "changedSemanticSearchOutput", Description: "the changed search response"},
+		},
+	},
+	"AisPerformLLMRephraseRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "systemTemplate", Description: "the system template for the rephrase request"},
+			{Name: "userTemplate", Description: "the user template for the rephrase request"},
+			{Name: "query", Description: "the user query"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "tokenCountModelName", Description: "the model name to use for token count"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "rephrasedQuery", Description: "the rephrased query"},
+			{Name: "inputTokenCount", Description: "the input token count"},
+			{Name: "outputTokenCount", Description: "the output token count"},
+		},
+	},
+	"AisReturnIndexList": {
+		Inputs: []ParameterMetadata{
+			{Name: "accessPoint", Description: "the access point"},
+			{Name: "physics", Description: "the physics"},
+			{Name: "version", Description: "the versions, used to select the indexes of the 25R1 and 25R2 releases"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "indexList", Description: "the index list"},
+		},
+	},
+	"AnsysGPTACSSemanticHybridSearchs": {
+		Inputs: []ParameterMetadata{
+			{Name: "acsEndpoint", Description: "the ACS endpoint"},
+			{Name: "acsApiKey", Description: "the ACS API key"},
+			{Name: "acsApiVersion", Description: "the ACS API version"},
+			{Name: "query", Description: "the query string"},
+			{Name: "embeddedQuery", Description: "the embedded query"},
+			{Name: "indexList", Description: "the index list"},
+			{Name: "filter", Description: "the filter"},
+			{Name: "topK", Description: "the number of results to be returned from vector search"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "output", Description: "the search results"},
+		},
+	},
+	"AnsysGPTBuildFinalQuery": {
+		Inputs: []ParameterMetadata{
+			{Name: "refrasedQuery", Description: "the refrased query"},
+			{Name: "context", Description: "the context"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "finalQuery", Description: "the final query"},
+			{Name: "errorResponse", Description: "the error response, set if no context was found"},
+			{Name: "displayFixedMessageToUser", Description: "the flag to indicate whether the error response should be displayed to the user"},
+		},
+	},
+	"AnsysGPTCheckProhibitedWords": {
+		Inputs: []ParameterMetadata{
+			{Name: "query", Description: "the user query"},
+			{Name: "prohibitedWords", Description: "the list of prohibited words"},
+			{Name: "errorResponseMessage", Description: "the error response message"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "foundProhibited", Description: "the flag indicating whether prohibited words were found"},
+			{Name: "responseMessage", Description: "the response message"},
+		},
+	},
+	"AnsysGPTExtractFieldsFromQuery": {
+		Inputs: []ParameterMetadata{
+			{Name: "query", Description: "the user query"},
+			{Name: "fieldValues", Description: "the field values that the user query can contain"},
+			{Name: "defaultFields", Description: "the default fields that the user query can contain"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "fields", Description: "the extracted fields"},
+		},
+	},
+	"AnsysGPTGetSystemPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "query", Description: "the user query"},
+			{Name: "prohibitedWords", Description: "the words the answer must not contain"},
+			{Name: "template", Description: "the template of the system prompt"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "systemPrompt", Description: "the system prompt"},
+		},
+	},
+	"AnsysGPTPerformLLMRephraseRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "userTemplate", Description: "the template for the rephrase request"},
+			{Name: "query", Description: "the user query"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "systemPrompt", Description: "the system prompt of the rephrase request"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "rephrasedQuery", Description: "the rephrased query"},
+		},
+	},
+	"AnsysGPTPerformLLMRephraseRequestNew": {
+		Inputs: []ParameterMetadata{
+			{Name: "template", Description: "the template for the rephrase request"},
+			{Name: "query", Description: "the user query"},
+			{Name: "history", Description: "the conversation history"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "rephrasedQuery", Description: "the rephrased query"},
+		},
+	},
+	"AnsysGPTPerformLLMRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "finalQuery", Description: "the final query"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "isStream", Description: "the flag to indicate whether the response should be streamed"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the message from the llm, empty if the response is streamed"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"AnsysGPTReorderSearchResponseAndReturnOnlyTopK": {
+		Inputs: []ParameterMetadata{
+			{Name: "semanticSearchOutput", Description: "the search response"},
+			{Name: "topK", Description: "the number of results to be returned"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "reorderedSemanticSearchOutput", Description: "the reordered search response"},
+		},
+	},
+	"AnsysGPTReturnIndexList": {
+		Inputs: []ParameterMetadata{
+			{Name: "indexGroups", Description: "the index groups"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "indexList", Description: "the index list"},
+		},
+	},
+	"AppendMeshPilotHistory": {
+		Inputs: []ParameterMetadata{
+			{Name: "history", Description: "the tool history"},
+			{Name: "role", Description: "the tool id"},
+			{Name: "content", Description: "the tool name"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedHistory", Description: "the updated mesh pilot history"},
+		},
+	},
+	"AppendMessageHistory": {
+		Inputs: []ParameterMetadata{
+			{Name: "newMessage", Description: "the new message"},
+			{Name: "role", Description: "the role of the message"},
+			{Name: "history", Description: "the conversation history"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedHistory", Description: "the updated conversation history"},
+		},
+	},
+	"AppendStringSlices": {
+		Inputs: []ParameterMetadata{
+			{Name: "slice1", Description: "the first slice to append."},
+			{Name: "slice2", Description: "the second slice to append."},
+			{Name: "slice3", Description: "the third slice to append."},
+			{Name: "slice4", Description: "the fourth slice to append."},
+			{Name: "slice5", Description: "the fifth slice to append."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "[]string"},
+		},
+	},
+	"AppendToolHistory": {
+		Inputs: []ParameterMetadata{
+			{Name: "toolHistory", Description: "the tool history"},
+			{Name: "toolId", Description: "the tool id"},
+			{Name: "toolName", Description: "the tool name"},
+			{Name: "toolArguments", Description: "the tool arguments"},
+			{Name: "toolResponse", Description: "the tool response"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedToolHistory", Description: "the updated tool history"},
+		},
+	},
+	"AssignStringToString": {
+		Inputs: []ParameterMetadata{
+			{Name: "inputString", Description: "the input string"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "outputString", Description: "the output string"},
+		},
+	},
+	"BuildFinalQueryForCodeLLMRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "request", Description: "the original request"},
+			{Name: "knowledgedbResponse", Description: "the KnowledgeDB response"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "finalQuery", Description: "the final query"},
+		},
+	},
+	"BuildFinalQueryForGeneralLLMRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "request", Description: "the original request"},
+			{Name: "knowledgedbResponse", Description: "the KnowledgeDB response"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "finalQuery", Description: "the final query"},
+		},
+	},
+	"BuildLibraryContext": {
+		Inputs: []ParameterMetadata{
+			{Name: "message", Description: "the message string"},
+			{Name: "libraryContext", Description: "the library context string"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "messageWithContext", Description: "the message with context"},
+		},
+	},
+	"CastAnyToBool": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - bool"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "bool"},
+		},
+	},
+	"CastAnyToByte": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - byte"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "byte"},
+		},
+	},
+	"CastAnyToComplex128": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - complex128"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "complex128"},
+		},
+	},
+	"CastAnyToComplex64": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - complex64"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "complex64"},
+		},
+	},
+	"CastAnyToFloat32": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - float32"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "float32"},
+		},
+	},
+	"CastAnyToFloat64": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - float64"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "float64"},
+		},
+	},
+	"CastAnyToInt": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - int"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "int"},
+		},
+	},
+	"CastAnyToInt16": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - int16"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "int16"},
+		},
+	},
+	"CastAnyToInt32": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - int32"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "int32"},
+		},
+	},
+	"CastAnyToInt64": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - int64"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "int64"},
+		},
+	},
+	"CastAnyToInt8": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - int8"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "int8"},
+		},
+	},
+	"CastAnyToInterface": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - interface {}"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "interface{}"},
+		},
+	},
+	"CastAnyToRune": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - rune"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "rune"},
+		},
+	},
+	"CastAnyToString": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - string"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "string"},
+		},
+	},
+	"CastAnyToUint": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - uint"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "uint"},
+		},
+	},
+	"CastAnyToUint16": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - uint16"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "uint16"},
+		},
+	},
+	"CastAnyToUint32": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - uint32"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "uint32"},
+		},
+	},
+	"CastAnyToUint64": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - uint64"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "uint64"},
+		},
+	},
+	"CastAnyToUint8": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the any value to cast. Returns - uint8"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "uint8"},
+		},
+	},
+	"CastArrayMapStringAnyToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the []map[string]any value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastBoolToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the bool value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastByteToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the byte value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastComplex128ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the complex128 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastComplex64ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the complex64 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastFloat32ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the float32 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastFloat64ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the float64 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastInt16ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the int16 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastInt32ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the int32 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastInt64ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the int64 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastInt8ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the int8 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastIntToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the int value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastInterfaceToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the interface {} value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastRuneToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the rune value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastStringToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the string value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastUint16ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the uint16 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastUint32ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the uint32 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastUint64ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the uint64 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastUint8ToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the uint8 value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CastUintToAny": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the uint value to cast. Returns - any"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"CheckApiKeyAuthKvDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "kvdbEndpoint", Description: "the KVDB endpoint"},
+			{Name: "apiKey", Description: "The API key to check"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "isAuthenticated", Description: "true if the API key is authenticated, false otherwise"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+			{Name: "userID", Description: "the ID of the user the API key belongs to, empty if the API key is not authenticated"},
+		},
+	},
+	"CheckApiKeyAuthMongoDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "apiKey", Description: "The API key to check."},
+			{Name: "mongoDbUrl", Description: "The URL of the MongoDB database."},
+			{Name: "mongoDatabaseName", Description: "The name of the MongoDB database."},
+			{Name: "mongoDbCollectionName", Description: "The name of the MongoDB collection."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "isAuthenticated", Description: "A boolean indicating whether the API key is authenticated."},
+		},
+	},
+	"CheckCreateUserIdMongoDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "userId", Description: "The user ID to check."},
+			{Name: "temporaryTokenLimit", Description: "The token limit for new users."},
+			{Name: "hoursUntilTokenLimitReset", Description: "The number of hours until the token limit of new users is reset."},
+			{Name: "modelId", Description: "The IDs of the models the new users can access."},
+			{Name: "mongoDbUrl", Description: "The URL of the MongoDB database."},
+			{Name: "mongoDatabaseName", Description: "The name of the MongoDB database."},
+			{Name: "mongoDbCollectionName", Description: "The name of the MongoDB collection."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "existingUser", Description: "A boolean indicating whether the user ID already exists."},
+		},
+	},
+	"CheckTokenLimitReached": {
+		Inputs: []ParameterMetadata{
+			{Name: "query", Description: "the query string"},
+			{Name: "tokenLimit", Description: "the token limit"},
+			{Name: "modelName", Description: "the name of the model to check against"},
+			{Name: "tokenLimitMessage", Description: "the message to return if the token limit is reached"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "tokenLimitReached", Description: "true if the token limit is reached, false otherwise"},
+			{Name: "responseMessage", Description: "the token limit message if the token limit is reached, empty otherwise"},
+		},
+	},
+	"CreateCollectionRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "collectionName", Description: "the name of the collection to create."},
+			{Name: "vectorSize", Description: "the length of the vector S"},
+			{Name: "vectorDistance", Description: "the vector similarity distance algorithm to use for the vector index (cosine, dot, euclid, manhattan)"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"CreateDbFilter": {
+		Inputs: []ParameterMetadata{
+			{Name: "guid", Description: "the guid filter"},
+			{Name: "documentId", Description: "the document ID filter"},
+			{Name: "documentName", Description: "the document name filter"},
+			{Name: "level", Description: "the level filter"},
+			{Name: "tags", Description: "the tags filter"},
+			{Name: "keywords", Description: "the keywords filter"},
+			{Name: "metadata", Description: "the metadata filter"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "databaseFilter", Description: "the filter"},
+		},
+	},
+	"CreateGeneralDataExtractionDocumentObjects": {
+		Inputs: []ParameterMetadata{
+			{Name: "documentName", Description: "name of the document."},
+			{Name: "documentChunks", Description: "chunks of the document."},
+			{Name: "denseEmbeddings", Description: "dense embeddings of the document."},
+			{Name: "sparseEmbeddings", Description: "sparse embeddings of the document."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "extractionData", Description: "general data extraction document objects in interface format."},
+		},
+	},
+	"CreateKeywordsDbFilter": {
+		Inputs: []ParameterMetadata{
+			{Name: "keywords", Description: "the keywords to be used for the filter"},
+			{Name: "needAll", Description: "flag to indicate whether all keywords are needed"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "databaseFilter", Description: "the keywords filter"},
+		},
+	},
+	"CreateMessageWithVariable": {
+		Inputs: []ParameterMetadata{
+			{Name: "message", Description: "The message to create."},
+			{Name: "variable", Description: "The variable to insert into the message."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedMessage", Description: "The updated message with the variable inserted."},
+		},
+	},
+	"CreateMetadataDbFilter": {
+		Inputs: []ParameterMetadata{
+			{Name: "fieldName", Description: "the name of the field"},
+			{Name: "fieldType", Description: "the type of the field"},
+			{Name: "filterData", Description: "the filter data"},
+			{Name: "needAll", Description: "flag to indicate whether all data is needed"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "databaseFilter", Description: "the metadata filter"},
+		},
+	},
+	"CreateTagsDbFilter": {
+		Inputs: []ParameterMetadata{
+			{Name: "tags", Description: "the tags to be used for the filter"},
+			{Name: "needAll", Description: "flag to indicate whether all tags are needed"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "databaseFilter", Description: "the tags filter"},
+		},
+	},
+	"CutLatestMessagesFromHistory": {
+		Inputs: []ParameterMetadata{
+			{Name: "history", Description: "the conversation history"},
+			{Name: "numberOfMessages", Description: "the number of messages to cut from the end of the conversation history"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedHistory", Description: "the updated conversation history"},
+		},
+	},
+	"DataPluginCheckQueryType": {
+		Inputs: []ParameterMetadata{
+			{Name: "queryType", Description: "the query type"},
+			{Name: "errorResponseMessage", Description: "the message returned for gated queries"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "isGreet", Description: "the flag indicating whether the query type is greet"},
+			{Name: "isGated", Description: "the flag indicating whether the query type is gated"},
+			{Name: "errorMessage", Description: "the error message"},
+		},
+	},
+	"DataPluginConvertCitationsToContext": {
+		Inputs: []ParameterMetadata{
+			{Name: "citations", Description: "the citations string"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "context", Description: "the context extracted from the citations"},
+			{Name: "resetCitations", Description: "an empty string, used to reset the citations"},
+		},
+	},
+	"DataPluginGetContext": {
+		Inputs: []ParameterMetadata{
+			{Name: "userQuery", Description: "the user query"},
+			{Name: "apiUrl", Description: "the API URL of the data plugin"},
+			{Name: "username", Description: "the username for authentication at the data plugin"},
+			{Name: "password", Description: "the password for authentication at the data plugin"},
+			{Name: "topK", Description: "the number of results to be returned"},
+			{Name: "physics", Description: "the physics to be used as filter"},
+			{Name: "dataSource", Description: "the data sources to be used as filter"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "context", Description: "the context retrieved from the data plugin"},
+		},
+	},
+	"DataPluginGetFinalSystemPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "responseType", Description: "the response type"},
+			{Name: "classicTemplate", Description: "the classic template"},
+			{Name: "listTemplate", Description: "the list template"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "systemPrompt", Description: "the final system prompt"},
+		},
+	},
+	"DataPluginPerformLLMFinalRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "systemTemplate", Description: "the system template for the final request"},
+			{Name: "userTemplate", Description: "the user template for the final request"},
+			{Name: "query", Description: "the user query"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "context", Description: "the context retrieved from the retriever module"},
+			{Name: "prohibitedWords", Description: "the list of prohibited words"},
+			{Name: "isStream", Description: "the flag to define if the response should be streamed"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the message"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"DataPluginPerformLLMRephraseRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "systemTemplate", Description: "the system template for the rephrase request"},
+			{Name: "userTemplate", Description: "the user template for the rephrase request"},
+			{Name: "query", Description: "the user query"},
+			{Name: "history", Description: "the conversation history"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "rephrasedQuery", Description: "the rephrased query"},
+		},
+	},
+	"DenyCustomerAccessAndSendWarningKvDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "kvdbEndpoint", Description: "the KVDB endpoint"},
+			{Name: "apiKey", Description: "The API key of the customer"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "customerName", Description: "The name of the customer"},
+			{Name: "sendWarning", Description: "true if a warning was sent, false if it was already sent"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "apiKey", Description: "The API key of the customer."},
+			{Name: "mongoDbUrl", Description: "The URL of the MongoDB database."},
+			{Name: "mongoDatabaseName", Description: "The name of the MongoDB database."},
+			{Name: "mongoDbCollectionName", Description: "The name of the MongoDB collection."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "customerName", Description: "The name of the customer."},
+			{Name: "sendWarning", Description: "A boolean indicating whether a warning should be sent to the customer."},
+		},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDbUserId": {
+		Inputs: []ParameterMetadata{
+			{Name: "userId", Description: "The user ID of the customer."},
+			{Name: "mongoDbUrl", Description: "The URL of the MongoDB database."},
+			{Name: "mongoDatabaseName", Description: "The name of the MongoDB database."},
+			{Name: "mongoDbCollectionName", Description: "The name of the MongoDB collection."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "sendWarning", Description: "A boolean indicating whether a warning should be sent to the customer."},
+		},
+	},
+	"DownloadGithubFileContent": {
+		Inputs: []ParameterMetadata{
+			{Name: "githubRepoName", Description: "name of the github repository."},
+			{Name: "githubRepoOwner", Description: "owner of the github repository."},
+			{Name: "githubRepoBranch", Description: "branch of the github repository."},
+			{Name: "gihubFilePath", Description: "path to file in the github repository."},
+			{Name: "githubAccessToken", Description: "access token for github."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "checksum", Description: "checksum of file."},
+			{Name: "content", Description: "content of file."},
+		},
+	},
+	"DownloadGithubFilesContent": {
+		Inputs: []ParameterMetadata{
+			{Name: "githubRepoName", Description: "name of the github repository."},
+			{Name: "githubRepoOwner", Description: "owner of the github repository."},
+			{Name: "githubRepoBranch", Description: "branch of the github repository."},
+			{Name: "gihubFilePaths", Description: "paths to the files in the github repository."},
+			{Name: "githubAccessToken", Description: "access token for github."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "filesMap", Description: "map of file paths to file content."},
+		},
+	},
+	"ExecuteTool": {
+		Inputs: []ParameterMetadata{
+			{Name: "serverURL", Description: "the WebSocket URL of the MCP server"},
+			{Name: "toolName", Description: "the name of the tool to execute"},
+			{Name: "args", Description: "a map of arguments to pass to the tool"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "map[string]interface{}"},
+			{Name: "error", Description: "any error that occurred during execution"},
+		},
+	},
+	"ExtractCriteriaSuggestions": {
+		Inputs: []ParameterMetadata{
+			{Name: "llmResponse", Description: "the text response from the LLM containing JSON with criteria suggestions"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "criteriaSuggestions", Description: "the list of criteria suggestions extracted from the LLM response"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"ExtractDesignRequirementsAndSearchCriteria": {
+		Inputs: []ParameterMetadata{
+			{Name: "userInput", Description: "the user input JSON string"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "designRequirements", Description: "the extracted design requirements string"},
+			{Name: "availableSearchCriteria", Description: "the extracted list of attribute GUIDs"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"ExtractJSONStringField": {
+		Inputs: []ParameterMetadata{
+			{Name: "jsonStr", Description: "the JSON string to extract the field from"},
+			{Name: "keyPath", Description: "the dot-separated path to the field in the JSON object"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "string"},
+		},
+	},
+	"FetchActionsPathFromPathDescription": {
+		Inputs: []ParameterMetadata{
+			{Name: "db_name", Description: "the name of the database"},
+			{Name: "description", Description: "the desctiption of path"},
+			{Name: "nodeLabel", Description: "the label of the node"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "actions", Description: "the list of actions to execute"},
+		},
+	},
+	"FetchNodeDescriptionsFromPathDescription": {
+		Inputs: []ParameterMetadata{
+			{Name: "db_name", Description: "the name of the database"},
+			{Name: "description", Description: "the desctiption of path"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "actionDescriptions", Description: "action descriptions"},
+		},
+	},
+	"FetchPropertiesFromPathDescription": {
+		Inputs: []ParameterMetadata{
+			{Name: "db_name", Description: "the name of the database"},
+			{Name: "description", Description: "the desctiption of path"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "properties", Description: "the list of descriptions"},
+		},
+	},
+	"FilterOutDuplicateAttributes": {
+		Inputs: []ParameterMetadata{
+			{Name: "criteriaSuggestions", Description: "current list of criteria suggestions"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "filtered", Description: "the list of criteria suggestions excluding duplicates based on attribute names"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"FilterOutNonExistingAttributes": {
+		Inputs: []ParameterMetadata{
+			{Name: "criteriaSuggestions", Description: "current list of criteria suggestions"},
+			{Name: "availableSearchCriteria", Description: "the list of available search criteria (GUIDs)"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "filtered", Description: "the list of criteria suggestions excluding those that do not match any of the available search criteria"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"FinalizeMessage": {
+		Inputs: []ParameterMetadata{
+			{Name: "message", Description: "final message"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "response schema sent to chat interface"},
+		},
+	},
+	"FinalizeResult": {
+		Inputs: []ParameterMetadata{
+			{Name: "actions", Description: "the executable actions"},
+			{Name: "toolName", Description: "tool name to create customize messages"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "the actions in json format"},
+		},
+	},
+	"FindRelevantPathDescription": {
+		Inputs: []ParameterMetadata{
+			{Name: "descriptions", Description: "the list of descriptions"},
+			{Name: "message", Description: "the message from llm"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "relevantDescription", Description: "the relevant desctiption"},
+		},
+	},
+	"FluentCodeGen": {
+		Inputs: []ParameterMetadata{
+			{Name: "url", Description: "the URL of the Fluent container"},
+			{Name: "message", Description: "the raw user message to send to the container"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "response", Description: "the response from the Fluent container as a string"},
+		},
+	},
+	"GeneralGraphDbQuery": {
+		Inputs: []ParameterMetadata{
+			{Name: "dbname", Description: "the name of the graphdb to target. If not provided, defaults to \"aali\"."},
+			{Name: "query", Description: "the Cypher query to be executed."},
+			{Name: "parameters", Description: "parameters to pass to the query during execution"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "[]map[string]any"},
+		},
+	},
+	"GeneralQuery": {
+		Inputs: []ParameterMetadata{
+			{Name: "collectionName", Description: "the name of the collection to which the data objects will be added."},
+			{Name: "maxRetrievalCount", Description: "the maximum number of results to be retrieved."},
+			{Name: "outputFields", Description: "the fields to be included in the output."},
+			{Name: "filters", Description: "the filter for the query."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "databaseResponse", Description: "the query results"},
+		},
+	},
+	"GenerateActionsSubWorkflowPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "userInstruction", Description: "user instruction"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "userPrompt", Description: "the user prompt"},
+		},
+	},
+	"GenerateDocumentTree": {
+		Inputs: []ParameterMetadata{
+			{Name: "documentName", Description: "name of the document."},
+			{Name: "documentId", Description: "id of the document."},
+			{Name: "documentChunks", Description: "chunks of the document."},
+			{Name: "embeddingsDimensions", Description: "dimensions of the embeddings."},
+			{Name: "getSummary", Description: "whether to get summary."},
+			{Name: "getKeywords", Description: "whether to get keywords."},
+			{Name: "numKeywords", Description: "number of keywords."},
+			{Name: "chunkSize", Description: "size of the chunks."},
+			{Name: "numLlmWorkers", Description: "number of llm workers."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "returnedDocumentData", Description: "tree structure of the document."},
+		},
+	},
+	"GenerateHelperSubWorkflowPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "userInstruction", Description: "user instruction"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "userPrompt", Description: "the user prompt"},
+		},
+	},
+	"GenerateMKSummariesforTags": {
+		Inputs: []ParameterMetadata{
+			{Name: "dbName", Description: "the name of the database"},
+			{Name: "tags", Description: "the list of tags"},
+			{Name: "GetTagIdByNameQuery", Description: "the query getting the ID of a tag by its name"},
+			{Name: "GetMKSummaryFromDBQuery", Description: "the query getting the MK summary of a tag ID"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "allTagsSummaries", Description: "the list of unique MK summaries"},
+		},
+	},
+	"GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "SynthesizeAnswerUserPromptTemplate", Description: "the template string with placeholders for original query, expanded sub-queries, and retrieved Q&A pairs"},
+			{Name: "originalQuery", Description: "the user's original query"},
+			{Name: "expandedQueries", Description: "the expanded sub-queries"},
+			{Name: "retrievedQAPairs", Description: "the retrieved Q&A pairs"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "userPrompt", Description: "the formatted user prompt"},
+		},
+	},
+	"GenerateUUID": {
+		Inputs: []ParameterMetadata{},
+		Outputs: []ParameterMetadata{
+			{Name: "string"},
+		},
+	},
+	"GenerateUserPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "userInstruction", Description: "user instruction"},
+			{Name: "userPromptTemplate", Description: "user prompt template"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "userPrompt", Description: "the user prompt"},
+		},
+	},
+	"GenerateUserPromptWithContext": {
+		Inputs: []ParameterMetadata{
+			{Name: "userInstruction", Description: "user instruction"},
+			{Name: "context", Description: "user context"},
+			{Name: "userPromptTemplate", Description: "user prompt template"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "userPrompt", Description: "the user prompt"},
+		},
+	},
+	"GenerateUserPromptWithList": {
+		Inputs: []ParameterMetadata{
+			{Name: "userInstruction", Description: "user instruction"},
+			{Name: "userList", Description: "list of items to include in the prompt"},
+			{Name: "userPromptTemplate", Description: "user prompt template"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "userPrompt", Description: "the user prompt"},
+		},
+	},
+	"GetActionsFromConfig": {
+		Inputs: []ParameterMetadata{
+			{Name: "toolName", Description: "tool name to create customize messages"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "the actions in json format"},
+		},
+	},
+	"GetDocumentType": {
+		Inputs: []ParameterMetadata{
+			{Name: "filePath", Description: "path to file."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "documentType", Description: "file extension."},
+		},
+	},
+	"GetGithubFilesToExtract": {
+		Inputs: []ParameterMetadata{
+			{Name: "githubRepoName", Description: "name of the github repository."},
+			{Name: "githubRepoOwner", Description: "owner of the github repository."},
+			{Name: "githubRepoBranch", Description: "branch of the github repository."},
+			{Name: "githubAccessToken", Description: "access token for github."},
+			{Name: "githubFileExtensions", Description: "github file extensions."},
+			{Name: "githubFilteredDirectories", Description: "github filtered directories."},
+			{Name: "githubExcludedDirectories", Description: "github excluded directories."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "githubFilesToExtract", Description: "github files to extract."},
+		},
+	},
+	"GetListCollections": {
+		Inputs: []ParameterMetadata{},
+		Outputs: []ParameterMetadata{
+			{Name: "collectionsList", Description: "the list of collections"},
+		},
+	},
+	"GetLocalFileContent": {
+		Inputs: []ParameterMetadata{
+			{Name: "localFilePath", Description: "path to file."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "checksum", Description: "checksum of file."},
+			{Name: "content", Description: "content of file."},
+		},
+	},
+	"GetLocalFilesContent": {
+		Inputs: []ParameterMetadata{
+			{Name: "localFilePaths", Description: "paths to files."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "filesMap", Description: "map of file paths to file content."},
+		},
+	},
+	"GetLocalFilesToExtract": {
+		Inputs: []ParameterMetadata{
+			{Name: "localPath", Description: "path to the local directory."},
+			{Name: "localFileExtensions", Description: "local file extensions."},
+			{Name: "localFilteredDirectories", Description: "local filtered directories."},
+			{Name: "localExcludedDirectories", Description: "local excluded directories."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "localFilesToExtract", Description: "local files to extract."},
+		},
+	},
+	"GetResource": {
+		Inputs: []ParameterMetadata{
+			{Name: "serverURL", Description: "the WebSocket URL of the MCP server"},
+			{Name: "resourceName", Description: "the name of the resource to retrieve"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "map[string]interface{}"},
+			{Name: "error", Description: "any error that occurred during the request"},
+		},
+	},
+	"GetSelectedSolution": {
+		Inputs: []ParameterMetadata{
+			{Name: "arguments", Description: "these are the arguments ReAct found based on user choice"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "solution", Description: "the selected solution"},
+		},
+	},
+	"GetSolutionsToFixProblem": {
+		Inputs: []ParameterMetadata{
+			{Name: "db_name", Description: "the name of the database"},
+			{Name: "fmFailureCode", Description: "FM failure Code"},
+			{Name: "primeMeshFailureCode", Description: "Prime Mesh Failure Code"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "solutions", Description: "the list of solutions in json"},
+		},
+	},
+	"GetSystemPrompt": {
+		Inputs: []ParameterMetadata{
+			{Name: "serverURL", Description: "the WebSocket URL of the MCP server"},
+			{Name: "promptName", Description: "the name of the system prompt to retrieve"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "string"},
+			{Name: "error", Description: "any error that occurred during the request"},
+		},
+	},
+	"JsonPath": {
+		Inputs: []ParameterMetadata{
+			{Name: "pat", Description: "The JSON Path pattern"},
+			{Name: "data", Description: "The data to extract from"},
+			{Name: "oneResult", Description: "Whether you are expecting to extract 1 result or an array of results If you set oneResult=true but there are not exactle 1 result in the output, you will receive an error. This should only be set if the result is guaranteed to have length 1. Returns - The extracted data. If oneResult=false, this will be an array of any."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "any"},
+		},
+	},
+	"LangchainSplitter": {
+		Inputs: []ParameterMetadata{
+			{Name: "bytesContent", Description: "content to split."},
+			{Name: "documentType", Description: "type of document."},
+			{Name: "chunkSize", Description: "size of the chunks."},
+			{Name: "chunkOverlap", Description: "overlap of the chunks."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "output", Description: "chunks as an slice of strings."},
+		},
+	},
+	"ListAll": {
+		Inputs: []ParameterMetadata{
+			{Name: "serverURL", Description: "the WebSocket URL of the MCP server"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "map[string][]string"},
+			{Name: "error", Description: "any error that occurred during the process"},
+		},
+	},
+	"LoadAndCheckExampleDependencies": {
+		Inputs: []ParameterMetadata{
+			{Name: "dependenciesContent", Description: "content of the dependencies file in []byte format."},
+			{Name: "elements", Description: "code generation elements."},
+			{Name: "instancesReplacementDict", Description: "dictionary of instances replacements."},
+			{Name: "InstancesReplacementPriorityList", Description: "list of instances replacement priority."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "checkedDependenciesMap", Description: "checked dependencies."},
+			{Name: "equivalencesMap", Description: "equivalences."},
+		},
+	},
+	"LoadCodeGenerationElements": {
+		Inputs: []ParameterMetadata{
+			{Name: "content", Description: "content of the file in []byte format."},
+			{Name: "elementsFilePath", Description: "path to the file."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "elements", Description: "code generation elements."},
+		},
+	},
+	"LoadCodeGenerationExamples": {
+		Inputs: []ParameterMetadata{
+			{Name: "source", Description: "source of the examples (local or github)."},
+			{Name: "examplesToExtract", Description: "paths to the examples."},
+			{Name: "githubRepoName", Description: "name of the github repository."},
+			{Name: "githubRepoOwner", Description: "owner of the github repository."},
+			{Name: "githubRepoBranch", Description: "branch of the github repository."},
+			{Name: "githubAccessToken", Description: "access token for the github repository."},
+			{Name: "dependencies", Description: "dependencies of the examples."},
+			{Name: "equivalencesMap", Description: "equivalences of the examples."},
+			{Name: "chunkSize", Description: "size of the chunks."},
+			{Name: "chunkOverlap", Description: "overlap of the chunks."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "examples", Description: "code generation examples."},
+		},
+	},
+	"LoadUserGuideSections": {
+		Inputs: []ParameterMetadata{
+			{Name: "source", Description: "source of the sections (local or github)."},
+			{Name: "sectionFilePaths", Description: "paths to the sections."},
+			{Name: "githubRepoName", Description: "name of the github repository."},
+			{Name: "githubRepoOwner", Description: "owner of the github repository."},
+			{Name: "githubRepoBranch", Description: "branch of the github repository."},
+			{Name: "githubAccessToken", Description: "access token for the github repository."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "sections", Description: "user guide sections."},
+		},
+	},
+	"LogRequestFailed": {
+		Inputs: []ParameterMetadata{
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"LogRequestFailedDebugWithMessage": {
+		Inputs: []ParameterMetadata{
+			{Name: "msg1", Description: "the first part of the debug message"},
+			{Name: "msg2", Description: "the second part of the debug message"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"LogRequestSuccess": {
+		Inputs: []ParameterMetadata{
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"MarkdownToHTML": {
+		Inputs: []ParameterMetadata{
+			{Name: "markdown", Description: "content in markdown format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "html", Description: "content in html format"},
+		},
+	},
+	"ParseHistory": {
+		Inputs: []ParameterMetadata{
+			{Name: "historyJson", Description: "history in json format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "history", Description: "the parsed history"},
+		},
+	},
+	"ParseHistoryToHistoricMessages": {
+		Inputs: []ParameterMetadata{
+			{Name: "historyJson", Description: "chat history in json format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "history", Description: "the history in sharedtypes.HistoricMessage format"},
+		},
+	},
+	"ParseSlashCommand": {
+		Inputs: []ParameterMetadata{
+			{Name: "userInput", Description: "the input string containing the Slash Input message in JSON format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "slashCmd", Description: "the slash command if found, otherwise an empty string"},
+			{Name: "targetCmd", Description: "the target command if found, otherwise an empty string"},
+			{Name: "hasCmd", Description: "boolean indicating if a slash command or target command was found"},
+			{Name: "hasContext", Description: "boolean indicating if the input contains text besides the commands"},
+		},
+	},
+	"ParseSlashCommands": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "The input string containing slash commands."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "slashCommands", Description: "The parsed slash commands, each with its scope and command. If no scope is provided, the scope will be 'global'."},
+		},
+	},
+	"PerformBatchEmbeddingRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input strings"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "embeddedVectors", Description: "the embedded vectors in float32 format"},
+		},
+	},
+	"PerformBatchHybridEmbeddingRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input strings"},
+			{Name: "maxBatchSize", Description: "the maximum number of strings sent in a single request"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "denseEmbeddings", Description: "the dense embeddings in float32 format"},
+			{Name: "sparseEmbeddings", Description: "the sparse embeddings in map format"},
+		},
+	},
+	"PerformCodeLLMRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input string"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "isStream", Description: "the stream flag"},
+			{Name: "validateCode", Description: "the flag to indicate whether the code should be validated"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the generated code"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformGeneralModelSpecificationRequest": {
+		Deprecated: "use PerformLLMRequest or PerformLLMStreamRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "isStream", Description: "the flag to indicate whether the response should be streamed"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "modelIds", Description: "the model IDs"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformGeneralRequest": {
+		Deprecated: "use PerformLLMRequest or PerformLLMStreamRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input string"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "isStream", Description: "the stream flag"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the generated message"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformGeneralRequestNoStreaming": {
+		Deprecated: "use PerformLLMRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input string"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the generated message"},
+		},
+	},
+	"PerformGeneralRequestSpecificModel": {
+		Deprecated: "use PerformLLMRequest or PerformLLMStreamRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "isStream", Description: "the flag to indicate whether the response should be streamed"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "modelIds", Description: "the model IDs"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptions": {
+		Deprecated: "use PerformLLMRequest or PerformLLMStreamRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "isStream", Description: "the flag to indicate whether the response should be streamed"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "modelIds", Description: "the model IDs"},
+			{Name: "modelOptions", Description: "the model options"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput": {
+		Deprecated: "use PerformLLMRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "modelIds", Description: "the model IDs of the AI models to use"},
+			{Name: "modelOptions", Description: "the model options"},
+			{Name: "tokenCountModelName", Description: "the model name to use for token count"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "inputTokenCount", Description: "the input token count"},
+			{Name: "outputTokenCount", Description: "the output token count"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput": {
+		Deprecated: "use PerformLLMRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "modelIds", Description: "the model IDs of the AI models to use"},
+			{Name: "modelOptions", Description: "the model options"},
+			{Name: "tokenCountModelName", Description: "the model name to use for token count"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "tokenCount", Description: "the token count"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelModelOptionsAndImages": {
+		Deprecated: "use PerformLLMRequest or PerformLLMStreamRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "isStream", Description: "the flag to indicate whether the response should be streamed"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "modelIds", Description: "the model IDs"},
+			{Name: "modelOptions", Description: "the model options"},
+			{Name: "images", Description: "the images to include in the request"},
+			{Name: "modelCategory", Description: "the model categories"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput": {
+		Deprecated: "use PerformLLMRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "modelIds", Description: "the model IDs of the AI models to use"},
+			{Name: "tokenCountModelName", Description: "the model name to use for token count"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "tokenCount", Description: "the token count"},
+		},
+	},
+	"PerformGeneralRequestWithImages": {
+		Deprecated: "use PerformLLMRequest or PerformLLMStreamRequest instead",
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "history", Description: "the conversation history"},
+			{Name: "isStream", Description: "the flag to indicate whether the response should be streamed"},
+			{Name: "systemPrompt", Description: "the system prompt"},
+			{Name: "images", Description: "the images"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformKeywordExtractionRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input string"},
+			{Name: "maxKeywordsSearch", Description: "the maximum number of keywords to search for"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "keywords", Description: "the keywords extracted from the input string as a slice of strings"},
+		},
+	},
+	"PerformLLMRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "options", Description: "the options of the request"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "message", Description: "the response message"},
+			{Name: "inputTokenCount", Description: "the input token count"},
+			{Name: "outputTokenCount", Description: "the output token count"},
+		},
+	},
+	"PerformLLMStreamRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input"},
+			{Name: "options", Description: "the options of the request"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "stream", Description: "the stream channel"},
+		},
+	},
+	"PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the user input string"},
+			{Name: "history", Description: "the conversation history for context"},
+			{Name: "systemPrompt", Description: "the system prompt to guide the LLM"},
+			{Name: "modelIds", Description: "the model IDs of the LLMs to query"},
+			{Name: "tokenCountModelName", Description: "the model name used for token count calculation"},
+			{Name: "n", Description: "number of parallel requests to perform"},
+			{Name: "temperature", Description: "the temperature setting for the LLM requests"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+			{Name: "userID", Description: "the ID of the user, logged with the token usage"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "uniqueCriterion", Description: "a deduplicated list of extracted attributes (criteria) from all responses"},
+			{Name: "tokenCount", Description: "the total token count (input tokens × n + combined output tokens)"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"PerformSimilaritySearchForSubqueries": {
+		Inputs: []ParameterMetadata{
+			{Name: "subQueries", Description: "the list of expanded sub-queries"},
+			{Name: "collection", Description: "the vector database collection name"},
+			{Name: "similaritySearchResults", Description: "the number of similarity search results"},
+			{Name: "similaritySearchMinScore", Description: "the minimum similarity score threshold"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "uniqueQAPairs", Description: "the unique Q&A pairs from similarity search results"},
+		},
+	},
+	"PerformSummaryRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input string"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "summary", Description: "the summary extracted from the input string"},
+		},
+	},
+	"PerformVectorEmbeddingRequest": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input string"},
+			{Name: "includeSparse", Description: "flag to include sparse vectors (false for dense-only, true for hybrid search)"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "embeddedVector", Description: "the embedded vector in float32 format"},
+			{Name: "sparseVector", Description: "the sparse embedded vector as term_id->weight map (only when includeSparse=true)"},
+		},
+	},
+	"PerformVectorEmbeddingRequestWithTokenLimitCatch": {
+		Inputs: []ParameterMetadata{
+			{Name: "input", Description: "the input string"},
+			{Name: "tokenLimitMessage", Description: "the message to return if the token limit is reached"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "embeddedVector", Description: "the embedded vector in float32 format"},
+			{Name: "tokenLimitReached", Description: "true if the token limit is reached, false otherwise"},
+			{Name: "responseMessage", Description: "the token limit message if the token limit is reached, empty otherwise"},
+		},
+	},
+	"PrintFeedback": {
+		Inputs: []ParameterMetadata{
+			{Name: "feedback", Description: "the feedback to print"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"ProcessJSONListOutput": {
+		Inputs: []ParameterMetadata{
+			{Name: "response", Description: "the JSON response string"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "generatedList", Description: "the list of items extracted from the response"},
+		},
+	},
+	"ProcessMWWorkflowInfo": {
+		Inputs: []ParameterMetadata{
+			{Name: "mwWorkflowInfo", Description: "the workflow information in string format"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"ProcessMainAgentOutput": {
+		Inputs: []ParameterMetadata{
+			{Name: "llmOutput", Description: "the llm output for main agent"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "messageTo", Description: "send the message to this recipient"},
+			{Name: "message", Description: "message to send to the recipient"},
+		},
+	},
+	"ProcessSubworkflowIdentificationOutput": {
+		Inputs: []ParameterMetadata{
+			{Name: "llmOutput", Description: "the llm output for subworkflow identification"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "status", Description: "status of processing"},
+			{Name: "workflowName", Description: "the identified subworkflow name"},
+		},
+	},
+	"QdrantCreateCollection": {
+		Inputs: []ParameterMetadata{
+			{Name: "collectionName", Description: "The name of the collection"},
+			{Name: "vectorSize", Description: "The size of the vectors stored in this collection"},
+			{Name: "vectorDistance", Description: "The distance metric to use of vector similarity search (cosine, dot, euclid, manhattan)"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"QdrantCreateIndex": {
+		Inputs: []ParameterMetadata{
+			{Name: "collectionName", Description: "The name of the collection"},
+			{Name: "fieldName", Description: "The name of the payload field to create an index on"},
+			{Name: "fieldType", Description: "The qdrant type that the payload field is expected to be"},
+			{Name: "wait", Description: "Whether to wait for the index to be created or return immediately & continue indexing in background"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"QdrantInsertData": {
+		Inputs: []ParameterMetadata{
+			{Name: "collectionName", Description: "The name of the collection"},
+			{Name: "data", Description: "The data points to insert (func will fail if elements are not `map[string]any`)"},
+			{Name: "idFieldName", Description: "The name of the field to use as the ID"},
+			{Name: "vectorFieldName", Description: "The name of the field to use as the vector"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"ResetTokenCountIfNewMonth": {
+		Inputs: []ParameterMetadata{
+			{Name: "kvdbEndpoint", Description: "the KVDB endpoint"},
+			{Name: "apiKey", Description: "The API key of the customer"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"RetrieveDependencies": {
+		Inputs: []ParameterMetadata{
+			{Name: "dbname", Description: "the name of the graphdb to target. If not provided, defaults to \"aali\"."},
+			{Name: "relationshipName", Description: "the name of the relationship to retrieve dependencies for."},
+			{Name: "relationshipDirection", Description: "the direction of the relationship to retrieve dependencies for."},
+			{Name: "sourceDocumentId", Description: "the document ID of the source node."},
+			{Name: "nodeTypesFilter", Description: "filter based on node types."},
+			{Name: "maxHopsNumber", Description: "maximum number of hops to traverse."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "dependenciesIds", Description: "the list of dependencies"},
+		},
+	},
+	"SelectedSolution": {
+		Inputs: []ParameterMetadata{
+			{Name: "selectedSolution", Description: "selected solution found by the LLM"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "solution", Description: "parsed solution"},
+		},
+	},
+	"SendLogicAppNotificationEmail": {
+		Inputs: []ParameterMetadata{
+			{Name: "logicAppEndpoint", Description: "The email service endpoint."},
+			{Name: "email", Description: "The email address."},
+			{Name: "subject", Description: "The email subject."},
+			{Name: "content", Description: "The email content."},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"SendLogicAppNotificationEmailToMultipleEmails": {
+		Inputs: []ParameterMetadata{
+			{Name: "logicAppEndpoint", Description: "The Logic App email service endpoint"},
+			{Name: "emails", Description: "Array of email addresses to send to"},
+			{Name: "subject", Description: "The email subject"},
+			{Name: "content", Description: "The email content"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"SendRestAPICall": {
+		Inputs: []ParameterMetadata{
+			{Name: "requestType", Description: "the type of the request (GET, POST, PUT, PATCH, DELETE)"},
+			{Name: "endpoint", Description: "the URL to send the request to"},
+			{Name: "header", Description: "the headers to include in the request"},
+			{Name: "query", Description: "the query parameters to include in the request"},
+			{Name: "jsonBody", Description: "the body of the request as a JSON string"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "success", Description: "a boolean indicating whether the request was successful"},
+			{Name: "returnJsonBody", Description: "the JSON body of the response as a string"},
+		},
+	},
+	"SendVectorsToKnowledgeDB": {
+		Inputs: []ParameterMetadata{
+			{Name: "vector", Description: "the vector to be sent to the KnowledgeDB"},
+			{Name: "keywords", Description: "the keywords to be used to filter the results"},
+			{Name: "keywordsSearch", Description: "the flag to enable the keywords search"},
+			{Name: "collection", Description: "the collection name"},
+			{Name: "similaritySearchResults", Description: "the number of results to be returned"},
+			{Name: "similaritySearchMinScore", Description: "the minimum score for the results"},
+			{Name: "sparseVector", Description: "optional sparse vector for hybrid search (pass empty map for dense-only search)"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "databaseResponse", Description: "an array of the most relevant data"},
+		},
+	},
+	"SerializeResponse": {
+		Inputs: []ParameterMetadata{
+			{Name: "criteriaSuggestions", Description: "the suggested criteria to serialize"},
+			{Name: "tokens", Description: "tokens consumed by the request"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "string representation of the response in JSON format"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"SetCopilotGenerateRequestJsonBody": {
+		Inputs: []ParameterMetadata{
+			{Name: "query", Description: "the query string for the request."},
+			{Name: "sessionID", Description: "the session ID for the request."},
+			{Name: "mode", Description: "the mode of operation for the request."},
+			{Name: "timeout", Description: "the timeout for the request in seconds."},
+			{Name: "priority", Description: "the priority of the request."},
+			{Name: "agentPreference", Description: "the preferred agent for the request."},
+			{Name: "saveIntermediate", Description: "whether to save intermediate results."},
+			{Name: "similarityTopK", Description: "the number of top similar results to consider."},
+			{Name: "noCritique", Description: "whether to disable critique."},
+			{Name: "maxIterations", Description: "the maximum number of iterations for the request."},
+			{Name: "forceAzure", Description: "whether to force the use of Azure for the request."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "jsonBody", Description: "the JSON body of the request."},
+		},
+	},
+	"ShortenMessageHistory": {
+		Inputs: []ParameterMetadata{
+			{Name: "history", Description: "the conversation history"},
+			{Name: "maxLength", Description: "the maximum length of the conversation history"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedHistory", Description: "the updated conversation history"},
+		},
+	},
+	"SimilaritySearch": {
+		Inputs: []ParameterMetadata{
+			{Name: "collectionName", Description: "the name of the collection to which the data objects will be added."},
+			{Name: "embeddedVector", Description: "the embedded vector used for searching."},
+			{Name: "maxRetrievalCount", Description: "the maximum number of results to be retrieved."},
+			{Name: "filters", Description: "the filter for the query."},
+			{Name: "minScore", Description: "the minimum score filter."},
+			{Name: "getLeafNodes", Description: "flag to indicate whether to retrieve all the leaf nodes in the result node branch."},
+			{Name: "getSiblings", Description: "flag to indicate whether to retrieve the previous and next node to the result nodes."},
+			{Name: "getParent", Description: "flag to indicate whether to retrieve the parent object."},
+			{Name: "getChildren", Description: "flag to indicate whether to retrieve the children objects."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "databaseResponse", Description: "the similarity search results"},
+		},
+	},
+	"SimilartitySearchOnPathDescriptions": {
+		Inputs: []ParameterMetadata{
+			{Name: "instruction", Description: "the user query"},
+			{Name: "toolName", Description: "the tool name"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "descriptions", Description: "the list of descriptions"},
+		},
+	},
+	"SimilartitySearchOnPathDescriptionsQdrant": {
+		Inputs: []ParameterMetadata{
+			{Name: "vector", Description: "the embedded user query"},
+			{Name: "collection", Description: "the collection to search in"},
+			{Name: "similaritySearchResults", Description: "the number of results to return"},
+			{Name: "similaritySearchMinScore", Description: "the minimum score of the results"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "descriptions", Description: "the list of descriptions"},
+		},
+	},
+	"StartTrace": {
+		Inputs: []ParameterMetadata{},
+		Outputs: []ParameterMetadata{
+			{Name: "traceID", Description: "a 128-bit trace ID in decimal format"},
+			{Name: "spanID", Description: "a 64-bit span ID in decimal format"},
+		},
+	},
+	"StoreElementsInGraphDatabase": {
+		Inputs: []ParameterMetadata{
+			{Name: "dbname", Description: "the name of the graphdb to target. If not provided, defaults to \"aali\"."},
+			{Name: "elements", Description: "code generation elements."},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"StoreElementsInVectorDatabase": {
+		Inputs: []ParameterMetadata{
+			{Name: "elements", Description: "code generation elements."},
+			{Name: "elementsCollectionName", Description: "name of the collection."},
+			{Name: "batchSize", Description: "batch size for embeddings."},
+			{Name: "vectorDistance", Description: "the distance metric to use for the vector index (cosine, dot, euclid, manhattan)"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"StoreExamplesInGraphDatabase": {
+		Inputs: []ParameterMetadata{
+			{Name: "dbname", Description: "the name of the graphdb to target. If not provided, defaults to \"aali\"."},
+			{Name: "examples", Description: "code generation examples."},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"StoreExamplesInVectorDatabase": {
+		Inputs: []ParameterMetadata{
+			{Name: "examples", Description: "code generation examples."},
+			{Name: "examplesCollectionName", Description: "name of the collection."},
+			{Name: "batchSize", Description: "batch size for embeddings."},
+			{Name: "vectorDistance", Description: "the distance metric to use for the vector index (cosine, dot, euclid, manhattan)"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"StoreUserGuideSectionsInGraphDatabase": {
+		Inputs: []ParameterMetadata{
+			{Name: "dbname", Description: "the name of the graphdb to target. If not provided, defaults to \"aali\"."},
+			{Name: "sections", Description: "user guide sections."},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"StoreUserGuideSectionsInVectorDatabase": {
+		Inputs: []ParameterMetadata{
+			{Name: "sections", Description: "user guide sections."},
+			{Name: "userGuideCollectionName", Description: "name of the collection."},
+			{Name: "batchSize", Description: "batch size for embeddings."},
+			{Name: "chunkSize", Description: "size of the chunks."},
+			{Name: "chunkOverlap", Description: "overlap of the chunks."},
+			{Name: "vectorDistance", Description: "the distance metric to use for the vector index (cosine, dot, euclid, manhattan)"},
+		},
+		Outputs: []ParameterMetadata{},
+	},
+	"StringConcat": {
+		Inputs: []ParameterMetadata{
+			{Name: "a", Description: "the first string"},
+			{Name: "b", Description: "the second string"},
+			{Name: "separator", Description: "the separator string. If not provided, will be an empty string."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "string"},
+		},
+	},
+	"StringFormat": {
+		Inputs: []ParameterMetadata{
+			{Name: "data", Description: "the data to format as a string"},
+			{Name: "format", Description: "the format specifier to use. If not provided will default to \"%v\". See the [go fmt docs](https://pkg.go.dev/fmt) for details."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "string"},
+		},
+	},
+	"SynthesizeActions": {
+		Inputs: []ParameterMetadata{
+			{Name: "message", Description: "the message from the llm"},
+			{Name: "properties", Description: "the list of properties"},
+			{Name: "actions", Description: "the list of actions"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedActions", Description: "the list of synthesized actions"},
+		},
+	},
+	"SynthesizeActionsTool11": {
+		Inputs: []ParameterMetadata{
+			{Name: "content", Description: "the llm content"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "the synthesized string"},
+		},
+	},
+	"SynthesizeActionsTool12": {
+		Inputs: []ParameterMetadata{
+			{Name: "content", Description: "the llm content"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "the synthesized string"},
+		},
+	},
+	"SynthesizeActionsTool17": {
+		Inputs: []ParameterMetadata{
+			{Name: "content", Description: "the llm content"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "the synthesized string"},
+		},
+	},
+	"SynthesizeActionsTool2": {
+		Inputs: []ParameterMetadata{
+			{Name: "message", Description: "the message from the llm"},
+			{Name: "actions", Description: "the list of actions"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedActions", Description: "the list of synthesized actions"},
+		},
+	},
+	"SynthesizeActionsTool3": {
+		Inputs: []ParameterMetadata{
+			{Name: "message_1", Description: "the first message from the llm"},
+			{Name: "message_2", Description: "the second message from the llm"},
+			{Name: "target_object", Description: "the target object of the actions"},
+			{Name: "actions", Description: "the list of actions"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "updatedActions", Description: "the list of synthesized actions"},
+		},
+	},
+	"SynthesizeSlashCommand": {
+		Inputs: []ParameterMetadata{
+			{Name: "slashCmd", Description: "the slash command"},
+			{Name: "targetCmd", Description: "the target command"},
+			{Name: "finalizeResult", Description: "the finalize result from previous step"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "result", Description: "the synthesized string"},
+		},
+	},
+	"UpdateTotalTokenCountForCustomerKvDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "kvdbEndpoint", Description: "the KVDB endpoint"},
+			{Name: "apiKey", Description: "The API key of the customer"},
+			{Name: "additionalTokenCount", Description: "The number of tokens to add to the customer's total token count"},
+			{Name: "traceID", Description: "the trace ID in decimal format"},
+			{Name: "spanID", Description: "the span ID in decimal format"},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "tokenLimitReached", Description: "true if the new total token count exceeds the customer's token limit, false otherwise"},
+			{Name: "childSpanID", Description: "the child span ID created for this operation"},
+		},
+	},
+	"UpdateTotalTokenCountForCustomerMongoDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "apiKey", Description: "The API key of the customer."},
+			{Name: "mongoDbUrl", Description: "The URL of the MongoDB database."},
+			{Name: "mongoDatabaseName", Description: "The name of the MongoDB database."},
+			{Name: "mongoDbCollectionName", Description: "The name of the MongoDB collection."},
+			{Name: "additionalTokenCount", Description: "The number of additional tokens to add to the total token count."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "tokenLimitReached", Description: "A boolean indicating whether the customer has reached the token limit."},
+		},
+	},
+	"UpdateTotalTokenCountForUserIdMongoDb": {
+		Inputs: []ParameterMetadata{
+			{Name: "userId", Description: "The user ID of the customer."},
+			{Name: "mongoDbUrl", Description: "The URL of the MongoDB database."},
+			{Name: "mongoDatabaseName", Description: "The name of the MongoDB database."},
+			{Name: "mongoDbCollectionName", Description: "The name of the MongoDB collection."},
+			{Name: "additionalInputTokenCount", Description: "The number of input tokens to add to the token count."},
+			{Name: "additionalOutputTokenCount", Description: "The number of output tokens to add to the token count."},
+			{Name: "hoursUntilTokenLimitReset", Description: "The number of hours until the token limit is reset."},
+			{Name: "modelId", Description: "The IDs of the models the tokens were used for."},
+		},
+		Outputs: []ParameterMetadata{
+			{Name: "tokenLimitReached", Description: "A boolean indicating whether the customer has reached the token limit."},
+		},
+	},
+}
+
+// Enums holds the string enumerations used by the inputs of the functions, by type name
+var Enums = map[string]Enum{
+	"AppendMessageHistoryRole": {
+		Name: "AppendMessageHistoryRole",
+		Constants: []EnumConstant{
+			{Name: "user", Value: "user"},
+			{Name: "assistant", Value: "assistant"},
+			{Name: "system", Value: "system"},
+		},
+	},
+}
+
+// FunctionAdapters holds the typed adapter of every function in ExternalFunctionsMap
+var FunctionAdapters = map[string]FunctionAdapter{
+	"AddAvailableAttributesToSystemPrompt": {
+		Name:     "AddAvailableAttributesToSystemPrompt",
+		function: AddAvailableAttributesToSystemPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "userDesignRequirements", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "systemPromptTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "allAvailableAttributes", GoType: "[]MaterialAttribute", Type: reflect.TypeFor[[]sharedtypes.MaterialAttribute]()},
+			{Name: "availableSearchCriteria", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "fullSystemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := AddAvailableAttributesToSystemPrompt(inputValue[string](inputs, 0, "userDesignRequirements"), inputValue[string](inputs, 1, "systemPromptTemplate"), inputValue[[]sharedtypes.MaterialAttribute](inputs, 2, "allAvailableAttributes"), inputValue[[]string](inputs, 3, "availableSearchCriteria"), inputValue[string](inputs, 4, "traceID"), inputValue[string](inputs, 5, "spanID"))
+			return []any{output0, output1}
+		},
+	},
+	"AddDataRequest": {
+		Name:     "AddDataRequest",
+		function: AddDataRequest,
+		Inputs: []AdapterParameter{
+			{Name: "collectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "documentData", GoType: "[]DbData", Type: reflect.TypeFor[[]sharedtypes.DbData]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			AddDataRequest(ctx, inputValue[string](inputs, 0, "collectionName"), inputValue[[]sharedtypes.DbData](inputs, 1, "documentData"))
+			return nil
+		},
+	},
+	"AddGraphDbParameter": {
+		Name:     "AddGraphDbParameter",
+		function: AddGraphDbParameter,
+		Inputs: []AdapterParameter{
+			{Name: "parameters", GoType: "ParameterMap", Type: reflect.TypeFor[aali_graphdb.ParameterMap]()},
+			{Name: "name", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "value", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "paramType", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "ParameterMap", GoType: "ParameterMap", Type: reflect.TypeFor[aali_graphdb.ParameterMap]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AddGraphDbParameter(inputValue[aali_graphdb.ParameterMap](inputs, 0, "parameters"), inputValue[string](inputs, 1, "name"), inputValue[string](inputs, 2, "value"), inputValue[string](inputs, 3, "paramType"))
+			return []any{output0}
+		},
+	},
+	"AddGuidsToAttributes": {
+		Name:     "AddGuidsToAttributes",
+		function: AddGuidsToAttributes,
+		Inputs: []AdapterParameter{
+			{Name: "criteriaSuggestions", GoType: "[]MaterialLlmCriterion", Type: reflect.TypeFor[[]sharedtypes.MaterialLlmCriterion]()},
+			{Name: "availableAttributes", GoType: "[]MaterialAttribute", Type: reflect.TypeFor[[]sharedtypes.MaterialAttribute]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "criteriaWithGuids", GoType: "[]MaterialCriterionWithGuid", Type: reflect.TypeFor[[]sharedtypes.MaterialCriterionWithGuid]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := AddGuidsToAttributes(inputValue[[]sharedtypes.MaterialLlmCriterion](inputs, 0, "criteriaSuggestions"), inputValue[[]sharedtypes.MaterialAttribute](inputs, 1, "availableAttributes"), inputValue[string](inputs, 2, "traceID"), inputValue[string](inputs, 3, "spanID"))
+			return []any{output0, output1}
+		},
+	},
+	"AecGetContextFromRetrieverModule": {
+		Name:     "AecGetContextFromRetrieverModule",
+		function: AecGetContextFromRetrieverModule,
+		Inputs: []AdapterParameter{
+			{Name: "retrieverModuleEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "dataSources", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "physics", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "version", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "product", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "topK", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "plattform", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "retrieverModuleKey", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "context", GoType: "[]AnsysGPTRetrieverModuleChunk", Type: reflect.TypeFor[[]sharedtypes.AnsysGPTRetrieverModuleChunk]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AecGetContextFromRetrieverModule(ctx, inputValue[string](inputs, 0, "retrieverModuleEndpoint"), inputValue[string](inputs, 1, "userQuery"), inputValue[[]string](inputs, 2, "dataSources"), inputValue[[]string](inputs, 3, "physics"), inputValue[[]string](inputs, 4, "version"), inputValue[[]string](inputs, 5, "product"), inputValue[int](inputs, 6, "topK"), inputValue[string](inputs, 7, "plattform"), inputValue[string](inputs, 8, "retrieverModuleKey"))
+			return []any{output0}
+		},
+	},
+	"AecPerformLLMFinalRequest": {
+		Name:     "AecPerformLLMFinalRequest",
+		function: AecPerformLLMFinalRequest,
+		Inputs: []AdapterParameter{
+			{Name: "systemTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "context", GoType: "[]AnsysGPTRetrieverModuleChunk", Type: reflect.TypeFor[[]sharedtypes.AnsysGPTRetrieverModuleChunk]()},
+			{Name: "prohibitedWords", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "errorList1", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "errorList2", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "tokenCountEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "previousInputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "previousOutputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "tokenCountModelName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "userEmail", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "jwtToken", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "dontSendTokenCount", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := AecPerformLLMFinalRequest(ctx, inputValue[string](inputs, 0, "systemTemplate"), inputValue[string](inputs, 1, "userTemplate"), inputValue[string](inputs, 2, "query"), inputValue[[]sharedtypes.HistoricMessage](inputs, 3, "history"), inputValue[[]sharedtypes.AnsysGPTRetrieverModuleChunk](inputs, 4, "context"), inputValue[[]string](inputs, 5, "prohibitedWords"), inputValue[[]string](inputs, 6, "errorList1"), inputValue[[]string](inputs, 7, "errorList2"), inputValue[string](inputs, 8, "tokenCountEndpoint"), inputValue[int](inputs, 9, "previousInputTokenCount"), inputValue[int](inputs, 10, "previousOutputTokenCount"), inputValue[string](inputs, 11, "tokenCountModelName"), inputValue[bool](inputs, 12, "isStream"), inputValue[string](inputs, 13, "userEmail"), inputValue[string](inputs, 14, "jwtToken"), inputValue[bool](inputs, 15, "dontSendTokenCount"))
+			return []any{output0, output1}
+		},
+	},
+	"AisAcsSemanticHybridSearchs": {
+		Name:     "AisAcsSemanticHybridSearchs",
+		function: AisAcsSemanticHybridSearchs,
+		Inputs: []AdapterParameter{
+			{Name: "acsEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "acsApiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "acsApiVersion", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "embeddedQuery", GoType: "[]float32", Type: reflect.TypeFor[[]float32]()},
+			{Name: "indexList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "physics", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "topK", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "[]ACSSearchResponse", GoType: "[]ACSSearchResponse", Type: reflect.TypeFor[[]sharedtypes.ACSSearchResponse]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AisAcsSemanticHybridSearchs(ctx, inputValue[string](inputs, 0, "acsEndpoint"), inputValue[string](inputs, 1, "acsApiKey"), inputValue[string](inputs, 2, "acsApiVersion"), inputValue[string](inputs, 3, "query"), inputValue[[]float32](inputs, 4, "embeddedQuery"), inputValue[[]string](inputs, 5, "indexList"), inputValue[[]string](inputs, 6, "physics"), inputValue[int](inputs, 7, "topK"))
+			return []any{output0}
+		},
+	},
+	"AisChangeAcsResponsesByFactor": {
+		Name:     "AisChangeAcsResponsesByFactor",
+		function: AisChangeAcsResponsesByFactor,
+		Inputs: []AdapterParameter{
+			{Name: "factors", GoType: "map[string]float64", Type: reflect.TypeFor[map[string]float64]()},
+			{Name: "semanticSearchOutput", GoType: "[]ACSSearchResponse", Type: reflect.TypeFor[[]sharedtypes.ACSSearchResponse]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "changedSemanticSearchOutput", GoType: "[]ACSSearchResponse", Type: reflect.TypeFor[[]sharedtypes.ACSSearchResponse]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AisChangeAcsResponsesByFactor(inputMap[map[string]float64](inputs, 0, "factors"), inputValue[[]sharedtypes.ACSSearchResponse](inputs, 1, "semanticSearchOutput"))
+			return []any{output0}
+		},
+	},
+	"AisPerformLLMRephraseRequest": {
+		Name:     "AisPerformLLMRephraseRequest",
+		function: AisPerformLLMRephraseRequest,
+		Inputs: []AdapterParameter{
+			{Name: "systemTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "tokenCountModelName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "rephrasedQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "inputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "outputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := AisPerformLLMRephraseRequest(ctx, inputValue[string](inputs, 0, "systemTemplate"), inputValue[string](inputs, 1, "userTemplate"), inputValue[string](inputs, 2, "query"), inputValue[[]sharedtypes.HistoricMessage](inputs, 3, "history"), inputValue[string](inputs, 4, "tokenCountModelName"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"AisReturnIndexList": {
+		Name:     "AisReturnIndexList",
+		function: AisReturnIndexList,
+		Inputs: []AdapterParameter{
+			{Name: "accessPoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "physics", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "version", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "indexList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AisReturnIndexList(inputValue[string](inputs, 0, "accessPoint"), inputValue[[]string](inputs, 1, "physics"), inputValue[[]string](inputs, 2, "version"))
+			return []any{output0}
+		},
+	},
+	"AnsysGPTACSSemanticHybridSearchs": {
+		Name:     "AnsysGPTACSSemanticHybridSearchs",
+		function: AnsysGPTACSSemanticHybridSearchs,
+		Inputs: []AdapterParameter{
+			{Name: "acsEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "acsApiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "acsApiVersion", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "embeddedQuery", GoType: "[]float32", Type: reflect.TypeFor[[]float32]()},
+			{Name: "indexList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "filter", GoType: "map[string]string", Type: reflect.TypeFor[map[string]string]()},
+			{Name: "topK", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "output", GoType: "[]ACSSearchResponse", Type: reflect.TypeFor[[]sharedtypes.ACSSearchResponse]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AnsysGPTACSSemanticHybridSearchs(ctx, inputValue[string](inputs, 0, "acsEndpoint"), inputValue[string](inputs, 1, "acsApiKey"), inputValue[string](inputs, 2, "acsApiVersion"), inputValue[string](inputs, 3, "query"), inputValue[[]float32](inputs, 4, "embeddedQuery"), inputValue[[]string](inputs, 5, "indexList"), inputMap[map[string]string](inputs, 6, "filter"), inputValue[int](inputs, 7, "topK"))
+			return []any{output0}
+		},
+	},
+	"AnsysGPTBuildFinalQuery": {
+		Name:     "AnsysGPTBuildFinalQuery",
+		function: AnsysGPTBuildFinalQuery,
+		Inputs: []AdapterParameter{
+			{Name: "refrasedQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "context", GoType: "[]ACSSearchResponse", Type: reflect.TypeFor[[]sharedtypes.ACSSearchResponse]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "finalQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "errorResponse", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "displayFixedMessageToUser", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := AnsysGPTBuildFinalQuery(inputValue[string](inputs, 0, "refrasedQuery"), inputValue[[]sharedtypes.ACSSearchResponse](inputs, 1, "context"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"AnsysGPTCheckProhibitedWords": {
+		Name:     "AnsysGPTCheckProhibitedWords",
+		function: AnsysGPTCheckProhibitedWords,
+		Inputs: []AdapterParameter{
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "prohibitedWords", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "errorResponseMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "foundProhibited", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "responseMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := AnsysGPTCheckProhibitedWords(inputValue[string](inputs, 0, "query"), inputValue[[]string](inputs, 1, "prohibitedWords"), inputValue[string](inputs, 2, "errorResponseMessage"))
+			return []any{output0, output1}
+		},
+	},
+	"AnsysGPTExtractFieldsFromQuery": {
+		Name:     "AnsysGPTExtractFieldsFromQuery",
+		function: AnsysGPTExtractFieldsFromQuery,
+		Inputs: []AdapterParameter{
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "fieldValues", GoType: "map[string][]string", Type: reflect.TypeFor[map[string][]string]()},
+			{Name: "defaultFields", GoType: "[]AnsysGPTDefaultFields", Type: reflect.TypeFor[[]sharedtypes.AnsysGPTDefaultFields]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "fields", GoType: "map[string]string", Type: reflect.TypeFor[map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AnsysGPTExtractFieldsFromQuery(inputValue[string](inputs, 0, "query"), inputMap[map[string][]string](inputs, 1, "fieldValues"), inputValue[[]sharedtypes.AnsysGPTDefaultFields](inputs, 2, "defaultFields"))
+			return []any{output0}
+		},
+	},
+	"AnsysGPTGetSystemPrompt": {
+		Name:     "AnsysGPTGetSystemPrompt",
+		function: AnsysGPTGetSystemPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "prohibitedWords", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "template", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AnsysGPTGetSystemPrompt(inputValue[string](inputs, 0, "query"), inputValue[[]string](inputs, 1, "prohibitedWords"), inputValue[string](inputs, 2, "template"))
+			return []any{output0}
+		},
+	},
+	"AnsysGPTPerformLLMRephraseRequest": {
+		Name:     "AnsysGPTPerformLLMRephraseRequest",
+		function: AnsysGPTPerformLLMRephraseRequest,
+		Inputs: []AdapterParameter{
+			{Name: "userTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "rephrasedQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AnsysGPTPerformLLMRephraseRequest(ctx, inputValue[string](inputs, 0, "userTemplate"), inputValue[string](inputs, 1, "query"), inputValue[[]sharedtypes.HistoricMessage](inputs, 2, "history"), inputValue[string](inputs, 3, "systemPrompt"))
+			return []any{output0}
+		},
+	},
+	"AnsysGPTPerformLLMRephraseRequestNew": {
+		Name:     "AnsysGPTPerformLLMRephraseRequestNew",
+		function: AnsysGPTPerformLLMRephraseRequestNew,
+		Inputs: []AdapterParameter{
+			{Name: "template", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "rephrasedQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AnsysGPTPerformLLMRephraseRequestNew(ctx, inputValue[string](inputs, 0, "template"), inputValue[string](inputs, 1, "query"), inputValue[[]sharedtypes.HistoricMessage](inputs, 2, "history"))
+			return []any{output0}
+		},
+	},
+	"AnsysGPTPerformLLMRequest": {
+		Name:     "AnsysGPTPerformLLMRequest",
+		function: AnsysGPTPerformLLMRequest,
+		Inputs: []AdapterParameter{
+			{Name: "finalQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := AnsysGPTPerformLLMRequest(ctx, inputValue[string](inputs, 0, "finalQuery"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[string](inputs, 2, "systemPrompt"), inputValue[bool](inputs, 3, "isStream"))
+			return []any{output0, output1}
+		},
+	},
+	"AnsysGPTReorderSearchResponseAndReturnOnlyTopK": {
+		Name:     "AnsysGPTReorderSearchResponseAndReturnOnlyTopK",
+		function: AnsysGPTReorderSearchResponseAndReturnOnlyTopK,
+		Inputs: []AdapterParameter{
+			{Name: "semanticSearchOutput", GoType: "[]ACSSearchResponse", Type: reflect.TypeFor[[]sharedtypes.ACSSearchResponse]()},
+			{Name: "topK", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "reorderedSemanticSearchOutput", GoType: "[]ACSSearchResponse", Type: reflect.TypeFor[[]sharedtypes.ACSSearchResponse]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AnsysGPTReorderSearchResponseAndReturnOnlyTopK(inputValue[[]sharedtypes.ACSSearchResponse](inputs, 0, "semanticSearchOutput"), inputValue[int](inputs, 1, "topK"))
+			return []any{output0}
+		},
+	},
+	"AnsysGPTReturnIndexList": {
+		Name:     "AnsysGPTReturnIndexList",
+		function: AnsysGPTReturnIndexList,
+		Inputs: []AdapterParameter{
+			{Name: "indexGroups", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "indexList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AnsysGPTReturnIndexList(inputValue[[]string](inputs, 0, "indexGroups"))
+			return []any{output0}
+		},
+	},
+	"AppendMeshPilotHistory": {
+		Name:     "AppendMeshPilotHistory",
+		function: AppendMeshPilotHistory,
+		Inputs: []AdapterParameter{
+			{Name: "history", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+			{Name: "role", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "content", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedHistory", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AppendMeshPilotHistory(inputValue[[]map[string]string](inputs, 0, "history"), inputValue[string](inputs, 1, "role"), inputValue[string](inputs, 2, "content"))
+			return []any{output0}
+		},
+	},
+	"AppendMessageHistory": {
+		Name:     "AppendMessageHistory",
+		function: AppendMessageHistory,
+		Inputs: []AdapterParameter{
+			{Name: "newMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "role", GoType: "string", Options: []string{"user", "assistant", "system"}, Type: reflect.TypeFor[AppendMessageHistoryRole]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedHistory", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AppendMessageHistory(inputValue[string](inputs, 0, "newMessage"), enumValue[AppendMessageHistoryRole](inputs, 1, "role", "AppendMessageHistoryRole"), inputValue[[]sharedtypes.HistoricMessage](inputs, 2, "history"))
+			return []any{output0}
+		},
+	},
+	"AppendStringSlices": {
+		Name:     "AppendStringSlices",
+		function: AppendStringSlices,
+		Inputs: []AdapterParameter{
+			{Name: "slice1", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "slice2", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "slice3", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "slice4", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "slice5", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "[]string", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AppendStringSlices(inputValue[[]string](inputs, 0, "slice1"), inputValue[[]string](inputs, 1, "slice2"), inputValue[[]string](inputs, 2, "slice3"), inputValue[[]string](inputs, 3, "slice4"), inputValue[[]string](inputs, 4, "slice5"))
+			return []any{output0}
+		},
+	},
+	"AppendToolHistory": {
+		Name:     "AppendToolHistory",
+		function: AppendToolHistory,
+		Inputs: []AdapterParameter{
+			{Name: "toolHistory", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+			{Name: "toolId", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "toolName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "toolArguments", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "toolResponse", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedToolHistory", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AppendToolHistory(inputValue[[]map[string]string](inputs, 0, "toolHistory"), inputValue[string](inputs, 1, "toolId"), inputValue[string](inputs, 2, "toolName"), inputValue[string](inputs, 3, "toolArguments"), inputValue[string](inputs, 4, "toolResponse"))
+			return []any{output0}
+		},
+	},
+	"AssignStringToString": {
+		Name:     "AssignStringToString",
+		function: AssignStringToString,
+		Inputs: []AdapterParameter{
+			{Name: "inputString", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "outputString", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := AssignStringToString(inputValue[string](inputs, 0, "inputString"))
+			return []any{output0}
+		},
+	},
+	"BuildFinalQueryForCodeLLMRequest": {
+		Name:     "BuildFinalQueryForCodeLLMRequest",
+		function: BuildFinalQueryForCodeLLMRequest,
+		Inputs: []AdapterParameter{
+			{Name: "request", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "knowledgedbResponse", GoType: "[]DbResponse", Type: reflect.TypeFor[[]sharedtypes.DbResponse]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "finalQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := BuildFinalQueryForCodeLLMRequest(inputValue[string](inputs, 0, "request"), inputValue[[]sharedtypes.DbResponse](inputs, 1, "knowledgedbResponse"))
+			return []any{output0}
+		},
+	},
+	"BuildFinalQueryForGeneralLLMRequest": {
+		Name:     "BuildFinalQueryForGeneralLLMRequest",
+		function: BuildFinalQueryForGeneralLLMRequest,
+		Inputs: []AdapterParameter{
+			{Name: "request", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "knowledgedbResponse", GoType: "[]DbResponse", Type: reflect.TypeFor[[]sharedtypes.DbResponse]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "finalQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := BuildFinalQueryForGeneralLLMRequest(inputValue[string](inputs, 0, "request"), inputValue[[]sharedtypes.DbResponse](inputs, 1, "knowledgedbResponse"))
+			return []any{output0}
+		},
+	},
+	"BuildLibraryContext": {
+		Name:     "BuildLibraryContext",
+		function: BuildLibraryContext,
+		Inputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "libraryContext", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "messageWithContext", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := BuildLibraryContext(inputValue[string](inputs, 0, "message"), inputValue[string](inputs, 1, "libraryContext"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToBool": {
+		Name:     "CastAnyToBool",
+		function: CastAnyToBool,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "bool", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToBool(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToByte": {
+		Name:     "CastAnyToByte",
+		function: CastAnyToByte,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "byte", GoType: "byte", Type: reflect.TypeFor[byte]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToByte(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToComplex128": {
+		Name:     "CastAnyToComplex128",
+		function: CastAnyToComplex128,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "complex128", GoType: "complex128", Type: reflect.TypeFor[complex128]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToComplex128(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToComplex64": {
+		Name:     "CastAnyToComplex64",
+		function: CastAnyToComplex64,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "complex64", GoType: "complex64", Type: reflect.TypeFor[complex64]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToComplex64(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToFloat32": {
+		Name:     "CastAnyToFloat32",
+		function: CastAnyToFloat32,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "float32", GoType: "float32", Type: reflect.TypeFor[float32]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToFloat32(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToFloat64": {
+		Name:     "CastAnyToFloat64",
+		function: CastAnyToFloat64,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "float64", GoType: "float64", Type: reflect.TypeFor[float64]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToFloat64(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToInt": {
+		Name:     "CastAnyToInt",
+		function: CastAnyToInt,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "int", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToInt(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToInt16": {
+		Name:     "CastAnyToInt16",
+		function: CastAnyToInt16,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "int16", GoType: "int16", Type: reflect.TypeFor[int16]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToInt16(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToInt32": {
+		Name:     "CastAnyToInt32",
+		function: CastAnyToInt32,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "int32", GoType: "int32", Type: reflect.TypeFor[int32]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToInt32(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToInt64": {
+		Name:     "CastAnyToInt64",
+		function: CastAnyToInt64,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "int64", GoType: "int64", Type: reflect.TypeFor[int64]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToInt64(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToInt8": {
+		Name:     "CastAnyToInt8",
+		function: CastAnyToInt8,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "int8", GoType: "int8", Type: reflect.TypeFor[int8]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToInt8(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToInterface": {
+		Name:     "CastAnyToInterface",
+		function: CastAnyToInterface,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "interface{}", GoType: "interface{}", Type: reflect.TypeFor[interface{}]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToInterface(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToRune": {
+		Name:     "CastAnyToRune",
+		function: CastAnyToRune,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "rune", GoType: "rune", Type: reflect.TypeFor[rune]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToRune(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToString": {
+		Name:     "CastAnyToString",
+		function: CastAnyToString,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "string", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToString(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToUint": {
+		Name:     "CastAnyToUint",
+		function: CastAnyToUint,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "uint", GoType: "uint", Type: reflect.TypeFor[uint]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToUint(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToUint16": {
+		Name:     "CastAnyToUint16",
+		function: CastAnyToUint16,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "uint16", GoType: "uint16", Type: reflect.TypeFor[uint16]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToUint16(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToUint32": {
+		Name:     "CastAnyToUint32",
+		function: CastAnyToUint32,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "uint32", GoType: "uint32", Type: reflect.TypeFor[uint32]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToUint32(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToUint64": {
+		Name:     "CastAnyToUint64",
+		function: CastAnyToUint64,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "uint64", GoType: "uint64", Type: reflect.TypeFor[uint64]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToUint64(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastAnyToUint8": {
+		Name:     "CastAnyToUint8",
+		function: CastAnyToUint8,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "uint8", GoType: "uint8", Type: reflect.TypeFor[uint8]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastAnyToUint8(inputValue[any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastArrayMapStringAnyToAny": {
+		Name:     "CastArrayMapStringAnyToAny",
+		function: CastArrayMapStringAnyToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "[]map[string]any", Type: reflect.TypeFor[[]map[string]any]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastArrayMapStringAnyToAny(inputValue[[]map[string]any](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastBoolToAny": {
+		Name:     "CastBoolToAny",
+		function: CastBoolToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastBoolToAny(inputValue[bool](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastByteToAny": {
+		Name:     "CastByteToAny",
+		function: CastByteToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "byte", Type: reflect.TypeFor[byte]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastByteToAny(inputValue[byte](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastComplex128ToAny": {
+		Name:     "CastComplex128ToAny",
+		function: CastComplex128ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "complex128", Type: reflect.TypeFor[complex128]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastComplex128ToAny(inputValue[complex128](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastComplex64ToAny": {
+		Name:     "CastComplex64ToAny",
+		function: CastComplex64ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "complex64", Type: reflect.TypeFor[complex64]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastComplex64ToAny(inputValue[complex64](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastFloat32ToAny": {
+		Name:     "CastFloat32ToAny",
+		function: CastFloat32ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "float32", Type: reflect.TypeFor[float32]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastFloat32ToAny(inputValue[float32](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastFloat64ToAny": {
+		Name:     "CastFloat64ToAny",
+		function: CastFloat64ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "float64", Type: reflect.TypeFor[float64]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastFloat64ToAny(inputValue[float64](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastInt16ToAny": {
+		Name:     "CastInt16ToAny",
+		function: CastInt16ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "int16", Type: reflect.TypeFor[int16]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastInt16ToAny(inputValue[int16](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastInt32ToAny": {
+		Name:     "CastInt32ToAny",
+		function: CastInt32ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "int32", Type: reflect.TypeFor[int32]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastInt32ToAny(inputValue[int32](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastInt64ToAny": {
+		Name:     "CastInt64ToAny",
+		function: CastInt64ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "int64", Type: reflect.TypeFor[int64]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastInt64ToAny(inputValue[int64](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastInt8ToAny": {
+		Name:     "CastInt8ToAny",
+		function: CastInt8ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "int8", Type: reflect.TypeFor[int8]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastInt8ToAny(inputValue[int8](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastIntToAny": {
+		Name:     "CastIntToAny",
+		function: CastIntToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastIntToAny(inputValue[int](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastInterfaceToAny": {
+		Name:     "CastInterfaceToAny",
+		function: CastInterfaceToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "interface{}", Type: reflect.TypeFor[interface{}]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastInterfaceToAny(inputValue[interface{}](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastRuneToAny": {
+		Name:     "CastRuneToAny",
+		function: CastRuneToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "rune", Type: reflect.TypeFor[rune]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastRuneToAny(inputValue[rune](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastStringToAny": {
+		Name:     "CastStringToAny",
+		function: CastStringToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastStringToAny(inputValue[string](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastUint16ToAny": {
+		Name:     "CastUint16ToAny",
+		function: CastUint16ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "uint16", Type: reflect.TypeFor[uint16]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastUint16ToAny(inputValue[uint16](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastUint32ToAny": {
+		Name:     "CastUint32ToAny",
+		function: CastUint32ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "uint32", Type: reflect.TypeFor[uint32]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastUint32ToAny(inputValue[uint32](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastUint64ToAny": {
+		Name:     "CastUint64ToAny",
+		function: CastUint64ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "uint64", Type: reflect.TypeFor[uint64]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastUint64ToAny(inputValue[uint64](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastUint8ToAny": {
+		Name:     "CastUint8ToAny",
+		function: CastUint8ToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "uint8", Type: reflect.TypeFor[uint8]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastUint8ToAny(inputValue[uint8](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CastUintToAny": {
+		Name:     "CastUintToAny",
+		function: CastUintToAny,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "uint", Type: reflect.TypeFor[uint]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CastUintToAny(inputValue[uint](inputs, 0, "data"))
+			return []any{output0}
+		},
+	},
+	"CheckApiKeyAuthKvDb": {
+		Name:     "CheckApiKeyAuthKvDb",
+		function: CheckApiKeyAuthKvDb,
+		Inputs: []AdapterParameter{
+			{Name: "kvdbEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "apiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "isAuthenticated", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := CheckApiKeyAuthKvDb(ctx, inputValue[string](inputs, 0, "kvdbEndpoint"), inputValue[string](inputs, 1, "apiKey"), inputValue[string](inputs, 2, "traceID"), inputValue[string](inputs, 3, "spanID"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"CheckApiKeyAuthMongoDb": {
+		Name:     "CheckApiKeyAuthMongoDb",
+		function: CheckApiKeyAuthMongoDb,
+		Inputs: []AdapterParameter{
+			{Name: "apiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbUrl", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDatabaseName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "isAuthenticated", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CheckApiKeyAuthMongoDb(ctx, inputValue[string](inputs, 0, "apiKey"), inputValue[string](inputs, 1, "mongoDbUrl"), inputValue[string](inputs, 2, "mongoDatabaseName"), inputValue[string](inputs, 3, "mongoDbCollectionName"))
+			return []any{output0}
+		},
+	},
+	"CheckCreateUserIdMongoDb": {
+		Name:     "CheckCreateUserIdMongoDb",
+		function: CheckCreateUserIdMongoDb,
+		Inputs: []AdapterParameter{
+			{Name: "userId", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "temporaryTokenLimit", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "hoursUntilTokenLimitReset", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "modelId", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "mongoDbUrl", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDatabaseName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "existingUser", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CheckCreateUserIdMongoDb(ctx, inputValue[string](inputs, 0, "userId"), inputValue[int](inputs, 1, "temporaryTokenLimit"), inputValue[int](inputs, 2, "hoursUntilTokenLimitReset"), inputValue[[]string](inputs, 3, "modelId"), inputValue[string](inputs, 4, "mongoDbUrl"), inputValue[string](inputs, 5, "mongoDatabaseName"), inputValue[string](inputs, 6, "mongoDbCollectionName"))
+			return []any{output0}
+		},
+	},
+	"CheckTokenLimitReached": {
+		Name:     "CheckTokenLimitReached",
+		function: CheckTokenLimitReached,
+		Inputs: []AdapterParameter{
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "tokenLimit", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "modelName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "tokenLimitMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "tokenLimitReached", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "responseMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := CheckTokenLimitReached(inputValue[string](inputs, 0, "query"), inputValue[int](inputs, 1, "tokenLimit"), inputValue[string](inputs, 2, "modelName"), inputValue[string](inputs, 3, "tokenLimitMessage"))
+			return []any{output0, output1}
+		},
+	},
+	"CreateCollectionRequest": {
+		Name:     "CreateCollectionRequest",
+		function: CreateCollectionRequest,
+		Inputs: []AdapterParameter{
+			{Name: "collectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "vectorSize", GoType: "uint64", Type: reflect.TypeFor[uint64]()},
+			{Name: "vectorDistance", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			CreateCollectionRequest(ctx, inputValue[string](inputs, 0, "collectionName"), inputValue[uint64](inputs, 1, "vectorSize"), inputValue[string](inputs, 2, "vectorDistance"))
+			return nil
+		},
+	},
+	"CreateDbFilter": {
+		Name:     "CreateDbFilter",
+		function: CreateDbFilter,
+		Inputs: []AdapterParameter{
+			{Name: "guid", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "documentId", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "documentName", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "level", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "tags", GoType: "DbArrayFilter", Type: reflect.TypeFor[sharedtypes.DbArrayFilter]()},
+			{Name: "keywords", GoType: "DbArrayFilter", Type: reflect.TypeFor[sharedtypes.DbArrayFilter]()},
+			{Name: "metadata", GoType: "[]DbJsonFilter", Type: reflect.TypeFor[[]sharedtypes.DbJsonFilter]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "databaseFilter", GoType: "DbFilters", Type: reflect.TypeFor[sharedtypes.DbFilters]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CreateDbFilter(inputValue[[]string](inputs, 0, "guid"), inputValue[[]string](inputs, 1, "documentId"), inputValue[[]string](inputs, 2, "documentName"), inputValue[[]string](inputs, 3, "level"), inputValue[sharedtypes.DbArrayFilter](inputs, 4, "tags"), inputValue[sharedtypes.DbArrayFilter](inputs, 5, "keywords"), inputValue[[]sharedtypes.DbJsonFilter](inputs, 6, "metadata"))
+			return []any{output0}
+		},
+	},
+	"CreateGeneralDataExtractionDocumentObjects": {
+		Name:     "CreateGeneralDataExtractionDocumentObjects",
+		function: CreateGeneralDataExtractionDocumentObjects,
+		Inputs: []AdapterParameter{
+			{Name: "documentName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "documentChunks", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "denseEmbeddings", GoType: "[][]float32", Type: reflect.TypeFor[[][]float32]()},
+			{Name: "sparseEmbeddings", GoType: "[]map[uint]float32", Type: reflect.TypeFor[[]map[uint]float32]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "extractionData", GoType: "[]interface{}", Type: reflect.TypeFor[[]interface{}]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CreateGeneralDataExtractionDocumentObjects(inputValue[string](inputs, 0, "documentName"), inputValue[[]string](inputs, 1, "documentChunks"), inputValue[[][]float32](inputs, 2, "denseEmbeddings"), inputValue[[]map[uint]float32](inputs, 3, "sparseEmbeddings"))
+			return []any{output0}
+		},
+	},
+	"CreateKeywordsDbFilter": {
+		Name:     "CreateKeywordsDbFilter",
+		function: CreateKeywordsDbFilter,
+		Inputs: []AdapterParameter{
+			{Name: "keywords", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "needAll", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "databaseFilter", GoType: "DbArrayFilter", Type: reflect.TypeFor[sharedtypes.DbArrayFilter]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CreateKeywordsDbFilter(inputValue[[]string](inputs, 0, "keywords"), inputValue[bool](inputs, 1, "needAll"))
+			return []any{output0}
+		},
+	},
+	"CreateMessageWithVariable": {
+		Name:     "CreateMessageWithVariable",
+		function: CreateMessageWithVariable,
+		Inputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "variable", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CreateMessageWithVariable(inputValue[string](inputs, 0, "message"), inputValue[string](inputs, 1, "variable"))
+			return []any{output0}
+		},
+	},
+	"CreateMetadataDbFilter": {
+		Name:     "CreateMetadataDbFilter",
+		function: CreateMetadataDbFilter,
+		Inputs: []AdapterParameter{
+			{Name: "fieldName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "fieldType", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "filterData", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "needAll", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "databaseFilter", GoType: "DbJsonFilter", Type: reflect.TypeFor[sharedtypes.DbJsonFilter]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CreateMetadataDbFilter(inputValue[string](inputs, 0, "fieldName"), inputValue[string](inputs, 1, "fieldType"), inputValue[[]string](inputs, 2, "filterData"), inputValue[bool](inputs, 3, "needAll"))
+			return []any{output0}
+		},
+	},
+	"CreateTagsDbFilter": {
+		Name:     "CreateTagsDbFilter",
+		function: CreateTagsDbFilter,
+		Inputs: []AdapterParameter{
+			{Name: "tags", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "needAll", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "databaseFilter", GoType: "DbArrayFilter", Type: reflect.TypeFor[sharedtypes.DbArrayFilter]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CreateTagsDbFilter(inputValue[[]string](inputs, 0, "tags"), inputValue[bool](inputs, 1, "needAll"))
+			return []any{output0}
+		},
+	},
+	"CutLatestMessagesFromHistory": {
+		Name:     "CutLatestMessagesFromHistory",
+		function: CutLatestMessagesFromHistory,
+		Inputs: []AdapterParameter{
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "numberOfMessages", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedHistory", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := CutLatestMessagesFromHistory(inputValue[[]sharedtypes.HistoricMessage](inputs, 0, "history"), inputValue[int](inputs, 1, "numberOfMessages"))
+			return []any{output0}
+		},
+	},
+	"DataPluginCheckQueryType": {
+		Name:     "DataPluginCheckQueryType",
+		function: DataPluginCheckQueryType,
+		Inputs: []AdapterParameter{
+			{Name: "queryType", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "errorResponseMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "isGreet", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "isGated", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "errorMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := DataPluginCheckQueryType(inputValue[string](inputs, 0, "queryType"), inputValue[string](inputs, 1, "errorResponseMessage"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"DataPluginConvertCitationsToContext": {
+		Name:     "DataPluginConvertCitationsToContext",
+		function: DataPluginConvertCitationsToContext,
+		Inputs: []AdapterParameter{
+			{Name: "citations", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "context", GoType: "[]AnsysGPTRetrieverModuleChunk", Type: reflect.TypeFor[[]sharedtypes.AnsysGPTRetrieverModuleChunk]()},
+			{Name: "resetCitations", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := DataPluginConvertCitationsToContext(inputValue[string](inputs, 0, "citations"))
+			return []any{output0, output1}
+		},
+	},
+	"DataPluginGetContext": {
+		Name:     "DataPluginGetContext",
+		function: DataPluginGetContext,
+		Inputs: []AdapterParameter{
+			{Name: "userQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "apiUrl", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "username", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "password", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "topK", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "physics", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "dataSource", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "context", GoType: "[]AnsysGPTRetrieverModuleChunk", Type: reflect.TypeFor[[]sharedtypes.AnsysGPTRetrieverModuleChunk]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := DataPluginGetContext(ctx, inputValue[string](inputs, 0, "userQuery"), inputValue[string](inputs, 1, "apiUrl"), inputValue[string](inputs, 2, "username"), inputValue[string](inputs, 3, "password"), inputValue[int](inputs, 4, "topK"), inputValue[[]string](inputs, 5, "physics"), inputValue[[]string](inputs, 6, "dataSource"))
+			return []any{output0}
+		},
+	},
+	"DataPluginGetFinalSystemPrompt": {
+		Name:     "DataPluginGetFinalSystemPrompt",
+		function: DataPluginGetFinalSystemPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "responseType", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "classicTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "listTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := DataPluginGetFinalSystemPrompt(inputValue[string](inputs, 0, "responseType"), inputValue[string](inputs, 1, "classicTemplate"), inputValue[string](inputs, 2, "listTemplate"))
+			return []any{output0}
+		},
+	},
+	"DataPluginPerformLLMFinalRequest": {
+		Name:     "DataPluginPerformLLMFinalRequest",
+		function: DataPluginPerformLLMFinalRequest,
+		Inputs: []AdapterParameter{
+			{Name: "systemTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "context", GoType: "[]AnsysGPTRetrieverModuleChunk", Type: reflect.TypeFor[[]sharedtypes.AnsysGPTRetrieverModuleChunk]()},
+			{Name: "prohibitedWords", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := DataPluginPerformLLMFinalRequest(ctx, inputValue[string](inputs, 0, "systemTemplate"), inputValue[string](inputs, 1, "userTemplate"), inputValue[string](inputs, 2, "query"), inputValue[[]sharedtypes.HistoricMessage](inputs, 3, "history"), inputValue[[]sharedtypes.AnsysGPTRetrieverModuleChunk](inputs, 4, "context"), inputValue[[]string](inputs, 5, "prohibitedWords"), inputValue[bool](inputs, 6, "isStream"))
+			return []any{output0, output1}
+		},
+	},
+	"DataPluginPerformLLMRephraseRequest": {
+		Name:     "DataPluginPerformLLMRephraseRequest",
+		function: DataPluginPerformLLMRephraseRequest,
+		Inputs: []AdapterParameter{
+			{Name: "systemTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "rephrasedQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := DataPluginPerformLLMRephraseRequest(ctx, inputValue[string](inputs, 0, "systemTemplate"), inputValue[string](inputs, 1, "userTemplate"), inputValue[string](inputs, 2, "query"), inputValue[[]sharedtypes.HistoricMessage](inputs, 3, "history"))
+			return []any{output0}
+		},
+	},
+	"DenyCustomerAccessAndSendWarningKvDb": {
+		Name:     "DenyCustomerAccessAndSendWarningKvDb",
+		function: DenyCustomerAccessAndSendWarningKvDb,
+		Inputs: []AdapterParameter{
+			{Name: "kvdbEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "apiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "customerName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "sendWarning", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := DenyCustomerAccessAndSendWarningKvDb(ctx, inputValue[string](inputs, 0, "kvdbEndpoint"), inputValue[string](inputs, 1, "apiKey"), inputValue[string](inputs, 2, "traceID"), inputValue[string](inputs, 3, "spanID"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDb": {
+		Name:     "DenyCustomerAccessAndSendWarningMongoDb",
+		function: DenyCustomerAccessAndSendWarningMongoDb,
+		Inputs: []AdapterParameter{
+			{Name: "apiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbUrl", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDatabaseName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "customerName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "sendWarning", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := DenyCustomerAccessAndSendWarningMongoDb(ctx, inputValue[string](inputs, 0, "apiKey"), inputValue[string](inputs, 1, "mongoDbUrl"), inputValue[string](inputs, 2, "mongoDatabaseName"), inputValue[string](inputs, 3, "mongoDbCollectionName"))
+			return []any{output0, output1}
+		},
+	},
+	"DenyCustomerAccessAndSendWarningMongoDbUserId": {
+		Name:     "DenyCustomerAccessAndSendWarningMongoDbUserId",
+		function: DenyCustomerAccessAndSendWarningMongoDbUserId,
+		Inputs: []AdapterParameter{
+			{Name: "userId", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbUrl", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDatabaseName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "sendWarning", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := DenyCustomerAccessAndSendWarningMongoDbUserId(ctx, inputValue[string](inputs, 0, "userId"), inputValue[string](inputs, 1, "mongoDbUrl"), inputValue[string](inputs, 2, "mongoDatabaseName"), inputValue[string](inputs, 3, "mongoDbCollectionName"))
+			return []any{output0}
+		},
+	},
+	"DownloadGithubFileContent": {
+		Name:     "DownloadGithubFileContent",
+		function: DownloadGithubFileContent,
+		Inputs: []AdapterParameter{
+			{Name: "githubRepoName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoOwner", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoBranch", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "gihubFilePath", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubAccessToken", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "checksum", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "content", GoType: "[]byte", Type: reflect.TypeFor[[]byte]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := DownloadGithubFileContent(ctx, inputValue[string](inputs, 0, "githubRepoName"), inputValue[string](inputs, 1, "githubRepoOwner"), inputValue[string](inputs, 2, "githubRepoBranch"), inputValue[string](inputs, 3, "gihubFilePath"), inputValue[string](inputs, 4, "githubAccessToken"))
+			return []any{output0, output1}
+		},
+	},
+	"DownloadGithubFilesContent": {
+		Name:     "DownloadGithubFilesContent",
+		function: DownloadGithubFilesContent,
+		Inputs: []AdapterParameter{
+			{Name: "githubRepoName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoOwner", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoBranch", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "gihubFilePaths", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "githubAccessToken", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "filesMap", GoType: "map[string][]byte", Type: reflect.TypeFor[map[string][]byte]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := DownloadGithubFilesContent(ctx, inputValue[string](inputs, 0, "githubRepoName"), inputValue[string](inputs, 1, "githubRepoOwner"), inputValue[string](inputs, 2, "githubRepoBranch"), inputValue[[]string](inputs, 3, "gihubFilePaths"), inputValue[string](inputs, 4, "githubAccessToken"))
+			return []any{output0}
+		},
+	},
+	"ExecuteTool": {
+		Name:     "ExecuteTool",
+		function: ExecuteTool,
+		Inputs: []AdapterParameter{
+			{Name: "serverURL", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "toolName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "args", GoType: "map[string]interface{}", Type: reflect.TypeFor[map[string]interface{}]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "map[string]interface{}", GoType: "map[string]interface{}", Type: reflect.TypeFor[map[string]interface{}]()},
+			{Name: "error", GoType: "error", Type: reflect.TypeFor[error]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := ExecuteTool(ctx, inputValue[string](inputs, 0, "serverURL"), inputValue[string](inputs, 1, "toolName"), inputMap[map[string]interface{}](inputs, 2, "args"))
+			return []any{output0, output1}
+		},
+	},
+	"ExtractCriteriaSuggestions": {
+		Name:     "ExtractCriteriaSuggestions",
+		function: ExtractCriteriaSuggestions,
+		Inputs: []AdapterParameter{
+			{Name: "llmResponse", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "criteriaSuggestions", GoType: "[]MaterialLlmCriterion", Type: reflect.TypeFor[[]sharedtypes.MaterialLlmCriterion]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := ExtractCriteriaSuggestions(inputValue[string](inputs, 0, "llmResponse"), inputValue[string](inputs, 1, "traceID"), inputValue[string](inputs, 2, "spanID"))
+			return []any{output0, output1}
+		},
+	},
+	"ExtractDesignRequirementsAndSearchCriteria": {
+		Name:     "ExtractDesignRequirementsAndSearchCriteria",
+		function: ExtractDesignRequirementsAndSearchCriteria,
+		Inputs: []AdapterParameter{
+			{Name: "userInput", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "designRequirements", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "availableSearchCriteria", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := ExtractDesignRequirementsAndSearchCriteria(inputValue[string](inputs, 0, "userInput"), inputValue[string](inputs, 1, "traceID"), inputValue[string](inputs, 2, "spanID"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"ExtractJSONStringField": {
+		Name:     "ExtractJSONStringField",
+		function: ExtractJSONStringField,
+		Inputs: []AdapterParameter{
+			{Name: "jsonStr", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "keyPath", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "string", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := ExtractJSONStringField(inputValue[string](inputs, 0, "jsonStr"), inputValue[string](inputs, 1, "keyPath"))
+			return []any{output0}
+		},
+	},
+	"FetchActionsPathFromPathDescription": {
+		Name:     "FetchActionsPathFromPathDescription",
+		function: FetchActionsPathFromPathDescription,
+		Inputs: []AdapterParameter{
+			{Name: "db_name", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "description", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "nodeLabel", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "actions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := FetchActionsPathFromPathDescription(ctx, inputValue[string](inputs, 0, "db_name"), inputValue[string](inputs, 1, "description"), inputValue[string](inputs, 2, "nodeLabel"))
+			return []any{output0}
+		},
+	},
+	"FetchNodeDescriptionsFromPathDescription": {
+		Name:     "FetchNodeDescriptionsFromPathDescription",
+		function: FetchNodeDescriptionsFromPathDescription,
+		Inputs: []AdapterParameter{
+			{Name: "db_name", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "description", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "actionDescriptions", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := FetchNodeDescriptionsFromPathDescription(ctx, inputValue[string](inputs, 0, "db_name"), inputValue[string](inputs, 1, "description"))
+			return []any{output0}
+		},
+	},
+	"FetchPropertiesFromPathDescription": {
+		Name:     "FetchPropertiesFromPathDescription",
+		function: FetchPropertiesFromPathDescription,
+		Inputs: []AdapterParameter{
+			{Name: "db_name", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "description", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "properties", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := FetchPropertiesFromPathDescription(ctx, inputValue[string](inputs, 0, "db_name"), inputValue[string](inputs, 1, "description"))
+			return []any{output0}
+		},
+	},
+	"FilterOutDuplicateAttributes": {
+		Name:     "FilterOutDuplicateAttributes",
+		function: FilterOutDuplicateAttributes,
+		Inputs: []AdapterParameter{
+			{Name: "criteriaSuggestions", GoType: "[]MaterialLlmCriterion", Type: reflect.TypeFor[[]sharedtypes.MaterialLlmCriterion]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "filtered", GoType: "[]MaterialLlmCriterion", Type: reflect.TypeFor[[]sharedtypes.MaterialLlmCriterion]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := FilterOutDuplicateAttributes(inputValue[[]sharedtypes.MaterialLlmCriterion](inputs, 0, "criteriaSuggestions"), inputValue[string](inputs, 1, "traceID"), inputValue[string](inputs, 2, "spanID"))
+			return []any{output0, output1}
+		},
+	},
+	"FilterOutNonExistingAttributes": {
+		Name:     "FilterOutNonExistingAttributes",
+		function: FilterOutNonExistingAttributes,
+		Inputs: []AdapterParameter{
+			{Name: "criteriaSuggestions", GoType: "[]MaterialCriterionWithGuid", Type: reflect.TypeFor[[]sharedtypes.MaterialCriterionWithGuid]()},
+			{Name: "availableSearchCriteria", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "filtered", GoType: "[]MaterialCriterionWithGuid", Type: reflect.TypeFor[[]sharedtypes.MaterialCriterionWithGuid]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := FilterOutNonExistingAttributes(inputValue[[]sharedtypes.MaterialCriterionWithGuid](inputs, 0, "criteriaSuggestions"), inputValue[[]string](inputs, 1, "availableSearchCriteria"), inputValue[string](inputs, 2, "traceID"), inputValue[string](inputs, 3, "spanID"))
+			return []any{output0, output1}
+		},
+	},
+	"FinalizeMessage": {
+		Name:     "FinalizeMessage",
+		function: FinalizeMessage,
+		Inputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := FinalizeMessage(inputValue[string](inputs, 0, "message"))
+			return []any{output0}
+		},
+	},
+	"FinalizeResult": {
+		Name:     "FinalizeResult",
+		function: FinalizeResult,
+		Inputs: []AdapterParameter{
+			{Name: "actions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+			{Name: "toolName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := FinalizeResult(inputValue[[]map[string]string](inputs, 0, "actions"), inputValue[string](inputs, 1, "toolName"))
+			return []any{output0}
+		},
+	},
+	"FindRelevantPathDescription": {
+		Name:     "FindRelevantPathDescription",
+		function: FindRelevantPathDescription,
+		Inputs: []AdapterParameter{
+			{Name: "descriptions", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "relevantDescription", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := FindRelevantPathDescription(inputValue[[]string](inputs, 0, "descriptions"), inputValue[string](inputs, 1, "message"))
+			return []any{output0}
+		},
+	},
+	"FluentCodeGen": {
+		Name:     "FluentCodeGen",
+		function: FluentCodeGen,
+		Inputs: []AdapterParameter{
+			{Name: "url", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "response", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := FluentCodeGen(ctx, inputValue[string](inputs, 0, "url"), inputValue[string](inputs, 1, "message"))
+			return []any{output0}
+		},
+	},
+	"GeneralGraphDbQuery": {
+		Name:     "GeneralGraphDbQuery",
+		function: GeneralGraphDbQuery,
+		Inputs: []AdapterParameter{
+			{Name: "dbname", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "parameters", GoType: "ParameterMap", Type: reflect.TypeFor[aali_graphdb.ParameterMap]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "[]map[string]any", GoType: "[]map[string]any", Type: reflect.TypeFor[[]map[string]any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GeneralGraphDbQuery(ctx, inputValue[string](inputs, 0, "dbname"), inputValue[string](inputs, 1, "query"), inputValue[aali_graphdb.ParameterMap](inputs, 2, "parameters"))
+			return []any{output0}
+		},
+	},
+	"GeneralQuery": {
+		Name:     "GeneralQuery",
+		function: GeneralQuery,
+		Inputs: []AdapterParameter{
+			{Name: "collectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "maxRetrievalCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "outputFields", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "filters", GoType: "DbFilters", Type: reflect.TypeFor[sharedtypes.DbFilters]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "databaseResponse", GoType: "[]DbResponse", Type: reflect.TypeFor[[]sharedtypes.DbResponse]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GeneralQuery(ctx, inputValue[string](inputs, 0, "collectionName"), inputValue[int](inputs, 1, "maxRetrievalCount"), inputValue[[]string](inputs, 2, "outputFields"), inputValue[sharedtypes.DbFilters](inputs, 3, "filters"))
+			return []any{output0}
+		},
+	},
+	"GenerateActionsSubWorkflowPrompt": {
+		Name:     "GenerateActionsSubWorkflowPrompt",
+		function: GenerateActionsSubWorkflowPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "userInstruction", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := GenerateActionsSubWorkflowPrompt(inputValue[string](inputs, 0, "userInstruction"))
+			return []any{output0, output1}
+		},
+	},
+	"GenerateDocumentTree": {
+		Name:     "GenerateDocumentTree",
+		function: GenerateDocumentTree,
+		Inputs: []AdapterParameter{
+			{Name: "documentName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "documentId", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "documentChunks", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "embeddingsDimensions", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "getSummary", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "getKeywords", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "numKeywords", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "chunkSize", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "numLlmWorkers", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "returnedDocumentData", GoType: "[]DbData", Type: reflect.TypeFor[[]sharedtypes.DbData]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GenerateDocumentTree(ctx, inputValue[string](inputs, 0, "documentName"), inputValue[string](inputs, 1, "documentId"), inputValue[[]string](inputs, 2, "documentChunks"), inputValue[int](inputs, 3, "embeddingsDimensions"), inputValue[bool](inputs, 4, "getSummary"), inputValue[bool](inputs, 5, "getKeywords"), inputValue[int](inputs, 6, "numKeywords"), inputValue[int](inputs, 7, "chunkSize"), inputValue[int](inputs, 8, "numLlmWorkers"))
+			return []any{output0}
+		},
+	},
+	"GenerateHelperSubWorkflowPrompt": {
+		Name:     "GenerateHelperSubWorkflowPrompt",
+		function: GenerateHelperSubWorkflowPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "userInstruction", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := GenerateHelperSubWorkflowPrompt(inputValue[string](inputs, 0, "userInstruction"))
+			return []any{output0, output1}
+		},
+	},
+	"GenerateMKSummariesforTags": {
+		Name:     "GenerateMKSummariesforTags",
+		function: GenerateMKSummariesforTags,
+		Inputs: []AdapterParameter{
+			{Name: "dbName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "tags", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "GetTagIdByNameQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "GetMKSummaryFromDBQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "allTagsSummaries", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GenerateMKSummariesforTags(ctx, inputValue[string](inputs, 0, "dbName"), inputValue[[]string](inputs, 1, "tags"), inputValue[string](inputs, 2, "GetTagIdByNameQuery"), inputValue[string](inputs, 3, "GetMKSummaryFromDBQuery"))
+			return []any{output0}
+		},
+	},
+	"GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt": {
+		Name:     "GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt",
+		function: GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "SynthesizeAnswerUserPromptTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "originalQuery", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "expandedQueries", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "retrievedQAPairs", GoType: "[]map[string]interface{}", Type: reflect.TypeFor[[]map[string]interface{}]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "userPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GenerateSynthesizeAnswerfromMetaKnowlwdgeUserPrompt(inputValue[string](inputs, 0, "SynthesizeAnswerUserPromptTemplate"), inputValue[string](inputs, 1, "originalQuery"), inputValue[[]string](inputs, 2, "expandedQueries"), inputValue[[]map[string]interface{}](inputs, 3, "retrievedQAPairs"))
+			return []any{output0}
+		},
+	},
+	"GenerateUUID": {
+		Name:     "GenerateUUID",
+		function: GenerateUUID,
+		Inputs:   []AdapterParameter{},
+		Outputs: []AdapterParameter{
+			{Name: "string", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GenerateUUID()
+			return []any{output0}
+		},
+	},
+	"GenerateUserPrompt": {
+		Name:     "GenerateUserPrompt",
+		function: GenerateUserPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "userInstruction", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userPromptTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "userPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GenerateUserPrompt(inputValue[string](inputs, 0, "userInstruction"), inputValue[string](inputs, 1, "userPromptTemplate"))
+			return []any{output0}
+		},
+	},
+	"GenerateUserPromptWithContext": {
+		Name:     "GenerateUserPromptWithContext",
+		function: GenerateUserPromptWithContext,
+		Inputs: []AdapterParameter{
+			{Name: "userInstruction", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "context", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userPromptTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "userPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GenerateUserPromptWithContext(inputValue[string](inputs, 0, "userInstruction"), inputValue[string](inputs, 1, "context"), inputValue[string](inputs, 2, "userPromptTemplate"))
+			return []any{output0}
+		},
+	},
+	"GenerateUserPromptWithList": {
+		Name:     "GenerateUserPromptWithList",
+		function: GenerateUserPromptWithList,
+		Inputs: []AdapterParameter{
+			{Name: "userInstruction", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "userPromptTemplate", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "userPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GenerateUserPromptWithList(inputValue[string](inputs, 0, "userInstruction"), inputValue[[]string](inputs, 1, "userList"), inputValue[string](inputs, 2, "userPromptTemplate"))
+			return []any{output0}
+		},
+	},
+	"GetActionsFromConfig": {
+		Name:     "GetActionsFromConfig",
+		function: GetActionsFromConfig,
+		Inputs: []AdapterParameter{
+			{Name: "toolName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetActionsFromConfig(inputValue[string](inputs, 0, "toolName"))
+			return []any{output0}
+		},
+	},
+	"GetDocumentType": {
+		Name:     "GetDocumentType",
+		function: GetDocumentType,
+		Inputs: []AdapterParameter{
+			{Name: "filePath", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "documentType", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetDocumentType(inputValue[string](inputs, 0, "filePath"))
+			return []any{output0}
+		},
+	},
+	"GetGithubFilesToExtract": {
+		Name:     "GetGithubFilesToExtract",
+		function: GetGithubFilesToExtract,
+		Inputs: []AdapterParameter{
+			{Name: "githubRepoName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoOwner", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoBranch", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubAccessToken", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubFileExtensions", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "githubFilteredDirectories", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "githubExcludedDirectories", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "githubFilesToExtract", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetGithubFilesToExtract(ctx, inputValue[string](inputs, 0, "githubRepoName"), inputValue[string](inputs, 1, "githubRepoOwner"), inputValue[string](inputs, 2, "githubRepoBranch"), inputValue[string](inputs, 3, "githubAccessToken"), inputValue[[]string](inputs, 4, "githubFileExtensions"), inputValue[[]string](inputs, 5, "githubFilteredDirectories"), inputValue[[]string](inputs, 6, "githubExcludedDirectories"))
+			return []any{output0}
+		},
+	},
+	"GetListCollections": {
+		Name:     "GetListCollections",
+		function: GetListCollections,
+		Inputs:   []AdapterParameter{},
+		Outputs: []AdapterParameter{
+			{Name: "collectionsList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetListCollections(ctx)
+			return []any{output0}
+		},
+	},
+	"GetLocalFileContent": {
+		Name:     "GetLocalFileContent",
+		function: GetLocalFileContent,
+		Inputs: []AdapterParameter{
+			{Name: "localFilePath", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "checksum", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "content", GoType: "[]byte", Type: reflect.TypeFor[[]byte]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := GetLocalFileContent(inputValue[string](inputs, 0, "localFilePath"))
+			return []any{output0, output1}
+		},
+	},
+	"GetLocalFilesContent": {
+		Name:     "GetLocalFilesContent",
+		function: GetLocalFilesContent,
+		Inputs: []AdapterParameter{
+			{Name: "localFilePaths", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "filesMap", GoType: "map[string][]byte", Type: reflect.TypeFor[map[string][]byte]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetLocalFilesContent(inputValue[[]string](inputs, 0, "localFilePaths"))
+			return []any{output0}
+		},
+	},
+	"GetLocalFilesToExtract": {
+		Name:     "GetLocalFilesToExtract",
+		function: GetLocalFilesToExtract,
+		Inputs: []AdapterParameter{
+			{Name: "localPath", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "localFileExtensions", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "localFilteredDirectories", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "localExcludedDirectories", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "localFilesToExtract", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetLocalFilesToExtract(inputValue[string](inputs, 0, "localPath"), inputValue[[]string](inputs, 1, "localFileExtensions"), inputValue[[]string](inputs, 2, "localFilteredDirectories"), inputValue[[]string](inputs, 3, "localExcludedDirectories"))
+			return []any{output0}
+		},
+	},
+	"GetResource": {
+		Name:     "GetResource",
+		function: GetResource,
+		Inputs: []AdapterParameter{
+			{Name: "serverURL", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "resourceName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "map[string]interface{}", GoType: "map[string]interface{}", Type: reflect.TypeFor[map[string]interface{}]()},
+			{Name: "error", GoType: "error", Type: reflect.TypeFor[error]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := GetResource(ctx, inputValue[string](inputs, 0, "serverURL"), inputValue[string](inputs, 1, "resourceName"))
+			return []any{output0, output1}
+		},
+	},
+	"GetSelectedSolution": {
+		Name:     "GetSelectedSolution",
+		function: GetSelectedSolution,
+		Inputs: []AdapterParameter{
+			{Name: "arguments", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "solution", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetSelectedSolution(inputValue[string](inputs, 0, "arguments"))
+			return []any{output0}
+		},
+	},
+	"GetSolutionsToFixProblem": {
+		Name:     "GetSolutionsToFixProblem",
+		function: GetSolutionsToFixProblem,
+		Inputs: []AdapterParameter{
+			{Name: "db_name", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "fmFailureCode", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "primeMeshFailureCode", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "solutions", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := GetSolutionsToFixProblem(ctx, inputValue[string](inputs, 0, "db_name"), inputValue[string](inputs, 1, "fmFailureCode"), inputValue[string](inputs, 2, "primeMeshFailureCode"))
+			return []any{output0}
+		},
+	},
+	"GetSystemPrompt": {
+		Name:     "GetSystemPrompt",
+		function: GetSystemPrompt,
+		Inputs: []AdapterParameter{
+			{Name: "serverURL", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "promptName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "string", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "error", GoType: "error", Type: reflect.TypeFor[error]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := GetSystemPrompt(ctx, inputValue[string](inputs, 0, "serverURL"), inputValue[string](inputs, 1, "promptName"))
+			return []any{output0, output1}
+		},
+	},
+	"JsonPath": {
+		Name:     "JsonPath",
+		function: JsonPath,
+		Inputs: []AdapterParameter{
+			{Name: "pat", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+			{Name: "oneResult", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "any", GoType: "any", Type: reflect.TypeFor[any]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := JsonPath(inputValue[string](inputs, 0, "pat"), inputValue[any](inputs, 1, "data"), inputValue[bool](inputs, 2, "oneResult"))
+			return []any{output0}
+		},
+	},
+	"LangchainSplitter": {
+		Name:     "LangchainSplitter",
+		function: LangchainSplitter,
+		Inputs: []AdapterParameter{
+			{Name: "bytesContent", GoType: "[]byte", Type: reflect.TypeFor[[]byte]()},
+			{Name: "documentType", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "chunkSize", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "chunkOverlap", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "output", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := LangchainSplitter(ctx, inputValue[[]byte](inputs, 0, "bytesContent"), inputValue[string](inputs, 1, "documentType"), inputValue[int](inputs, 2, "chunkSize"), inputValue[int](inputs, 3, "chunkOverlap"))
+			return []any{output0}
+		},
+	},
+	"ListAll": {
+		Name:     "ListAll",
+		function: ListAll,
+		Inputs: []AdapterParameter{
+			{Name: "serverURL", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "map[string][]string", GoType: "map[string][]string", Type: reflect.TypeFor[map[string][]string]()},
+			{Name: "error", GoType: "error", Type: reflect.TypeFor[error]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := ListAll(ctx, inputValue[string](inputs, 0, "serverURL"))
+			return []any{output0, output1}
+		},
+	},
+	"LoadAndCheckExampleDependencies": {
+		Name:     "LoadAndCheckExampleDependencies",
+		function: LoadAndCheckExampleDependencies,
+		Inputs: []AdapterParameter{
+			{Name: "dependenciesContent", GoType: "[]byte", Type: reflect.TypeFor[[]byte]()},
+			{Name: "elements", GoType: "[]CodeGenerationElement", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationElement]()},
+			{Name: "instancesReplacementDict", GoType: "map[string]string", Type: reflect.TypeFor[map[string]string]()},
+			{Name: "InstancesReplacementPriorityList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "checkedDependenciesMap", GoType: "map[string][]string", Type: reflect.TypeFor[map[string][]string]()},
+			{Name: "equivalencesMap", GoType: "map[string]map[string]string", Type: reflect.TypeFor[map[string]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := LoadAndCheckExampleDependencies(inputValue[[]byte](inputs, 0, "dependenciesContent"), inputValue[[]sharedtypes.CodeGenerationElement](inputs, 1, "elements"), inputMap[map[string]string](inputs, 2, "instancesReplacementDict"), inputValue[[]string](inputs, 3, "InstancesReplacementPriorityList"))
+			return []any{output0, output1}
+		},
+	},
+	"LoadCodeGenerationElements": {
+		Name:     "LoadCodeGenerationElements",
+		function: LoadCodeGenerationElements,
+		Inputs: []AdapterParameter{
+			{Name: "content", GoType: "[]byte", Type: reflect.TypeFor[[]byte]()},
+			{Name: "elementsFilePath", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "elements", GoType: "[]CodeGenerationElement", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationElement]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := LoadCodeGenerationElements(inputValue[[]byte](inputs, 0, "content"), inputValue[string](inputs, 1, "elementsFilePath"))
+			return []any{output0}
+		},
+	},
+	"LoadCodeGenerationExamples": {
+		Name:     "LoadCodeGenerationExamples",
+		function: LoadCodeGenerationExamples,
+		Inputs: []AdapterParameter{
+			{Name: "source", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "examplesToExtract", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "githubRepoName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoOwner", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoBranch", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubAccessToken", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "dependencies", GoType: "map[string][]string", Type: reflect.TypeFor[map[string][]string]()},
+			{Name: "equivalencesMap", GoType: "map[string]map[string]string", Type: reflect.TypeFor[map[string]map[string]string]()},
+			{Name: "chunkSize", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "chunkOverlap", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "examples", GoType: "[]CodeGenerationExample", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationExample]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := LoadCodeGenerationExamples(ctx, inputValue[string](inputs, 0, "source"), inputValue[[]string](inputs, 1, "examplesToExtract"), inputValue[string](inputs, 2, "githubRepoName"), inputValue[string](inputs, 3, "githubRepoOwner"), inputValue[string](inputs, 4, "githubRepoBranch"), inputValue[string](inputs, 5, "githubAccessToken"), inputMap[map[string][]string](inputs, 6, "dependencies"), inputMap[map[string]map[string]string](inputs, 7, "equivalencesMap"), inputValue[int](inputs, 8, "chunkSize"), inputValue[int](inputs, 9, "chunkOverlap"))
+			return []any{output0}
+		},
+	},
+	"LoadUserGuideSections": {
+		Name:     "LoadUserGuideSections",
+		function: LoadUserGuideSections,
+		Inputs: []AdapterParameter{
+			{Name: "source", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "sectionFilePaths", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "githubRepoName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoOwner", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubRepoBranch", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "githubAccessToken", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "sections", GoType: "[]CodeGenerationUserGuideSection", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationUserGuideSection]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := LoadUserGuideSections(ctx, inputValue[string](inputs, 0, "source"), inputValue[[]string](inputs, 1, "sectionFilePaths"), inputValue[string](inputs, 2, "githubRepoName"), inputValue[string](inputs, 3, "githubRepoOwner"), inputValue[string](inputs, 4, "githubRepoBranch"), inputValue[string](inputs, 5, "githubAccessToken"))
+			return []any{output0}
+		},
+	},
+	"LogRequestFailed": {
+		Name:     "LogRequestFailed",
+		function: LogRequestFailed,
+		Inputs: []AdapterParameter{
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := LogRequestFailed(inputValue[string](inputs, 0, "traceID"), inputValue[string](inputs, 1, "spanID"))
+			return []any{output0}
+		},
+	},
+	"LogRequestFailedDebugWithMessage": {
+		Name:     "LogRequestFailedDebugWithMessage",
+		function: LogRequestFailedDebugWithMessage,
+		Inputs: []AdapterParameter{
+			{Name: "msg1", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "msg2", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := LogRequestFailedDebugWithMessage(inputValue[string](inputs, 0, "msg1"), inputValue[string](inputs, 1, "msg2"), inputValue[string](inputs, 2, "traceID"), inputValue[string](inputs, 3, "spanID"))
+			return []any{output0}
+		},
+	},
+	"LogRequestSuccess": {
+		Name:     "LogRequestSuccess",
+		function: LogRequestSuccess,
+		Inputs: []AdapterParameter{
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := LogRequestSuccess(inputValue[string](inputs, 0, "traceID"), inputValue[string](inputs, 1, "spanID"))
+			return []any{output0}
+		},
+	},
+	"MarkdownToHTML": {
+		Name:     "MarkdownToHTML",
+		function: MarkdownToHTML,
+		Inputs: []AdapterParameter{
+			{Name: "markdown", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "html", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := MarkdownToHTML(inputValue[string](inputs, 0, "markdown"))
+			return []any{output0}
+		},
+	},
+	"ParseHistory": {
+		Name:     "ParseHistory",
+		function: ParseHistory,
+		Inputs: []AdapterParameter{
+			{Name: "historyJson", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "history", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := ParseHistory(inputValue[string](inputs, 0, "historyJson"))
+			return []any{output0}
+		},
+	},
+	"ParseHistoryToHistoricMessages": {
+		Name:     "ParseHistoryToHistoricMessages",
+		function: ParseHistoryToHistoricMessages,
+		Inputs: []AdapterParameter{
+			{Name: "historyJson", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := ParseHistoryToHistoricMessages(inputValue[string](inputs, 0, "historyJson"))
+			return []any{output0}
+		},
+	},
+	"ParseSlashCommand": {
+		Name:     "ParseSlashCommand",
+		function: ParseSlashCommand,
+		Inputs: []AdapterParameter{
+			{Name: "userInput", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "slashCmd", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "targetCmd", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "hasCmd", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "hasContext", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2, output3 := ParseSlashCommand(inputValue[string](inputs, 0, "userInput"))
+			return []any{output0, output1, output2, output3}
+		},
+	},
+	"ParseSlashCommands": {
+		Name:     "ParseSlashCommands",
+		function: ParseSlashCommands,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "slashCommands", GoType: "[]SlashCommand", Type: reflect.TypeFor[[]sharedtypes.SlashCommand]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := ParseSlashCommands(inputValue[string](inputs, 0, "input"))
+			return []any{output0}
+		},
+	},
+	"PerformBatchEmbeddingRequest": {
+		Name:     "PerformBatchEmbeddingRequest",
+		function: PerformBatchEmbeddingRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "embeddedVectors", GoType: "[][]float32", Type: reflect.TypeFor[[][]float32]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := PerformBatchEmbeddingRequest(ctx, inputValue[[]string](inputs, 0, "input"))
+			return []any{output0}
+		},
+	},
+	"PerformBatchHybridEmbeddingRequest": {
+		Name:     "PerformBatchHybridEmbeddingRequest",
+		function: PerformBatchHybridEmbeddingRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "maxBatchSize", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "denseEmbeddings", GoType: "[][]float32", Type: reflect.TypeFor[[][]float32]()},
+			{Name: "sparseEmbeddings", GoType: "[]map[uint]float32", Type: reflect.TypeFor[[]map[uint]float32]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformBatchHybridEmbeddingRequest(ctx, inputValue[[]string](inputs, 0, "input"), inputValue[int](inputs, 1, "maxBatchSize"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformCodeLLMRequest": {
+		Name:     "PerformCodeLLMRequest",
+		function: PerformCodeLLMRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "validateCode", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformCodeLLMRequest(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[bool](inputs, 2, "isStream"), inputValue[bool](inputs, 3, "validateCode"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralModelSpecificationRequest": {
+		Name:     "PerformGeneralModelSpecificationRequest",
+		function: PerformGeneralModelSpecificationRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "systemPrompt", GoType: "map[string]string", Type: reflect.TypeFor[map[string]string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralModelSpecificationRequest(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[bool](inputs, 2, "isStream"), inputMap[map[string]string](inputs, 3, "systemPrompt"), inputValue[[]string](inputs, 4, "modelIds"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralRequest": {
+		Name:     "PerformGeneralRequest",
+		function: PerformGeneralRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralRequest(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[bool](inputs, 2, "isStream"), inputValue[string](inputs, 3, "systemPrompt"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralRequestNoStreaming": {
+		Name:     "PerformGeneralRequestNoStreaming",
+		function: PerformGeneralRequestNoStreaming,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := PerformGeneralRequestNoStreaming(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[string](inputs, 2, "systemPrompt"))
+			return []any{output0}
+		},
+	},
+	"PerformGeneralRequestSpecificModel": {
+		Name:     "PerformGeneralRequestSpecificModel",
+		function: PerformGeneralRequestSpecificModel,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralRequestSpecificModel(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[bool](inputs, 2, "isStream"), inputValue[string](inputs, 3, "systemPrompt"), inputValue[[]string](inputs, 4, "modelIds"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptions": {
+		Name:     "PerformGeneralRequestSpecificModelAndModelOptions",
+		function: PerformGeneralRequestSpecificModelAndModelOptions,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "modelOptions", GoType: "ModelOptions", Type: reflect.TypeFor[sharedtypes.ModelOptions]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralRequestSpecificModelAndModelOptions(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[bool](inputs, 2, "isStream"), inputValue[string](inputs, 3, "systemPrompt"), inputValue[[]string](inputs, 4, "modelIds"), inputValue[sharedtypes.ModelOptions](inputs, 5, "modelOptions"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput": {
+		Name:     "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput",
+		function: PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "modelOptions", GoType: "ModelOptions", Type: reflect.TypeFor[sharedtypes.ModelOptions]()},
+			{Name: "tokenCountModelName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "inputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "outputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiInputOutputTokenOutput(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[string](inputs, 2, "systemPrompt"), inputValue[[]string](inputs, 3, "modelIds"), inputValue[sharedtypes.ModelOptions](inputs, 4, "modelOptions"), inputValue[string](inputs, 5, "tokenCountModelName"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput": {
+		Name:     "PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput",
+		function: PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "modelOptions", GoType: "ModelOptions", Type: reflect.TypeFor[sharedtypes.ModelOptions]()},
+			{Name: "tokenCountModelName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "tokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralRequestSpecificModelAndModelOptionsNoStreamWithOpenAiTokenOutput(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[string](inputs, 2, "systemPrompt"), inputValue[[]string](inputs, 3, "modelIds"), inputValue[sharedtypes.ModelOptions](inputs, 4, "modelOptions"), inputValue[string](inputs, 5, "tokenCountModelName"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralRequestSpecificModelModelOptionsAndImages": {
+		Name:     "PerformGeneralRequestSpecificModelModelOptionsAndImages",
+		function: PerformGeneralRequestSpecificModelModelOptionsAndImages,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "modelOptions", GoType: "ModelOptions", Type: reflect.TypeFor[sharedtypes.ModelOptions]()},
+			{Name: "images", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "modelCategory", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralRequestSpecificModelModelOptionsAndImages(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[bool](inputs, 2, "isStream"), inputValue[string](inputs, 3, "systemPrompt"), inputValue[[]string](inputs, 4, "modelIds"), inputValue[sharedtypes.ModelOptions](inputs, 5, "modelOptions"), inputValue[[]string](inputs, 6, "images"), inputValue[[]string](inputs, 7, "modelCategory"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput": {
+		Name:     "PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput",
+		function: PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "tokenCountModelName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "tokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralRequestSpecificModelNoStreamWithOpenAiTokenOutput(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[string](inputs, 2, "systemPrompt"), inputValue[[]string](inputs, 3, "modelIds"), inputValue[string](inputs, 4, "tokenCountModelName"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformGeneralRequestWithImages": {
+		Name:     "PerformGeneralRequestWithImages",
+		function: PerformGeneralRequestWithImages,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "isStream", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "images", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformGeneralRequestWithImages(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[bool](inputs, 2, "isStream"), inputValue[string](inputs, 3, "systemPrompt"), inputValue[[]string](inputs, 4, "images"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformKeywordExtractionRequest": {
+		Name:     "PerformKeywordExtractionRequest",
+		function: PerformKeywordExtractionRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "maxKeywordsSearch", GoType: "uint32", Type: reflect.TypeFor[uint32]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "keywords", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := PerformKeywordExtractionRequest(ctx, inputValue[string](inputs, 0, "input"), inputValue[uint32](inputs, 1, "maxKeywordsSearch"))
+			return []any{output0}
+		},
+	},
+	"PerformLLMRequest": {
+		Name:     "PerformLLMRequest",
+		function: PerformLLMRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "options", GoType: "LLMRequestOptions", Type: reflect.TypeFor[LLMRequestOptions]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "inputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "outputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := PerformLLMRequest(ctx, inputValue[string](inputs, 0, "input"), inputValue[LLMRequestOptions](inputs, 1, "options"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"PerformLLMStreamRequest": {
+		Name:     "PerformLLMStreamRequest",
+		function: PerformLLMStreamRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "options", GoType: "LLMRequestOptions", Type: reflect.TypeFor[LLMRequestOptions]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "stream", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := PerformLLMStreamRequest(ctx, inputValue[string](inputs, 0, "input"), inputValue[LLMRequestOptions](inputs, 1, "options"))
+			return []any{output0}
+		},
+	},
+	"PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput": {
+		Name:     "PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput",
+		function: PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "systemPrompt", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "modelIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "tokenCountModelName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "n", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "temperature", GoType: "float64", Type: reflect.TypeFor[float64]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "userID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "uniqueCriterion", GoType: "[]MaterialLlmCriterion", Type: reflect.TypeFor[[]sharedtypes.MaterialLlmCriterion]()},
+			{Name: "tokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := PerformMultipleGeneralRequestsAndExtractAttributesWithOpenAiTokenOutput(ctx, inputValue[string](inputs, 0, "input"), inputValue[[]sharedtypes.HistoricMessage](inputs, 1, "history"), inputValue[string](inputs, 2, "systemPrompt"), inputValue[[]string](inputs, 3, "modelIds"), inputValue[string](inputs, 4, "tokenCountModelName"), inputValue[int](inputs, 5, "n"), inputValue[float64](inputs, 6, "temperature"), inputValue[string](inputs, 7, "traceID"), inputValue[string](inputs, 8, "spanID"), inputValue[string](inputs, 9, "userID"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"PerformSimilaritySearchForSubqueries": {
+		Name:     "PerformSimilaritySearchForSubqueries",
+		function: PerformSimilaritySearchForSubqueries,
+		Inputs: []AdapterParameter{
+			{Name: "subQueries", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "collection", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "similaritySearchResults", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "similaritySearchMinScore", GoType: "float64", Type: reflect.TypeFor[float64]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "uniqueQAPairs", GoType: "[]map[string]interface{}", Type: reflect.TypeFor[[]map[string]interface{}]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := PerformSimilaritySearchForSubqueries(ctx, inputValue[[]string](inputs, 0, "subQueries"), inputValue[string](inputs, 1, "collection"), inputValue[int](inputs, 2, "similaritySearchResults"), inputValue[float64](inputs, 3, "similaritySearchMinScore"))
+			return []any{output0}
+		},
+	},
+	"PerformSummaryRequest": {
+		Name:     "PerformSummaryRequest",
+		function: PerformSummaryRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "summary", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := PerformSummaryRequest(ctx, inputValue[string](inputs, 0, "input"))
+			return []any{output0}
+		},
+	},
+	"PerformVectorEmbeddingRequest": {
+		Name:     "PerformVectorEmbeddingRequest",
+		function: PerformVectorEmbeddingRequest,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "includeSparse", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "embeddedVector", GoType: "[]float32", Type: reflect.TypeFor[[]float32]()},
+			{Name: "sparseVector", GoType: "map[uint]float32", Type: reflect.TypeFor[map[uint]float32]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := PerformVectorEmbeddingRequest(ctx, inputValue[string](inputs, 0, "input"), inputValue[bool](inputs, 1, "includeSparse"))
+			return []any{output0, output1}
+		},
+	},
+	"PerformVectorEmbeddingRequestWithTokenLimitCatch": {
+		Name:     "PerformVectorEmbeddingRequestWithTokenLimitCatch",
+		function: PerformVectorEmbeddingRequestWithTokenLimitCatch,
+		Inputs: []AdapterParameter{
+			{Name: "input", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "tokenLimitMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "embeddedVector", GoType: "[]float32", Type: reflect.TypeFor[[]float32]()},
+			{Name: "tokenLimitReached", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "responseMessage", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1, output2 := PerformVectorEmbeddingRequestWithTokenLimitCatch(ctx, inputValue[string](inputs, 0, "input"), inputValue[string](inputs, 1, "tokenLimitMessage"))
+			return []any{output0, output1, output2}
+		},
+	},
+	"PrintFeedback": {
+		Name:     "PrintFeedback",
+		function: PrintFeedback,
+		Inputs: []AdapterParameter{
+			{Name: "feedback", GoType: "Feedback", Type: reflect.TypeFor[sharedtypes.Feedback]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			PrintFeedback(inputValue[sharedtypes.Feedback](inputs, 0, "feedback"))
+			return nil
+		},
+	},
+	"ProcessJSONListOutput": {
+		Name:     "ProcessJSONListOutput",
+		function: ProcessJSONListOutput,
+		Inputs: []AdapterParameter{
+			{Name: "response", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "generatedList", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := ProcessJSONListOutput(inputValue[string](inputs, 0, "response"))
+			return []any{output0}
+		},
+	},
+	"ProcessMWWorkflowInfo": {
+		Name:     "ProcessMWWorkflowInfo",
+		function: ProcessMWWorkflowInfo,
+		Inputs: []AdapterParameter{
+			{Name: "mwWorkflowInfo", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			ProcessMWWorkflowInfo(inputValue[string](inputs, 0, "mwWorkflowInfo"))
+			return nil
+		},
+	},
+	"ProcessMainAgentOutput": {
+		Name:     "ProcessMainAgentOutput",
+		function: ProcessMainAgentOutput,
+		Inputs: []AdapterParameter{
+			{Name: "llmOutput", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "messageTo", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := ProcessMainAgentOutput(inputValue[string](inputs, 0, "llmOutput"))
+			return []any{output0, output1}
+		},
+	},
+	"ProcessSubworkflowIdentificationOutput": {
+		Name:     "ProcessSubworkflowIdentificationOutput",
+		function: ProcessSubworkflowIdentificationOutput,
+		Inputs: []AdapterParameter{
+			{Name: "llmOutput", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "status", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "workflowName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := ProcessSubworkflowIdentificationOutput(inputValue[string](inputs, 0, "llmOutput"))
+			return []any{output0, output1}
+		},
+	},
+	"QdrantCreateCollection": {
+		Name:     "QdrantCreateCollection",
+		function: QdrantCreateCollection,
+		Inputs: []AdapterParameter{
+			{Name: "collectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "vectorSize", GoType: "uint64", Type: reflect.TypeFor[uint64]()},
+			{Name: "vectorDistance", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			QdrantCreateCollection(ctx, inputValue[string](inputs, 0, "collectionName"), inputValue[uint64](inputs, 1, "vectorSize"), inputValue[string](inputs, 2, "vectorDistance"))
+			return nil
+		},
+	},
+	"QdrantCreateIndex": {
+		Name:     "QdrantCreateIndex",
+		function: QdrantCreateIndex,
+		Inputs: []AdapterParameter{
+			{Name: "collectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "fieldName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "fieldType", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "wait", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			QdrantCreateIndex(ctx, inputValue[string](inputs, 0, "collectionName"), inputValue[string](inputs, 1, "fieldName"), inputValue[string](inputs, 2, "fieldType"), inputValue[bool](inputs, 3, "wait"))
+			return nil
+		},
+	},
+	"QdrantInsertData": {
+		Name:     "QdrantInsertData",
+		function: QdrantInsertData,
+		Inputs: []AdapterParameter{
+			{Name: "collectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "data", GoType: "[]interface{}", Type: reflect.TypeFor[[]interface{}]()},
+			{Name: "idFieldName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "vectorFieldName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			QdrantInsertData(ctx, inputValue[string](inputs, 0, "collectionName"), inputValue[[]interface{}](inputs, 1, "data"), inputValue[string](inputs, 2, "idFieldName"), inputValue[string](inputs, 3, "vectorFieldName"))
+			return nil
+		},
+	},
+	"ResetTokenCountIfNewMonth": {
+		Name:     "ResetTokenCountIfNewMonth",
+		function: ResetTokenCountIfNewMonth,
+		Inputs: []AdapterParameter{
+			{Name: "kvdbEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "apiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := ResetTokenCountIfNewMonth(ctx, inputValue[string](inputs, 0, "kvdbEndpoint"), inputValue[string](inputs, 1, "apiKey"), inputValue[string](inputs, 2, "traceID"), inputValue[string](inputs, 3, "spanID"))
+			return []any{output0}
+		},
+	},
+	"RetrieveDependencies": {
+		Name:     "RetrieveDependencies",
+		function: RetrieveDependencies,
+		Inputs: []AdapterParameter{
+			{Name: "dbname", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "relationshipName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "relationshipDirection", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "sourceDocumentId", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "nodeTypesFilter", GoType: "DbArrayFilter", Type: reflect.TypeFor[sharedtypes.DbArrayFilter]()},
+			{Name: "maxHopsNumber", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "dependenciesIds", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := RetrieveDependencies(ctx, inputValue[string](inputs, 0, "dbname"), inputValue[string](inputs, 1, "relationshipName"), inputValue[string](inputs, 2, "relationshipDirection"), inputValue[string](inputs, 3, "sourceDocumentId"), inputValue[sharedtypes.DbArrayFilter](inputs, 4, "nodeTypesFilter"), inputValue[int](inputs, 5, "maxHopsNumber"))
+			return []any{output0}
+		},
+	},
+	"SelectedSolution": {
+		Name:     "SelectedSolution",
+		function: SelectedSolution,
+		Inputs: []AdapterParameter{
+			{Name: "selectedSolution", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "solution", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SelectedSolution(inputValue[string](inputs, 0, "selectedSolution"))
+			return []any{output0}
+		},
+	},
+	"SendLogicAppNotificationEmail": {
+		Name:     "SendLogicAppNotificationEmail",
+		function: SendLogicAppNotificationEmail,
+		Inputs: []AdapterParameter{
+			{Name: "logicAppEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "email", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "subject", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "content", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			SendLogicAppNotificationEmail(ctx, inputValue[string](inputs, 0, "logicAppEndpoint"), inputValue[string](inputs, 1, "email"), inputValue[string](inputs, 2, "subject"), inputValue[string](inputs, 3, "content"))
+			return nil
+		},
+	},
+	"SendLogicAppNotificationEmailToMultipleEmails": {
+		Name:     "SendLogicAppNotificationEmailToMultipleEmails",
+		function: SendLogicAppNotificationEmailToMultipleEmails,
+		Inputs: []AdapterParameter{
+			{Name: "logicAppEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "emails", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "subject", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "content", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			SendLogicAppNotificationEmailToMultipleEmails(ctx, inputValue[string](inputs, 0, "logicAppEndpoint"), inputValue[[]string](inputs, 1, "emails"), inputValue[string](inputs, 2, "subject"), inputValue[string](inputs, 3, "content"))
+			return nil
+		},
+	},
+	"SendRestAPICall": {
+		Name:     "SendRestAPICall",
+		function: SendRestAPICall,
+		Inputs: []AdapterParameter{
+			{Name: "requestType", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "endpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "header", GoType: "map[string]string", Type: reflect.TypeFor[map[string]string]()},
+			{Name: "query", GoType: "map[string]string", Type: reflect.TypeFor[map[string]string]()},
+			{Name: "jsonBody", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "success", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "returnJsonBody", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := SendRestAPICall(ctx, inputValue[string](inputs, 0, "requestType"), inputValue[string](inputs, 1, "endpoint"), inputMap[map[string]string](inputs, 2, "header"), inputMap[map[string]string](inputs, 3, "query"), inputValue[string](inputs, 4, "jsonBody"))
+			return []any{output0, output1}
+		},
+	},
+	"SendVectorsToKnowledgeDB": {
+		Name:     "SendVectorsToKnowledgeDB",
+		function: SendVectorsToKnowledgeDB,
+		Inputs: []AdapterParameter{
+			{Name: "vector", GoType: "[]float32", Type: reflect.TypeFor[[]float32]()},
+			{Name: "keywords", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "keywordsSearch", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "collection", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "similaritySearchResults", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "similaritySearchMinScore", GoType: "float64", Type: reflect.TypeFor[float64]()},
+			{Name: "sparseVector", GoType: "map[uint]float32", Type: reflect.TypeFor[map[uint]float32]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "databaseResponse", GoType: "[]DbResponse", Type: reflect.TypeFor[[]sharedtypes.DbResponse]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SendVectorsToKnowledgeDB(ctx, inputValue[[]float32](inputs, 0, "vector"), inputValue[[]string](inputs, 1, "keywords"), inputValue[bool](inputs, 2, "keywordsSearch"), inputValue[string](inputs, 3, "collection"), inputValue[int](inputs, 4, "similaritySearchResults"), inputValue[float64](inputs, 5, "similaritySearchMinScore"), inputMap[map[uint]float32](inputs, 6, "sparseVector"))
+			return []any{output0}
+		},
+	},
+	"SerializeResponse": {
+		Name:     "SerializeResponse",
+		function: SerializeResponse,
+		Inputs: []AdapterParameter{
+			{Name: "criteriaSuggestions", GoType: "[]MaterialCriterionWithGuid", Type: reflect.TypeFor[[]sharedtypes.MaterialCriterionWithGuid]()},
+			{Name: "tokens", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := SerializeResponse(inputValue[[]sharedtypes.MaterialCriterionWithGuid](inputs, 0, "criteriaSuggestions"), inputValue[int](inputs, 1, "tokens"), inputValue[string](inputs, 2, "traceID"), inputValue[string](inputs, 3, "spanID"))
+			return []any{output0, output1}
+		},
+	},
+	"SetCopilotGenerateRequestJsonBody": {
+		Name:     "SetCopilotGenerateRequestJsonBody",
+		function: SetCopilotGenerateRequestJsonBody,
+		Inputs: []AdapterParameter{
+			{Name: "query", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "sessionID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mode", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "timeout", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "priority", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "agentPreference", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "saveIntermediate", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "similarityTopK", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "noCritique", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "maxIterations", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "forceAzure", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "jsonBody", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SetCopilotGenerateRequestJsonBody(inputValue[string](inputs, 0, "query"), inputValue[string](inputs, 1, "sessionID"), inputValue[string](inputs, 2, "mode"), inputValue[int](inputs, 3, "timeout"), inputValue[int](inputs, 4, "priority"), inputValue[string](inputs, 5, "agentPreference"), inputValue[bool](inputs, 6, "saveIntermediate"), inputValue[int](inputs, 7, "similarityTopK"), inputValue[bool](inputs, 8, "noCritique"), inputValue[int](inputs, 9, "maxIterations"), inputValue[bool](inputs, 10, "forceAzure"))
+			return []any{output0}
+		},
+	},
+	"ShortenMessageHistory": {
+		Name:     "ShortenMessageHistory",
+		function: ShortenMessageHistory,
+		Inputs: []AdapterParameter{
+			{Name: "history", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+			{Name: "maxLength", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedHistory", GoType: "[]HistoricMessage", Type: reflect.TypeFor[[]sharedtypes.HistoricMessage]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := ShortenMessageHistory(inputValue[[]sharedtypes.HistoricMessage](inputs, 0, "history"), inputValue[int](inputs, 1, "maxLength"))
+			return []any{output0}
+		},
+	},
+	"SimilaritySearch": {
+		Name:     "SimilaritySearch",
+		function: SimilaritySearch,
+		Inputs: []AdapterParameter{
+			{Name: "collectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "embeddedVector", GoType: "[]float32", Type: reflect.TypeFor[[]float32]()},
+			{Name: "maxRetrievalCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "filters", GoType: "DbFilters", Type: reflect.TypeFor[sharedtypes.DbFilters]()},
+			{Name: "minScore", GoType: "float64", Type: reflect.TypeFor[float64]()},
+			{Name: "getLeafNodes", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "getSiblings", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "getParent", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "getChildren", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "databaseResponse", GoType: "[]DbResponse", Type: reflect.TypeFor[[]sharedtypes.DbResponse]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SimilaritySearch(ctx, inputValue[string](inputs, 0, "collectionName"), inputValue[[]float32](inputs, 1, "embeddedVector"), inputValue[int](inputs, 2, "maxRetrievalCount"), inputValue[sharedtypes.DbFilters](inputs, 3, "filters"), inputValue[float64](inputs, 4, "minScore"), inputValue[bool](inputs, 5, "getLeafNodes"), inputValue[bool](inputs, 6, "getSiblings"), inputValue[bool](inputs, 7, "getParent"), inputValue[bool](inputs, 8, "getChildren"))
+			return []any{output0}
+		},
+	},
+	"SimilartitySearchOnPathDescriptions": {
+		Name:     "SimilartitySearchOnPathDescriptions",
+		function: SimilartitySearchOnPathDescriptions,
+		Inputs: []AdapterParameter{
+			{Name: "instruction", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "toolName", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "descriptions", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SimilartitySearchOnPathDescriptions(ctx, inputValue[string](inputs, 0, "instruction"), inputValue[string](inputs, 1, "toolName"))
+			return []any{output0}
+		},
+	},
+	"SimilartitySearchOnPathDescriptionsQdrant": {
+		Name:     "SimilartitySearchOnPathDescriptionsQdrant",
+		function: SimilartitySearchOnPathDescriptionsQdrant,
+		Inputs: []AdapterParameter{
+			{Name: "vector", GoType: "[]float32", Type: reflect.TypeFor[[]float32]()},
+			{Name: "collection", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "similaritySearchResults", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "similaritySearchMinScore", GoType: "float64", Type: reflect.TypeFor[float64]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "descriptions", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SimilartitySearchOnPathDescriptionsQdrant(ctx, inputValue[[]float32](inputs, 0, "vector"), inputValue[string](inputs, 1, "collection"), inputValue[int](inputs, 2, "similaritySearchResults"), inputValue[float64](inputs, 3, "similaritySearchMinScore"))
+			return []any{output0}
+		},
+	},
+	"StartTrace": {
+		Name:     "StartTrace",
+		function: StartTrace,
+		Inputs:   []AdapterParameter{},
+		Outputs: []AdapterParameter{
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := StartTrace()
+			return []any{output0, output1}
+		},
+	},
+	"StoreElementsInGraphDatabase": {
+		Name:     "StoreElementsInGraphDatabase",
+		function: StoreElementsInGraphDatabase,
+		Inputs: []AdapterParameter{
+			{Name: "dbname", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "elements", GoType: "[]CodeGenerationElement", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationElement]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			StoreElementsInGraphDatabase(ctx, inputValue[string](inputs, 0, "dbname"), inputValue[[]sharedtypes.CodeGenerationElement](inputs, 1, "elements"))
+			return nil
+		},
+	},
+	"StoreElementsInVectorDatabase": {
+		Name:     "StoreElementsInVectorDatabase",
+		function: StoreElementsInVectorDatabase,
+		Inputs: []AdapterParameter{
+			{Name: "elements", GoType: "[]CodeGenerationElement", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationElement]()},
+			{Name: "elementsCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "batchSize", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "vectorDistance", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			StoreElementsInVectorDatabase(ctx, inputValue[[]sharedtypes.CodeGenerationElement](inputs, 0, "elements"), inputValue[string](inputs, 1, "elementsCollectionName"), inputValue[int](inputs, 2, "batchSize"), inputValue[string](inputs, 3, "vectorDistance"))
+			return nil
+		},
+	},
+	"StoreExamplesInGraphDatabase": {
+		Name:     "StoreExamplesInGraphDatabase",
+		function: StoreExamplesInGraphDatabase,
+		Inputs: []AdapterParameter{
+			{Name: "dbname", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "examples", GoType: "[]CodeGenerationExample", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationExample]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			StoreExamplesInGraphDatabase(ctx, inputValue[string](inputs, 0, "dbname"), inputValue[[]sharedtypes.CodeGenerationExample](inputs, 1, "examples"))
+			return nil
+		},
+	},
+	"StoreExamplesInVectorDatabase": {
+		Name:     "StoreExamplesInVectorDatabase",
+		function: StoreExamplesInVectorDatabase,
+		Inputs: []AdapterParameter{
+			{Name: "examples", GoType: "[]CodeGenerationExample", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationExample]()},
+			{Name: "examplesCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "batchSize", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "vectorDistance", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			StoreExamplesInVectorDatabase(ctx, inputValue[[]sharedtypes.CodeGenerationExample](inputs, 0, "examples"), inputValue[string](inputs, 1, "examplesCollectionName"), inputValue[int](inputs, 2, "batchSize"), inputValue[string](inputs, 3, "vectorDistance"))
+			return nil
+		},
+	},
+	"StoreUserGuideSectionsInGraphDatabase": {
+		Name:     "StoreUserGuideSectionsInGraphDatabase",
+		function: StoreUserGuideSectionsInGraphDatabase,
+		Inputs: []AdapterParameter{
+			{Name: "dbname", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "sections", GoType: "[]CodeGenerationUserGuideSection", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationUserGuideSection]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			StoreUserGuideSectionsInGraphDatabase(ctx, inputValue[string](inputs, 0, "dbname"), inputValue[[]sharedtypes.CodeGenerationUserGuideSection](inputs, 1, "sections"))
+			return nil
+		},
+	},
+	"StoreUserGuideSectionsInVectorDatabase": {
+		Name:     "StoreUserGuideSectionsInVectorDatabase",
+		function: StoreUserGuideSectionsInVectorDatabase,
+		Inputs: []AdapterParameter{
+			{Name: "sections", GoType: "[]CodeGenerationUserGuideSection", Type: reflect.TypeFor[[]sharedtypes.CodeGenerationUserGuideSection]()},
+			{Name: "userGuideCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "batchSize", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "chunkSize", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "chunkOverlap", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "vectorDistance", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{},
+		call: func(ctx context.Context, inputs []any) []any {
+			StoreUserGuideSectionsInVectorDatabase(ctx, inputValue[[]sharedtypes.CodeGenerationUserGuideSection](inputs, 0, "sections"), inputValue[string](inputs, 1, "userGuideCollectionName"), inputValue[int](inputs, 2, "batchSize"), inputValue[int](inputs, 3, "chunkSize"), inputValue[int](inputs, 4, "chunkOverlap"), inputValue[string](inputs, 5, "vectorDistance"))
+			return nil
+		},
+	},
+	"StringConcat": {
+		Name:     "StringConcat",
+		function: StringConcat,
+		Inputs: []AdapterParameter{
+			{Name: "a", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "b", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "separator", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "string", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := StringConcat(inputValue[string](inputs, 0, "a"), inputValue[string](inputs, 1, "b"), inputValue[string](inputs, 2, "separator"))
+			return []any{output0}
+		},
+	},
+	"StringFormat": {
+		Name:     "StringFormat",
+		function: StringFormat,
+		Inputs: []AdapterParameter{
+			{Name: "data", GoType: "any", Type: reflect.TypeFor[any]()},
+			{Name: "format", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "string", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := StringFormat(inputValue[any](inputs, 0, "data"), inputValue[string](inputs, 1, "format"))
+			return []any{output0}
+		},
+	},
+	"SynthesizeActions": {
+		Name:     "SynthesizeActions",
+		function: SynthesizeActions,
+		Inputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "properties", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+			{Name: "actions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedActions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SynthesizeActions(inputValue[string](inputs, 0, "message"), inputValue[[]string](inputs, 1, "properties"), inputValue[[]map[string]string](inputs, 2, "actions"))
+			return []any{output0}
+		},
+	},
+	"SynthesizeActionsTool11": {
+		Name:     "SynthesizeActionsTool11",
+		function: SynthesizeActionsTool11,
+		Inputs: []AdapterParameter{
+			{Name: "content", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SynthesizeActionsTool11(inputValue[string](inputs, 0, "content"))
+			return []any{output0}
+		},
+	},
+	"SynthesizeActionsTool12": {
+		Name:     "SynthesizeActionsTool12",
+		function: SynthesizeActionsTool12,
+		Inputs: []AdapterParameter{
+			{Name: "content", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SynthesizeActionsTool12(inputValue[string](inputs, 0, "content"))
+			return []any{output0}
+		},
+	},
+	"SynthesizeActionsTool17": {
+		Name:     "SynthesizeActionsTool17",
+		function: SynthesizeActionsTool17,
+		Inputs: []AdapterParameter{
+			{Name: "content", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SynthesizeActionsTool17(inputValue[string](inputs, 0, "content"))
+			return []any{output0}
+		},
+	},
+	"SynthesizeActionsTool2": {
+		Name:     "SynthesizeActionsTool2",
+		function: SynthesizeActionsTool2,
+		Inputs: []AdapterParameter{
+			{Name: "message", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "actions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedActions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SynthesizeActionsTool2(inputValue[string](inputs, 0, "message"), inputValue[[]map[string]string](inputs, 1, "actions"))
+			return []any{output0}
+		},
+	},
+	"SynthesizeActionsTool3": {
+		Name:     "SynthesizeActionsTool3",
+		function: SynthesizeActionsTool3,
+		Inputs: []AdapterParameter{
+			{Name: "message_1", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "message_2", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "target_object", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "actions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "updatedActions", GoType: "[]map[string]string", Type: reflect.TypeFor[[]map[string]string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SynthesizeActionsTool3(inputValue[string](inputs, 0, "message_1"), inputValue[string](inputs, 1, "message_2"), inputValue[string](inputs, 2, "target_object"), inputValue[[]map[string]string](inputs, 3, "actions"))
+			return []any{output0}
+		},
+	},
+	"SynthesizeSlashCommand": {
+		Name:     "SynthesizeSlashCommand",
+		function: SynthesizeSlashCommand,
+		Inputs: []AdapterParameter{
+			{Name: "slashCmd", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "targetCmd", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "finalizeResult", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "result", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := SynthesizeSlashCommand(inputValue[string](inputs, 0, "slashCmd"), inputValue[string](inputs, 1, "targetCmd"), inputValue[string](inputs, 2, "finalizeResult"))
+			return []any{output0}
+		},
+	},
+	"UpdateTotalTokenCountForCustomerKvDb": {
+		Name:     "UpdateTotalTokenCountForCustomerKvDb",
+		function: UpdateTotalTokenCountForCustomerKvDb,
+		Inputs: []AdapterParameter{
+			{Name: "kvdbEndpoint", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "apiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "additionalTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "traceID", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "spanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "tokenLimitReached", GoType: "bool", Type: reflect.TypeFor[bool]()},
+			{Name: "childSpanID", GoType: "string", Type: reflect.TypeFor[string]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0, output1 := UpdateTotalTokenCountForCustomerKvDb(ctx, inputValue[string](inputs, 0, "kvdbEndpoint"), inputValue[string](inputs, 1, "apiKey"), inputValue[int](inputs, 2, "additionalTokenCount"), inputValue[string](inputs, 3, "traceID"), inputValue[string](inputs, 4, "spanID"))
+			return []any{output0, output1}
+		},
+	},
+	"UpdateTotalTokenCountForCustomerMongoDb": {
+		Name:     "UpdateTotalTokenCountForCustomerMongoDb",
+		function: UpdateTotalTokenCountForCustomerMongoDb,
+		Inputs: []AdapterParameter{
+			{Name: "apiKey", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbUrl", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDatabaseName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "additionalTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "tokenLimitReached", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := UpdateTotalTokenCountForCustomerMongoDb(ctx, inputValue[string](inputs, 0, "apiKey"), inputValue[string](inputs, 1, "mongoDbUrl"), inputValue[string](inputs, 2, "mongoDatabaseName"), inputValue[string](inputs, 3, "mongoDbCollectionName"), inputValue[int](inputs, 4, "additionalTokenCount"))
+			return []any{output0}
+		},
+	},
+	"UpdateTotalTokenCountForUserIdMongoDb": {
+		Name:     "UpdateTotalTokenCountForUserIdMongoDb",
+		function: UpdateTotalTokenCountForUserIdMongoDb,
+		Inputs: []AdapterParameter{
+			{Name: "userId", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbUrl", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDatabaseName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "mongoDbCollectionName", GoType: "string", Type: reflect.TypeFor[string]()},
+			{Name: "additionalInputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "additionalOutputTokenCount", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "hoursUntilTokenLimitReset", GoType: "int", Type: reflect.TypeFor[int]()},
+			{Name: "modelId", GoType: "[]string", Type: reflect.TypeFor[[]string]()},
+		},
+		Outputs: []AdapterParameter{
+			{Name: "tokenLimitReached", GoType: "bool", Type: reflect.TypeFor[bool]()},
+		},
+		call: func(ctx context.Context, inputs []any) []any {
+			output0 := UpdateTotalTokenCountForUserIdMongoDb(ctx, inputValue[string](inputs, 0, "userId"), inputValue[string](inputs, 1, "mongoDbUrl"), inputValue[string](inputs, 2, "mongoDatabaseName"), inputValue[string](inputs, 3, "mongoDbCollectionName"), inputValue[int](inputs, 4, "additionalInputTokenCount"), inputValue[int](inputs, 5, "additionalOutputTokenCount"), inputValue[int](inputs, 6, "hoursUntilTokenLimitReset"), inputValue[[]string](inputs, 7, "modelId"))
+			return []any{output0}
+		},
+	},
+}
@@ -26,7 +26,7 @@ import (
 	"encoding/json"
 )
 
-// SetGenerateRequestJsonBody creates a JSON body for the generate request to RHSC Copilot.
+// SetCopilotGenerateRequestJsonBody creates a JSON body for the generate request to RHSC Copilot.
 // It takes various parameters to configure the request and returns the JSON string.
 //
 // Tags:
@@ -44,6 +44,9 @@ import (
 //   - noCritique: whether to disable critique.
 //   - maxIterations: the maximum number of iterations for the request.
 //   - forceAzure: whether to force the use of Azure for the request.
+//
+// Returns:
+//   - jsonBody: the JSON body of the request.
 func SetCopilotGenerateRequestJsonBody(
 	query string,
 	sessionID string,
@@ -76,7 +79,7 @@ func SetCopilotGenerateRequestJsonBody(
 
 	bytes, err := json.MarshalIndent(req, "", "  ")
 	if err != nil {
-		panic("Error marshalling request to JSON: " + err.Error())
+		panic(NewInternalError(err, "Error marshalling request to JSON: %v", err))
 	}
 
 	jsonBody = string(bytes)
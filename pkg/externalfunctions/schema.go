@@ -0,0 +1,170 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ansys/aali-flowkit/pkg/jsonschema"
+)
+
+// FunctionSchemas holds the JSON Schemas of the inputs and outputs of a function
+// The schemas describe the inputs and outputs as properties of an object, each property
+// is the decoded value of the input or output.
+type FunctionSchemas struct {
+	Input  jsonschema.Schema `json:"input"`
+	Output jsonschema.Schema `json:"output"`
+}
+
+// Schemas generates the JSON Schemas of the inputs and outputs of every function in the registry
+//
+// Returns:
+//   - map[string]FunctionSchemas: the schemas by function name
+func Schemas() map[string]FunctionSchemas {
+	schemas := make(map[string]FunctionSchemas, len(FunctionAdapters))
+	for name := range FunctionAdapters {
+		schemas[name] = FunctionSchema(name)
+	}
+	return schemas
+}
+
+// FunctionSchema generates the JSON Schemas of the inputs and outputs of a function
+// The types are walked from the function signature, string enumerations are restricted to their
+// declared values and the metadata of the function adds descriptions, defaults and examples.
+//
+// Parameters:
+//   - name: the name of the function
+//
+// Returns:
+//   - FunctionSchemas: the schemas, empty if the function does not exist
+func FunctionSchema(name string) FunctionSchemas {
+	adapter, exists := FunctionAdapters[name]
+	if !exists {
+		return FunctionSchemas{}
+	}
+	metadata := FunctionsMetadata[name]
+	generator := jsonschema.NewGenerator(enumValues)
+
+	input := objectSchema(generator, fmt.Sprintf("%s inputs", name), adapter.Inputs, metadata.Inputs, false)
+	output := objectSchema(generator, fmt.Sprintf("%s outputs", name), adapter.Outputs, metadata.Outputs, true)
+	if definition, exists := FunctionDefinitions[name]; exists {
+		input["description"] = definition.Description
+	}
+	if metadata.Deprecated != "" {
+		input["deprecated"] = true
+	}
+
+	// the definitions are shared by both schemas
+	if defs := generator.Defs(); len(defs) > 0 {
+		input["$defs"] = defs
+		output["$defs"] = defs
+	}
+	return FunctionSchemas{Input: input, Output: output}
+}
+
+// objectSchema generates the schema of the inputs or outputs of a function
+//
+// Parameters:
+//   - generator: the schema generator
+//   - title: the title of the schema
+//   - params: the inputs or outputs of the adapter
+//   - paramsMetadata: the metadata of the inputs or outputs, in the same order
+//   - allRequired: whether all parameters are required, as the outputs that are always returned
+//
+// Returns:
+//   - jsonschema.Schema: the schema
+func objectSchema(generator *jsonschema.Generator, title string, params []AdapterParameter, paramsMetadata []ParameterMetadata, allRequired bool) jsonschema.Schema {
+	properties := jsonschema.Schema{}
+	required := []string{}
+	for i, param := range params {
+		schema := generator.Schema(param.Type)
+		if len(param.Options) > 0 {
+			schema["enum"] = param.Options
+		}
+		if i < len(paramsMetadata) {
+			paramMetadata := paramsMetadata[i]
+			if paramMetadata.Description != "" {
+				schema["description"] = paramMetadata.Description
+			}
+			if paramMetadata.Default != "" {
+				schema["default"] = schemaValue(paramMetadata.Default, param.Type)
+			}
+			if paramMetadata.Example != "" {
+				schema["examples"] = []any{schemaValue(paramMetadata.Example, param.Type)}
+			}
+		}
+		if param.Required || allRequired {
+			required = append(required, param.Name)
+		}
+		properties[param.Name] = schema
+	}
+
+	return jsonschema.Schema{
+		"$schema":              jsonschema.Draft,
+		"title":                title,
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// schemaValue converts a default or example value of the metadata to a JSON value
+// Values of string parameters and values that are not valid JSON are strings.
+//
+// Parameters:
+//   - value: the value from the docstring
+//   - t: the type of the parameter
+//
+// Returns:
+//   - any: the JSON value
+func schemaValue(value string, t reflect.Type) any {
+	if t.Kind() == reflect.String {
+		return value
+	}
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+	return decoded
+}
+
+// enumValues returns the declared values of a string enumeration of this package
+//
+// Parameters:
+//   - t: the named string type
+//
+// Returns:
+//   - []string: the values, nil if the type is not an enumeration of this package
+func enumValues(t reflect.Type) []string {
+	if t.PkgPath() != reflect.TypeFor[Enum]().PkgPath() {
+		return nil
+	}
+	enum, exists := Enums[t.Name()]
+	if !exists {
+		return nil
+	}
+	return enum.Values()
+}
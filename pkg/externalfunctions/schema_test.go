@@ -0,0 +1,55 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/jsonschema"
+)
+
+func TestFunctionSchema(t *testing.T) {
+	schemas := FunctionSchema("AppendMessageHistory")
+
+	properties := schemas.Input["properties"].(jsonschema.Schema)
+	role := properties["role"].(jsonschema.Schema)
+	if !reflect.DeepEqual(role["enum"], []string{"user", "assistant", "system"}) {
+		t.Errorf("role enum = %v", role["enum"])
+	}
+	if role["description"] != "the role of the message" {
+		t.Errorf("role description = %v", role["description"])
+	}
+	if !reflect.DeepEqual(schemas.Output["required"], []string{"updatedHistory"}) {
+		t.Errorf("output required = %v", schemas.Output["required"])
+	}
+}
+
+func TestSchemasCoverAllFunctions(t *testing.T) {
+	schemas := Schemas()
+	for name := range ExternalFunctionsMap {
+		if _, exists := schemas[name]; !exists {
+			t.Errorf("no schema for function %v", name)
+		}
+	}
+}
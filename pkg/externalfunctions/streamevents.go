@@ -0,0 +1,318 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// StreamFormat is the encoding of the messages of the stream outputs
+type StreamFormat string
+
+// Stream formats
+// With the legacy format, the stream carries the model tokens as is and the other information as
+// in-band markers such as "$&$error$&$:$&$message$&$". With the events format, every message is a
+// StreamEvent encoded as JSON.
+const (
+	StreamFormatLegacy StreamFormat = "legacy"
+	StreamFormatEvents StreamFormat = "events"
+)
+
+// ParseStreamFormat parses the name of a stream format
+//
+// Parameters:
+//   - value: the name of the format, "legacy" or "events"; empty for the legacy format
+//
+// Returns:
+//   - StreamFormat: the format
+//   - error: an error if the format is unknown
+func ParseStreamFormat(value string) (StreamFormat, error) {
+	switch format := StreamFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "", StreamFormatLegacy:
+		return StreamFormatLegacy, nil
+	case StreamFormatEvents:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown stream format %q, expected %q or %q", value, StreamFormatLegacy, StreamFormatEvents)
+	}
+}
+
+// StreamEventType is the kind of a stream event
+type StreamEventType string
+
+// Stream event types
+const (
+	// StreamEventToken is a part of the answer of the model
+	StreamEventToken StreamEventType = "token"
+	// StreamEventError is an error of the model or of the function
+	StreamEventError StreamEventType = "error"
+	// StreamEventUsage is the number of tokens used by the request
+	StreamEventUsage StreamEventType = "usage"
+	// StreamEventContext is the context the answer is based on, such as the retrieved documents
+	StreamEventContext StreamEventType = "context"
+	// StreamEventValidation is the result of the validation of the generated code
+	StreamEventValidation StreamEventType = "validation"
+	// StreamEventValue is a value of a typed stream output, such as a *chan sharedtypes.DbResponse
+	StreamEventValue StreamEventType = "value"
+	// StreamEventProgress is the progress of the function, see Progress
+	StreamEventProgress StreamEventType = "progress"
+	// StreamEventHeartbeat keeps the connection open while the function is silent
+	StreamEventHeartbeat StreamEventType = "heartbeat"
+	// StreamEventDone ends the stream, it is always the last event
+	StreamEventDone StreamEventType = "done"
+)
+
+// Code validation results of the validation events
+const (
+	ValidationValid   = "valid"
+	ValidationWarning = "warning"
+	ValidationInvalid = "invalid"
+)
+
+// StreamEvent is a typed message of a stream
+// Only the field of the event type is set. Stream is the name of the stream output the event
+// comes from, it is empty for the progress and done events that concern the whole call.
+type StreamEvent struct {
+	Type       StreamEventType     `json:"type"`
+	Stream     string              `json:"stream,omitempty"`
+	Delta      string              `json:"delta,omitempty"`
+	Error      *StreamError        `json:"error,omitempty"`
+	Usage      *TokenUsage         `json:"usage,omitempty"`
+	Context    string              `json:"context,omitempty"`
+	Validation string              `json:"validation,omitempty"`
+	Progress   *Progress           `json:"progress,omitempty"`
+	Value      json.RawMessage     `json:"value,omitempty"`
+	Outputs    []StreamEventOutput `json:"outputs,omitempty"`
+}
+
+// StreamError is the error of an error event
+type StreamError struct {
+	Code     string `json:"code"`
+	Reason   string `json:"reason,omitempty"`
+	Upstream string `json:"upstream,omitempty"`
+	Message  string `json:"message"`
+}
+
+// TokenUsage is the number of tokens of a usage event
+type TokenUsage struct {
+	InputTokens  int `json:"inputTokens"`
+	OutputTokens int `json:"outputTokens"`
+}
+
+// StreamEventOutput is an output of the function, sent with the done event for the outputs that are not streamed
+type StreamEventOutput struct {
+	Name   string `json:"name"`
+	GoType string `json:"goType"`
+	Value  string `json:"value"`
+}
+
+// Encode encodes the event as JSON, the value of a stream message in the events format
+//
+// Returns:
+//   - string: the encoded event
+func (event StreamEvent) Encode() string {
+	encoded, err := json.Marshal(event)
+	if err != nil {
+		// the events only hold strings and numbers
+		panic(NewInternalError(err, "error encoding stream event: %v", err))
+	}
+	return string(encoded)
+}
+
+// DecodeStreamEvent decodes a stream message in the events format
+//
+// Parameters:
+//   - value: the message
+//
+// Returns:
+//   - StreamEvent: the event
+//   - error: an error if the message is not an event
+func DecodeStreamEvent(value string) (StreamEvent, error) {
+	var event StreamEvent
+	err := json.Unmarshal([]byte(value), &event)
+	if err != nil || event.Type == "" {
+		return StreamEvent{}, fmt.Errorf("invalid stream event %q", value)
+	}
+	return event, nil
+}
+
+// ErrorEvent creates the error event of an error
+// Function errors keep their gRPC code, reason and upstream, other errors are internal errors.
+//
+// Parameters:
+//   - err: the error
+//
+// Returns:
+//   - StreamEvent: the error event
+func ErrorEvent(err error) StreamEvent {
+	functionError := ErrorFromPanic(err)
+	return StreamEvent{Type: StreamEventError, Error: &StreamError{
+		Code:     functionError.Code.String(),
+		Reason:   functionError.Reason,
+		Upstream: functionError.Upstream,
+		Message:  functionError.Message,
+	}}
+}
+
+// streamFormatContextKey is the context key of the stream format
+type streamFormatContextKey struct{}
+
+// ContextWithStreamFormat returns a copy of the context carrying the format of the stream outputs
+// It is set by the gRPC server from the request of the client, see StreamFormatFromContext.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - format: the format of the stream messages
+//
+// Returns:
+//   - context.Context: the context carrying the format
+func ContextWithStreamFormat(ctx context.Context, format StreamFormat) context.Context {
+	return context.WithValue(ctx, streamFormatContextKey{}, format)
+}
+
+// StreamFormatFromContext returns the format of the stream outputs of a request
+//
+// Parameters:
+//   - ctx: the context of the request
+//
+// Returns:
+//   - StreamFormat: the format, legacy if the context does not carry one
+func StreamFormatFromContext(ctx context.Context) StreamFormat {
+	format, ok := ctx.Value(streamFormatContextKey{}).(StreamFormat)
+	if !ok {
+		return StreamFormatLegacy
+	}
+	return format
+}
+
+// streamWriter writes the messages of a stream output in the format of the request
+// With the legacy format, the usage, context and validation markers are collected and sent together
+// in a final message by flush, like the clients of the legacy format expect.
+type streamWriter struct {
+	ctx     context.Context
+	channel *chan string
+	format  StreamFormat
+	final   string
+}
+
+// newStreamWriter creates the writer of a stream output
+//
+// Parameters:
+//   - ctx: the context of the request, carrying the stream format; the writes stop once it is done
+//   - channel: the channel of the stream output
+//
+// Returns:
+//   - *streamWriter: the writer
+func newStreamWriter(ctx context.Context, channel *chan string) *streamWriter {
+	return &streamWriter{ctx: ctx, channel: channel, format: StreamFormatFromContext(ctx)}
+}
+
+// write writes a message to the stream channel, every message of the writer goes through it
+// The message is dropped if the request is done, as nobody reads the stream anymore.
+//
+// Parameters:
+//   - message: the message
+func (writer *streamWriter) write(message string) {
+	select {
+	case *writer.channel <- message:
+	case <-writer.ctx.Done():
+	}
+}
+
+// send sends an event, or its legacy encoding
+//
+// Parameters:
+//   - event: the event
+//   - legacy: the legacy encoding of the event
+func (writer *streamWriter) send(event StreamEvent, legacy string) {
+	if writer.format == StreamFormatEvents {
+		writer.write(event.Encode())
+		return
+	}
+	writer.write(legacy)
+}
+
+// token sends a part of the answer of the model
+//
+// Parameters:
+//   - delta: the part of the answer
+func (writer *streamWriter) token(delta string) {
+	writer.send(StreamEvent{Type: StreamEventToken, Delta: delta}, delta)
+}
+
+// error sends an error
+//
+// Parameters:
+//   - err: the error
+func (writer *streamWriter) error(err error) {
+	event := ErrorEvent(err)
+	writer.send(event, fmt.Sprintf("$&$error$&$:$&$%v$&$", event.Error.Message))
+}
+
+// usage sends the number of tokens used by the request
+//
+// Parameters:
+//   - inputTokens: the number of input tokens
+//   - outputTokens: the number of output tokens
+func (writer *streamWriter) usage(inputTokens int, outputTokens int) {
+	if writer.format == StreamFormatEvents {
+		writer.send(StreamEvent{Type: StreamEventUsage, Usage: &TokenUsage{InputTokens: inputTokens, OutputTokens: outputTokens}}, "")
+		return
+	}
+	writer.final += fmt.Sprintf("$&$input_token_count$&$:$&$%d$&$;$&$output_token_count$&$:$&$%d$&$;", inputTokens, outputTokens)
+}
+
+// context sends the context the answer is based on
+//
+// Parameters:
+//   - context: the context
+func (writer *streamWriter) context(context string) {
+	if writer.format == StreamFormatEvents {
+		writer.send(StreamEvent{Type: StreamEventContext, Context: context}, "")
+		return
+	}
+	writer.final += fmt.Sprintf("$&$context$&$:$&$%s$&$;", context)
+}
+
+// validation sends the result of the validation of the generated code
+//
+// Parameters:
+//   - result: ValidationValid, ValidationWarning or ValidationInvalid
+func (writer *streamWriter) validation(result string) {
+	if writer.format == StreamFormatEvents {
+		writer.send(StreamEvent{Type: StreamEventValidation, Validation: result}, "")
+		return
+	}
+	writer.final += fmt.Sprintf("$&$code_validation$&$:$&$%s$&$;", result)
+}
+
+// flush sends the final message of the legacy format, if any
+func (writer *streamWriter) flush() {
+	if writer.final != "" {
+		writer.write(writer.final)
+		writer.final = ""
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package externalfunctions
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+)
+
+// streamMessages runs transferDatafromResponseToStreamChannel on responses and returns the messages of the stream
+func streamMessages(ctx context.Context, responses []sharedtypes.HandlerResponse, sendContext bool) []string {
+	responseChannel := make(chan sharedtypes.HandlerResponse, len(responses))
+	for _, response := range responses {
+		responseChannel <- response
+	}
+	close(responseChannel)
+	streamChannel := make(chan string, 10)
+	transferDatafromResponseToStreamChannel(ctx, &responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", sendContext, "docs")
+	messages := []string{}
+	for message := range streamChannel {
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// chatResponse creates a chat response of aali-llm
+func chatResponse(data string, isLast bool) sharedtypes.HandlerResponse {
+	return sharedtypes.HandlerResponse{Type: "chat", ChatData: &data, IsLast: &isLast}
+}
+
+func TestTransferDataStreamFormats(t *testing.T) {
+	answer := []sharedtypes.HandlerResponse{chatResponse("Hello", false), chatResponse(" world", true)}
+	failure := []sharedtypes.HandlerResponse{chatResponse("Hel", false), {Type: "error", Error: &sharedtypes.ErrorResponse{Message: "model overloaded"}}}
+
+	// the legacy format keeps the in-band markers
+	got := streamMessages(context.Background(), answer, true)
+	want := []string{"Hello", " world", "$&$context$&$:$&$docs$&$;"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("legacy messages = %q, want %q", got, want)
+	}
+	got = streamMessages(context.Background(), failure, false)
+	want = []string{"Hel", "$&$error$&$:$&$model overloaded$&$"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("legacy error messages = %q, want %q", got, want)
+	}
+
+	// the events format sends one typed event per message
+	ctx := ContextWithStreamFormat(context.Background(), StreamFormatEvents)
+	var events []StreamEvent
+	for _, message := range streamMessages(ctx, answer, true) {
+		event, err := DecodeStreamEvent(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+	wantEvents := []StreamEvent{
+		{Type: StreamEventToken, Delta: "Hello"},
+		{Type: StreamEventToken, Delta: " world"},
+		{Type: StreamEventContext, Context: "docs"},
+	}
+	if !reflect.DeepEqual(events, wantEvents) {
+		t.Errorf("events = %+v, want %+v", events, wantEvents)
+	}
+
+	messages := streamMessages(ctx, failure, false)
+	event, err := DecodeStreamEvent(messages[len(messages)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantError := StreamError{Code: "Internal", Reason: ReasonUpstreamError, Upstream: UpstreamLLM, Message: "model overloaded"}
+	if event.Type != StreamEventError || event.Error == nil || *event.Error != wantError {
+		t.Errorf("error event = %+v, want %+v", event.Error, wantError)
+	}
+}
+
+func TestTransferDataStopsWhenRequestIsDone(t *testing.T) {
+	// the client reads nothing and aali-llm keeps answering
+	responseChannel := make(chan sharedtypes.HandlerResponse)
+	streamChannel := make(chan string)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		transferDatafromResponseToStreamChannel(ctx, &responseChannel, &streamChannel, false, false, "", 0, 0, "", "", "", false, "")
+	}()
+	responseChannel <- chatResponse("Hel", false)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the transfer did not stop after the request was done")
+	}
+	if _, open := <-streamChannel; open {
+		t.Error("the stream channel is open, want it closed")
+	}
+}
+
+func TestStreamWriterDropsMessagesWhenRequestIsDone(t *testing.T) {
+	for _, format := range []StreamFormat{StreamFormatLegacy, StreamFormatEvents} {
+		ctx, cancel := context.WithCancel(ContextWithStreamFormat(context.Background(), format))
+		cancel()
+		// nobody reads the stream channel, every write must return
+		streamChannel := make(chan string)
+		writer := newStreamWriter(ctx, &streamChannel)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			writer.token("Hello")
+			writer.error(NewInternalError(nil, "model overloaded"))
+			writer.usage(1, 2)
+			writer.context("docs")
+			writer.validation(ValidationValid)
+			writer.flush()
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("the %s writer blocked after the request was done", format)
+		}
+	}
+}
+
+func TestParseStreamFormat(t *testing.T) {
+	for value, want := range map[string]StreamFormat{"": StreamFormatLegacy, "legacy": StreamFormatLegacy, " Events ": StreamFormatEvents} {
+		got, err := ParseStreamFormat(value)
+		if err != nil || got != want {
+			t.Errorf("ParseStreamFormat(%q) = %q, %v, want %q", value, got, err, want)
+		}
+	}
+	if _, err := ParseStreamFormat("binary"); err == nil {
+		t.Error("ParseStreamFormat(\"binary\") succeeded, want an error")
+	}
+	if _, err := DecodeStreamEvent("Hello"); err == nil {
+		t.Error("DecodeStreamEvent of a token of the legacy format succeeded, want an error")
+	}
+}
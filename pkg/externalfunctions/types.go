@@ -211,6 +211,19 @@ type AnsysGPTRetrieverModuleRequest struct {
 	Platform      string `json:"platform"`
 }
 
+// LLMRequestOptions represents the options of a general request to the LLM.
+// The system prompt is either a string or a map of strings, the input and output tokens
+// are only counted if TokenCountModelName is set.
+type LLMRequestOptions struct {
+	History             []sharedtypes.HistoricMessage `json:"history,omitempty"`
+	SystemPrompt        any                           `json:"systemPrompt,omitempty"`
+	ModelIds            []string                      `json:"modelIds,omitempty"`
+	ModelCategory       []string                      `json:"modelCategory,omitempty"`
+	ModelOptions        *sharedtypes.ModelOptions     `json:"modelOptions,omitempty"`
+	Images              []string                      `json:"images,omitempty"`
+	TokenCountModelName string                        `json:"tokenCountModelName,omitempty"`
+}
+
 // DataExtractionBranch represents the branch structure for the data extraction.
 type DataExtractionBranch struct {
 	Text             string
@@ -30,12 +30,38 @@ import (
 	"go/parser"
 	"go/token"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ansys/aali-flowkit/pkg/internalstates"
 	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
 )
 
+// EnumDefinition is a string enumeration, a named string type with its declared constants
+type EnumDefinition struct {
+	Name      string
+	Constants []EnumConstant
+}
+
+// EnumConstant is a declared constant of a string enumeration
+type EnumConstant struct {
+	Name  string
+	Value string
+}
+
+// Values returns the declared values of the constants of the enumeration
+//
+// Returns:
+//   - []string: the values of the constants, in the order of declaration.
+func (enum EnumDefinition) Values() []string {
+	values := make([]string, len(enum.Constants))
+	for i, constant := range enum.Constants {
+		values[i] = constant.Value
+	}
+	return values
+}
+
 // ExtractFunctionDefinitionsFromPackage parses the given file for public functions and populates internalstates.AvailableFunctions.
 // The function definitions are stored in the internalstates.AvailableFunctions map.
 // The key is the function name and the value is a FunctionDefinition struct.
@@ -43,6 +69,7 @@ import (
 // The inputs and outputs are stored as FunctionInput and FunctionOutput structs, respectively.
 // The FunctionInput and FunctionOutput structs contain the name, type, and GoType of the input/output.
 // The GoType is the Go type of the input/output, while the Type is a simplified type string (e.g., "string", "number", "boolean", "json").
+// Inputs of a string enumeration declared in the file are sent as strings, with the values of the enumeration as options.
 //
 // The function returns an error if the file cannot be parsed.
 //
@@ -53,6 +80,24 @@ import (
 // Returns:
 //   - error: an error if the file cannot be parsed.
 func ExtractFunctionDefinitionsFromPackage(content string, category string) error {
+	enums, err := ExtractEnumDefinitions(content)
+	if err != nil {
+		return err
+	}
+	return ExtractFunctionDefinitionsWithEnums(content, category, enums)
+}
+
+// ExtractFunctionDefinitionsWithEnums parses the given file for public functions and populates internalstates.AvailableFunctions,
+// like ExtractFunctionDefinitionsFromPackage, with string enumerations that may be declared in other files of the package.
+//
+// Parameters:
+//   - content: the content of the file to parse.
+//   - category: the category of the functions, used for grouping in the FunctionDefinition struct.
+//   - enums: the string enumerations of the package, by type name.
+//
+// Returns:
+//   - error: an error if the file cannot be parsed.
+func ExtractFunctionDefinitionsWithEnums(content string, category string, enums map[string]EnumDefinition) error {
 	fset := token.NewFileSet() // positions are relative to fset
 
 	// Parse the file given by filePath
@@ -61,56 +106,6 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 		return err
 	}
 
-	enumerable_definitions := make(map[string][]string)
-
-	// Iterate over all declarations in the file to extract type and const definitions
-	for _, decl := range node.Decls {
-
-		// get general declarations
-		if gd, isGd := decl.(*ast.GenDecl); isGd {
-
-			// differentiating between type and const declarations
-			switch gd.Tok {
-
-			case token.TYPE:
-				// type declaration - only consider string type
-				spec := gd.Specs[0]
-				type_spec, is_type_spec := spec.(*ast.TypeSpec)
-				if is_type_spec {
-					ident, isIdent := type_spec.Type.(*ast.Ident)
-					if isIdent {
-						// check whether name is string -> exclude all other types including structs
-						if ident.Name == "string" {
-							// add to enumerable_definition
-							enumerable_definitions[type_spec.Name.Name] = []string{}
-						}
-					}
-				}
-
-			case token.CONST:
-				// const declaration (enumerable entries)
-
-				// extract consts
-				for _, spec := range gd.Specs {
-
-					// should be value spec
-					value_spec, is_value_spec := spec.(*ast.ValueSpec)
-					if is_value_spec {
-						// check whether enumerable is defined
-						_, is_enumerable_defined := enumerable_definitions[value_spec.Type.(*ast.Ident).Name]
-						if is_enumerable_defined {
-							// add to enumerable_definition
-							enumerable_definitions[value_spec.Type.(*ast.Ident).Name] = append(enumerable_definitions[value_spec.Type.(*ast.Ident).Name], value_spec.Names[0].Name)
-						}
-					}
-
-				}
-
-			}
-
-		}
-	}
-
 	// Iterate over all declarations in the file
 	for _, decl := range node.Decls {
 		// Filter function declarations
@@ -133,6 +128,11 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 				// Handle inputs (parameters)
 				if fn.Type.Params != nil {
 					for _, param := range fn.Type.Params.List {
+						// skip the request context, it is injected by the gRPC server
+						if isContextType(param.Type) {
+							continue
+						}
+
 						if len(param.Names) == 0 {
 							funcDef.Input = append(funcDef.Input, &aaliflowkitgrpc.FunctionInputDefinition{
 								Name:   typeExprToString(param.Type),
@@ -148,16 +148,18 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 
 								// check if enumerable
 								goType := typeExprToString(param.Type)
+								simpleType := typeExprToSimpleType(param.Type)
 								options := []string{}
-								enumerable, is_enumerable := enumerable_definitions[goType]
+								enum, is_enumerable := enums[goType]
 								if is_enumerable {
-									options = enumerable
+									options = enum.Values()
 									goType = "string"
+									simpleType = "string"
 								}
 
 								funcDef.Input = append(funcDef.Input, &aaliflowkitgrpc.FunctionInputDefinition{
 									Name:    paramName.Name,
-									Type:    typeExprToSimpleType(param.Type),
+									Type:    simpleType,
 									GoType:  goType,
 									Options: options,
 								})
@@ -197,6 +199,79 @@ func ExtractFunctionDefinitionsFromPackage(content string, category string) erro
 	return nil
 }
 
+// ExtractEnumDefinitions parses the given files for string enumerations.
+// A string enumeration is a type declared with the string underlying type, its constants
+// are the constants of that type declared in any of the files. Constants without a value
+// repeat the value of the previous constant, as in Go.
+//
+// Parameters:
+//   - contents: the contents of the files to parse.
+//
+// Returns:
+//   - map[string]EnumDefinition: the string enumerations, by type name.
+//   - error: an error if a file cannot be parsed.
+func ExtractEnumDefinitions(contents ...string) (map[string]EnumDefinition, error) {
+	enums := map[string]EnumDefinition{}
+	constDecls := []*ast.GenDecl{}
+	for _, content := range contents {
+		node, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range node.Decls {
+			gd, isGd := decl.(*ast.GenDecl)
+			if !isGd {
+				continue
+			}
+			switch gd.Tok {
+			case token.TYPE:
+				// only consider types with the string underlying type, structs and aliases are excluded
+				for _, spec := range gd.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					ident, isIdent := typeSpec.Type.(*ast.Ident)
+					if isIdent && ident.Name == "string" && typeSpec.Assign == 0 {
+						enums[typeSpec.Name.Name] = EnumDefinition{Name: typeSpec.Name.Name, Constants: []EnumConstant{}}
+					}
+				}
+			case token.CONST:
+				constDecls = append(constDecls, gd)
+			}
+		}
+	}
+
+	// the constants are read once all types are known, they may be declared in another file than their type
+	for _, gd := range constDecls {
+		typeName := ""
+		var values []ast.Expr
+		for _, spec := range gd.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if valueSpec.Type != nil || len(valueSpec.Values) > 0 {
+				typeName = ""
+				if ident, isIdent := valueSpec.Type.(*ast.Ident); isIdent {
+					typeName = ident.Name
+				}
+				values = valueSpec.Values
+			}
+
+			enum, isEnum := enums[typeName]
+			if !isEnum {
+				continue
+			}
+			for i, name := range valueSpec.Names {
+				value := name.Name
+				if i < len(values) {
+					if literal, isLiteral := values[i].(*ast.BasicLit); isLiteral && literal.Kind == token.STRING {
+						value, _ = strconv.Unquote(literal.Value)
+					}
+				}
+				enum.Constants = append(enum.Constants, EnumConstant{Name: name.Name, Value: value})
+			}
+			enums[typeName] = enum
+		}
+	}
+	return enums, nil
+}
+
 // typeExprToSimpleType translates an ast.Expr (which represents a type in Go's AST) into a simple type string,
 // treating user-defined types and any complex structures as "json".
 // The simple types are "string", "number", "boolean", and "json".
@@ -270,6 +345,23 @@ func typeExprToString(expr ast.Expr) string {
 	return cleanedTypeStr
 }
 
+// isContextType checks whether an ast.Expr represents the context.Context type.
+// Parameters of this type are not exposed as function inputs, the request context is passed by the gRPC server instead.
+//
+// Parameters:
+//   - expr: the ast.Expr representing the type.
+//
+// Returns:
+//   - bool: true if the type is context.Context, false otherwise.
+func isContextType(expr ast.Expr) bool {
+	selector, isSelector := expr.(*ast.SelectorExpr)
+	if !isSelector {
+		return false
+	}
+	pkg, isIdent := selector.X.(*ast.Ident)
+	return isIdent && pkg.Name == "context" && selector.Sel.Name == "Context"
+}
+
 // extractTagValue extracts the value of a tag from a docstring.
 // The tag value is expected to be in the format "- tag: value".
 //
@@ -293,6 +385,218 @@ func extractTagValue(docText, tag string) string {
 	return ""
 }
 
+// ParameterDoc is the documentation of a function parameter or result, parsed from the "Parameters:" or "Returns:" section of the docstring
+type ParameterDoc struct {
+	Name        string
+	Description string
+	Required    bool
+	Default     string
+	Example     string
+}
+
+// parameterLinePattern matches a documented parameter in the format "- name: description"
+var parameterLinePattern = regexp.MustCompile(`^-\s*([\w\[\]{}*.]+):\s*(.*)$`)
+
+// valueMarkerPattern matches the markers with a value in a parameter description, e.g. "@default(10)"
+var valueMarkerPattern = regexp.MustCompile(`@(default|example)\(([^)]*)\)`)
+
+// FunctionDoc is the documentation of a function parsed from its docstring, in addition to its description and display name
+type FunctionDoc struct {
+	Deprecated string
+	Since      string
+	Tags       []string
+	Inputs     map[string]ParameterDoc
+	Outputs    map[string]ParameterDoc
+}
+
+// ExtractFunctionMetadata extracts the documentation of a function from its docstring.
+// The "@deprecated", "@since" and "@tags" tags are read from the "Tags:" section, the tags
+// are given as a comma separated list. The inputs and outputs are read from the "Parameters:"
+// and "Returns:" sections, see ExtractParameterDocs.
+//
+// Parameters:
+//   - docText: the docstring text to extract the documentation from.
+//
+// Returns:
+//   - FunctionDoc: the documentation of the function.
+func ExtractFunctionMetadata(docText string) FunctionDoc {
+	doc := FunctionDoc{
+		Deprecated: extractTagValue(docText, "@deprecated"),
+		Since:      extractTagValue(docText, "@since"),
+		Tags:       []string{},
+		Inputs:     ExtractParameterDocs(docText),
+		Outputs:    ExtractReturnDocs(docText),
+	}
+	for _, tag := range strings.Split(extractTagValue(docText, "@tags"), ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			doc.Tags = append(doc.Tags, tag)
+		}
+	}
+	return doc
+}
+
+// ExtractParameterDocs extracts the documentation of the parameters from a docstring.
+// The parameters are expected in the "Parameters:" section, in the format "- name: description".
+// A parameter is required if its description contains the "@required" marker, and optional
+// if it contains the "@optional" marker or no marker at all. The "@default(value)" and
+// "@example(value)" markers give the default and an example value of the parameter.
+// The markers are removed from the description.
+//
+// Parameters:
+//   - docText: the docstring text to extract the parameters from.
+//
+// Returns:
+//   - map[string]ParameterDoc: the documentation of the parameters, by parameter name.
+func ExtractParameterDocs(docText string) map[string]ParameterDoc {
+	return extractSectionDocs(docText, "Parameters:")
+}
+
+// ExtractReturnDocs extracts the documentation of the results from a docstring.
+// The results are expected in the "Returns:" section, in the format "- name: description".
+//
+// Parameters:
+//   - docText: the docstring text to extract the results from.
+//
+// Returns:
+//   - map[string]ParameterDoc: the documentation of the results, by result name.
+func ExtractReturnDocs(docText string) map[string]ParameterDoc {
+	return extractSectionDocs(docText, "Returns:")
+}
+
+// extractSectionDocs extracts the documented parameters of a section of a docstring.
+// Lines that do not start a new parameter continue the description of the previous one,
+// the section ends with the next section title, e.g. "Returns:".
+//
+// Parameters:
+//   - docText: the docstring text to extract the parameters from.
+//   - section: the title of the section.
+//
+// Returns:
+//   - map[string]ParameterDoc: the documentation of the parameters, by parameter name.
+func extractSectionDocs(docText string, section string) map[string]ParameterDoc {
+	params := map[string]ParameterDoc{}
+	descriptions := map[string]string{}
+	names := []string{}
+	inSection := false
+	for _, line := range strings.Split(docText, "\n") {
+		line = strings.TrimSpace(line)
+		if line == section {
+			inSection = true
+			continue
+		}
+		if !inSection || line == "" {
+			continue
+		}
+
+		matches := parameterLinePattern.FindStringSubmatch(line)
+		switch {
+		case matches != nil:
+			names = append(names, matches[1])
+			descriptions[matches[1]] = matches[2]
+		case strings.HasSuffix(line, ":"):
+			// next section
+			inSection = false
+		case len(names) > 0:
+			// continuation of the previous description
+			last := names[len(names)-1]
+			descriptions[last] += " " + line
+		}
+	}
+
+	for _, name := range names {
+		description := descriptions[name]
+		doc := ParameterDoc{Name: name, Required: strings.Contains(description, "@required")}
+		for _, match := range valueMarkerPattern.FindAllStringSubmatch(description, -1) {
+			switch match[1] {
+			case "default":
+				doc.Default = strings.TrimSpace(match[2])
+			case "example":
+				doc.Example = strings.TrimSpace(match[2])
+			}
+		}
+		description = valueMarkerPattern.ReplaceAllString(description, "")
+		for _, marker := range []string{"@required", "@optional"} {
+			description = strings.ReplaceAll(description, marker, "")
+		}
+		doc.Description = strings.Join(strings.Fields(description), " ")
+		params[name] = doc
+	}
+	return params
+}
+
+// LintFunctionDocs checks that the parameters and results documented in the docstrings of the
+// exported functions of the given file match their signature.
+//
+// Parameters:
+//   - content: the content of the file to check.
+//
+// Returns:
+//   - []string: a description of every mismatch, empty if the docstrings match the signatures.
+//   - error: an error if the file cannot be parsed.
+func LintFunctionDocs(content string) ([]string, error) {
+	node, err := parser.ParseFile(token.NewFileSet(), "", content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	problems := []string{}
+	for _, decl := range node.Decls {
+		fn, isFn := decl.(*ast.FuncDecl)
+		if !isFn || !fn.Name.IsExported() || fn.Recv != nil {
+			continue
+		}
+		docText := fn.Doc.Text()
+		problems = append(problems, lintSection(fn.Name.Name, "parameter", ExtractParameterDocs(docText), fn.Type.Params)...)
+		problems = append(problems, lintSection(fn.Name.Name, "result", ExtractReturnDocs(docText), fn.Type.Results)...)
+	}
+	return problems, nil
+}
+
+// lintSection compares the documented parameters of a section with the fields of a signature.
+// The request context is passed by the server, so documenting it is optional.
+//
+// Parameters:
+//   - functionName: the name of the function.
+//   - kind: the kind of the fields, "parameter" or "result".
+//   - docs: the documented parameters.
+//   - fields: the fields of the signature, may be nil.
+//
+// Returns:
+//   - []string: a description of every documented parameter missing from the signature and of every
+//     parameter of the signature missing from the documentation.
+func lintSection(functionName string, kind string, docs map[string]ParameterDoc, fields *ast.FieldList) []string {
+	names := map[string]bool{}
+	undocumented := []string{}
+	if fields != nil {
+		for _, field := range fields.List {
+			// unnamed results are documented by their type
+			if len(field.Names) == 0 {
+				return []string{}
+			}
+			for _, name := range field.Names {
+				names[name.Name] = true
+				_, documented := docs[name.Name]
+				if !documented && name.Name != "_" && !isContextType(field.Type) {
+					undocumented = append(undocumented, name.Name)
+				}
+			}
+		}
+	}
+
+	problems := []string{}
+	for name := range docs {
+		if !names[name] {
+			problems = append(problems, fmt.Sprintf("%s: documented %s %q is not in the signature", functionName, kind, name))
+		}
+	}
+	for _, name := range undocumented {
+		problems = append(problems, fmt.Sprintf("%s: %s %q is not documented", functionName, kind, name))
+	}
+	sort.Strings(problems)
+	return problems
+}
+
 // displayNameOrDefault returns the displayName if it is not empty, otherwise it returns the defaultName.
 //
 // Parameters:
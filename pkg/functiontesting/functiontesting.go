@@ -23,6 +23,7 @@
 package functiontesting
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
@@ -38,7 +39,7 @@ import (
 //   - indexName: the name of the index to search
 //   - query: the query string to search for
 func TestAnsysGPTACSSearchIndex(indexName string, query string) {
-	embeddedQuery, _ := externalfunctions.PerformVectorEmbeddingRequest(query, false)
+	embeddedQuery, _ := externalfunctions.PerformVectorEmbeddingRequest(context.Background(), query, false)
 
 	// defaultFields := []sharedtypes.AnsysGPTDefaultFields{
 	// 	{QueryWord: "course", FieldName: "type_of_asset", FieldDefaultValue: "aic"},
@@ -64,6 +65,6 @@ func TestAnsysGPTACSSearchIndex(indexName string, query string) {
 	// Extract fields from the query
 	// filter := externalfunctions.AnsysGPTExtractFieldsFromQuery(query, filedValues, defaultFields)
 	// output := externalfunctions.AnsysGPTACSSemanticHybridSearchs(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexNames, filter, 10)
-	output := externalfunctions.AisAcsSemanticHybridSearchs(acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexNames, physics, 10)
+	output := externalfunctions.AisAcsSemanticHybridSearchs(context.Background(), acsEndpoint, acsApiKey, acsApiVersion, query, embeddedQuery, indexNames, physics, 10)
 	fmt.Println(len(output))
 }
@@ -0,0 +1,395 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/pipeline"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+)
+
+// apiKeyMetadataKey is the request metadata key carrying the API key
+const apiKeyMetadataKey = "x-api-key"
+
+// defaultAPIKeyName is the name of the key set with FLOWKIT_API_KEY
+const defaultAPIKeyName = "default"
+
+// apiKey is an API key accepted by the gRPC server
+// A key without categories may call the functions of every category.
+type apiKey struct {
+	Name       string   `yaml:"name"`
+	Key        string   `yaml:"key"`
+	Categories []string `yaml:"categories"`
+}
+
+// allows checks whether the key may call the functions of a category
+//
+// Parameters:
+// - category: the category of the function
+//
+// Returns:
+// - bool: true if the category is allowed
+func (key *apiKey) allows(category string) bool {
+	return len(key.Categories) == 0 || slices.Contains(key.Categories, category)
+}
+
+// apiKeyFile is the content of the API key file
+//
+// Example:
+//
+//	keys:
+//	  - name: ingestion
+//	    key: "..."
+//	    categories: [data_extraction, knowledge_db]
+type apiKeyFile struct {
+	Keys []apiKey `yaml:"keys"`
+}
+
+// apiKeyStore holds the API keys accepted by the gRPC server
+// The keys of the key file are reloaded when the file changes, so keys can be added
+// and rotated without restarting the server.
+type apiKeyStore struct {
+	staticKey string
+	filePath  string
+
+	mutex   sync.RWMutex
+	keys    []apiKey
+	modTime time.Time
+}
+
+// isHealthMethod checks whether a gRPC method belongs to the grpc.health.v1 service
+//
+// Parameters:
+// - fullMethod: the full name of the method
+//
+// Returns:
+// - bool: true for health methods
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// apiKeyContextKey is the context key of the API key that authenticated a request
+type apiKeyContextKey struct{}
+
+// newAPIKeyStore creates the API key store and loads the key file
+//
+// Parameters:
+// - staticKey: the key set in FLOWKIT_API_KEY, accepted for all categories; can be empty
+// - filePath: the path of the API key file; can be empty
+//
+// Returns:
+// - *apiKeyStore: the API key store
+// - error: an error if the key file cannot be loaded
+func newAPIKeyStore(staticKey string, filePath string) (*apiKeyStore, error) {
+	store := &apiKeyStore{staticKey: staticKey, filePath: filePath}
+	_, err := store.reload()
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// empty checks whether the store accepts no key, in which case authentication is disabled
+//
+// Returns:
+// - bool: true if no key is configured
+func (store *apiKeyStore) empty() bool {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	return store.staticKey == "" && store.filePath == "" && len(store.keys) == 0
+}
+
+// reload loads the key file if it changed since the last load
+// The previous keys are kept if the file cannot be read or is invalid.
+//
+// Returns:
+// - bool: true if the keys were reloaded
+// - error: an error if the key file cannot be loaded
+func (store *apiKeyStore) reload() (bool, error) {
+	if store.filePath == "" {
+		return false, nil
+	}
+
+	times, err := modTimes(store.filePath)
+	if err != nil {
+		return false, fmt.Errorf("error reading API key file: %v", err)
+	}
+	store.mutex.RLock()
+	unchanged := times[0].Equal(store.modTime)
+	store.mutex.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	content, err := os.ReadFile(store.filePath)
+	if err != nil {
+		return false, fmt.Errorf("error reading API key file: %v", err)
+	}
+	file := apiKeyFile{}
+	err = yaml.UnmarshalStrict(content, &file)
+	if err != nil {
+		return false, fmt.Errorf("error parsing API key file: %v", err)
+	}
+	names := map[string]bool{defaultAPIKeyName: store.staticKey != ""}
+	for i, key := range file.Keys {
+		if key.Name == "" || key.Key == "" {
+			return false, fmt.Errorf("API key %d of the key file has no name or key", i)
+		}
+		if names[key.Name] {
+			return false, fmt.Errorf("API key name '%s' is used more than once", key.Name)
+		}
+		names[key.Name] = true
+	}
+
+	store.mutex.Lock()
+	store.keys = file.Keys
+	store.modTime = times[0]
+	store.mutex.Unlock()
+	return true, nil
+}
+
+// watch reloads the key file at the given interval until the context is done
+//
+// Parameters:
+// - ctx: the context stopping the reloads
+// - interval: the interval between two checks of the file
+func (store *apiKeyStore) watch(ctx context.Context, interval time.Duration) {
+	if store.filePath == "" {
+		return
+	}
+	watchReloads(ctx, interval, "API keys", store.reload)
+}
+
+// lookup returns the key matching the received API key
+// Every key is compared in constant time.
+//
+// Parameters:
+// - received: the API key received with the request
+//
+// Returns:
+// - *apiKey: the matching key
+// - bool: true if a key matches
+func (store *apiKeyStore) lookup(received string) (*apiKey, bool) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	var match *apiKey
+	if store.staticKey != "" && subtle.ConstantTimeCompare([]byte(received), []byte(store.staticKey)) == 1 {
+		match = &apiKey{Name: defaultAPIKeyName, Key: store.staticKey}
+	}
+	for i := range store.keys {
+		if subtle.ConstantTimeCompare([]byte(received), []byte(store.keys[i].Key)) == 1 && match == nil {
+			key := store.keys[i]
+			match = &key
+		}
+	}
+	return match, match != nil
+}
+
+// authenticate checks the API key in the metadata of a request
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - context.Context: the context carrying the matching key, see apiKeyFromContext
+// - error: an Unauthenticated error if the key is missing or invalid
+func (store *apiKeyStore) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
+
+	receivedApiKeys := md[apiKeyMetadataKey]
+	if len(receivedApiKeys) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	key, ok := store.lookup(receivedApiKeys[0])
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	// the functions running a plan, such as the macros, authorize its steps against the key
+	ctx = context.WithValue(ctx, apiKeyContextKey{}, key)
+	return pipeline.ContextWithAuthorizer(ctx, authorizeFunction), nil
+}
+
+// apiKeyFromContext returns the API key that authenticated a request
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - *apiKey: the key, nil if authentication is disabled
+func apiKeyFromContext(ctx context.Context) *apiKey {
+	key, _ := ctx.Value(apiKeyContextKey{}).(*apiKey)
+	return key
+}
+
+// authorizeFunction checks whether the key of a request may call a function
+// Unknown functions are let through, so that the handler reports them as not found.
+//
+// Parameters:
+// - ctx: the context of the request
+// - functionName: the name of the function
+//
+// Returns:
+// - error: a PermissionDenied error if the category of the function is not allowed
+func authorizeFunction(ctx context.Context, functionName string) error {
+	key := apiKeyFromContext(ctx)
+	if key == nil {
+		return nil
+	}
+	functionDefinition, ok := internalstates.AvailableFunctions[functionName]
+	if !ok || key.allows(functionDefinition.Category) {
+		return nil
+	}
+	logging.Log.Warnf(logContext(ctx), "API key '%s' is not allowed to call function %s of category %s", key.Name, functionName, functionDefinition.Category)
+	return status.Errorf(codes.PermissionDenied, "API key '%s' is not allowed to call functions of category %s", key.Name, functionDefinition.Category)
+}
+
+// allowedFunctions returns the functions the key of a request may call
+//
+// Parameters:
+// - ctx: the context of the request
+// - functions: the functions to filter
+//
+// Returns:
+// - map[string]*aaliflowkitgrpc.FunctionDefinition: the allowed functions
+func allowedFunctions(ctx context.Context, functions map[string]*aaliflowkitgrpc.FunctionDefinition) map[string]*aaliflowkitgrpc.FunctionDefinition {
+	key := apiKeyFromContext(ctx)
+	if key == nil || len(key.Categories) == 0 {
+		return functions
+	}
+	allowed := map[string]*aaliflowkitgrpc.FunctionDefinition{}
+	for name, function := range functions {
+		if key.allows(function.Category) {
+			allowed[name] = function
+		}
+	}
+	return allowed
+}
+
+// apiKeyAuthInterceptor is a gRPC server interceptor that checks for a valid API key in the metadata of the request
+// Function calls are also checked against the categories allowed for the key.
+//
+// Parameters:
+// - store: the accepted API keys
+//
+// Returns:
+// - grpc.UnaryServerInterceptor: a gRPC server interceptor
+func apiKeyAuthInterceptor(store *apiKeyStore) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		// Health checks are open to probes, which cannot send an API key
+		if isHealthMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := store.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if inputs, ok := req.(*aaliflowkitgrpc.FunctionInputs); ok {
+			err := authorizeFunction(ctx, inputs.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Continue handling the request
+		return handler(ctx, req)
+	}
+}
+
+// apiKeyStreamInterceptor is the stream counterpart of apiKeyAuthInterceptor
+// The function inputs received on the stream are checked against the categories allowed for the key.
+//
+// Parameters:
+// - store: the accepted API keys
+//
+// Returns:
+// - grpc.StreamServerInterceptor: a gRPC server interceptor
+func apiKeyStreamInterceptor(store *apiKeyStore) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if isHealthMethod(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		ctx, err := store.authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		// Continue handling the stream
+		return handler(srv, &authenticatedStream{contextServerStream{ServerStream: stream, ctx: ctx}})
+	}
+}
+
+// authenticatedStream is a server stream carrying the API key of the request in its context
+type authenticatedStream struct {
+	contextServerStream
+}
+
+// RecvMsg receives a message and checks the function it calls
+//
+// Parameters:
+// - m: the message to receive into
+//
+// Returns:
+// - error: an error if receiving fails or the function is not allowed
+func (stream *authenticatedStream) RecvMsg(m interface{}) error {
+	err := stream.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	if inputs, ok := m.(*aaliflowkitgrpc.FunctionInputs); ok {
+		return authorizeFunction(stream.ctx, inputs.Name)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func writeAPIKeyFile(t *testing.T, path string, content string, modTime time.Time) {
+	t.Helper()
+	err := os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chtimes(path, modTime, modTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAPIKeyStoreReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	now := time.Now()
+	writeAPIKeyFile(t, path, "keys:\n  - name: ingestion\n    key: old-key\n    categories: [qdrant]\n", now)
+
+	store, err := newAPIKeyStore("static-key", path)
+	if err != nil {
+		t.Fatalf("newAPIKeyStore() error = %v", err)
+	}
+	if key, ok := store.lookup("static-key"); !ok || key.Name != defaultAPIKeyName {
+		t.Errorf("lookup(static-key) = %v, %v", key, ok)
+	}
+	if key, ok := store.lookup("old-key"); !ok || key.Name != "ingestion" || !slices.Equal(key.Categories, []string{"qdrant"}) {
+		t.Errorf("lookup(old-key) = %v, %v", key, ok)
+	}
+
+	// rotate the key
+	writeAPIKeyFile(t, path, "keys:\n  - name: ingestion\n    key: new-key\n", now.Add(time.Second))
+	reloaded, err := store.reload()
+	if err != nil || !reloaded {
+		t.Fatalf("reload() = %v, %v", reloaded, err)
+	}
+	if _, ok := store.lookup("old-key"); ok {
+		t.Error("old key still accepted after rotation")
+	}
+	if _, ok := store.lookup("new-key"); !ok {
+		t.Error("new key not accepted after rotation")
+	}
+
+	// an invalid file keeps the previous keys
+	writeAPIKeyFile(t, path, "keys:\n  - name: ingestion\n", now.Add(2*time.Second))
+	if _, err := store.reload(); err == nil {
+		t.Error("reload() of a key without value succeeded")
+	}
+	if _, ok := store.lookup("new-key"); !ok {
+		t.Error("previous key dropped after failed reload")
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	inputs *aaliflowkitgrpc.FunctionInputs
+}
+
+func (stream *testServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *testServerStream) RecvMsg(m interface{}) error {
+	*m.(*aaliflowkitgrpc.FunctionInputs) = *stream.inputs
+	return nil
+}
+
+func TestAPIKeyStreamInterceptor(t *testing.T) {
+	previousFunctions := internalstates.AvailableFunctions
+	internalstates.AvailableFunctions = testFunctions
+	defer func() { internalstates.AvailableFunctions = previousFunctions }()
+
+	store := &apiKeyStore{keys: []apiKey{{Name: "ingestion", Key: "ingestion-key", Categories: []string{"qdrant"}}}}
+	interceptor := apiKeyStreamInterceptor(store)
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&aaliflowkitgrpc.FunctionInputs{})
+	}
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		function string
+		want     codes.Code
+	}{
+		{name: "missing key", md: metadata.MD{}, function: "QdrantInsertData", want: codes.Unauthenticated},
+		{name: "invalid key", md: metadata.Pairs(apiKeyMetadataKey, "other-key"), function: "QdrantInsertData", want: codes.Unauthenticated},
+		{name: "allowed category", md: metadata.Pairs(apiKeyMetadataKey, "ingestion-key"), function: "QdrantInsertData", want: codes.OK},
+		{name: "denied category", md: metadata.Pairs(apiKeyMetadataKey, "ingestion-key"), function: "GeneralGraphDbQuery", want: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &testServerStream{
+				ctx:    metadata.NewIncomingContext(context.Background(), tt.md),
+				inputs: &aaliflowkitgrpc.FunctionInputs{Name: tt.function},
+			}
+			err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/aaliflowkitgrpc.ExternalFunctions/StreamFunction"}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("interceptor() code = %v, want %v (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestAllowedFunctions(t *testing.T) {
+	key := &apiKey{Name: "ingestion", Categories: []string{"qdrant", "generic"}}
+	ctx := context.WithValue(context.Background(), apiKeyContextKey{}, key)
+	got := slices.Sorted(maps.Keys(allowedFunctions(ctx, testFunctions)))
+	want := []string{"AssignStringToString", "QdrantCreateCollection", "QdrantInsertData"}
+	if !slices.Equal(got, want) {
+		t.Errorf("allowedFunctions() = %v, want %v", got, want)
+	}
+}
+
+func TestAPIKeyAuthInterceptorHealth(t *testing.T) {
+	interceptor := apiKeyAuthInterceptor(&apiKeyStore{staticKey: "static-key"})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	if err != nil {
+		t.Errorf("health check without API key error = %v", err)
+	}
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/aaliflowkitgrpc.ExternalFunctions/HealthCheck"}, handler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("HealthCheck without API key error = %v", err)
+	}
+}
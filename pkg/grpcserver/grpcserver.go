@@ -24,33 +24,54 @@ package grpcserver
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
-	"reflect"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
 	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
-	"github.com/ansys/aali-sharedtypes/pkg/typeconverters"
 
 	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/jobs"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// logging context keys of the requests
+const (
+	clientIdentityLogKey = logging.ContextKey("client_identity")
+	apiKeyLogKey         = logging.ContextKey("api_key")
+)
+
 // server is used to implement grpc_definition.ExternalFunctionsServer.
 type server struct {
 	aaliflowkitgrpc.UnimplementedExternalFunctionsServer
+	health *healthChecker
+	jobs   *jobs.Manager
+	// streams keeps the resumable streams of StreamFunction, nil if they are disabled
+	streams *streamRegistry
 }
 
 // StartServer starts the gRPC server
 // The server listens on the port specified in the configuration file
 // The server implements the ExternalFunctionsServer interface
+// It returns once the server is shut down after receiving SIGINT or SIGTERM, see shutdown.
 func StartServer() {
+	// Stop the server on the first SIGINT or SIGTERM, the next one terminates the process
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	// Get webserver address
 	webserverAddress, err := config.HandleLegacyPortDefinition(config.GlobalConfig.FLOWKIT_ADDRESS, config.GlobalConfig.EXTERNALFUNCTIONS_GRPC_PORT)
 	if err != nil {
@@ -64,68 +85,95 @@ func StartServer() {
 	}
 
 	// Check if SSL is enabled and load the server's certificate and private key
+	// Client certificates are verified if client CA bundles are configured
 	var opts []grpc.ServerOption
+	clientCAFiles := listValues([]string{config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_CLIENT_CA_FILES"]})
 	if config.GlobalConfig.USE_SSL {
-		creds, err := credentials.NewServerTLSFromFile(
+		clientAuth, err := parseClientAuth(config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_CLIENT_AUTH"])
+		if err != nil {
+			logging.Log.Fatalf(&logging.ContextMap{}, "failed to configure mutual TLS: %v", err)
+		}
+		certificates, err := newCertificateStore(
 			config.GlobalConfig.SSL_CERT_PUBLIC_KEY_FILE,
 			config.GlobalConfig.SSL_CERT_PRIVATE_KEY_FILE,
+			clientCAFiles,
+			clientAuth,
 		)
 		if err != nil {
 			logging.Log.Fatalf(&logging.ContextMap{}, "failed to load SSL certificates: %v", err)
 		}
-		opts = append(opts, grpc.Creds(creds))
+		go certificates.watch(ctx, durationVariable("FLOWKIT_CERT_RELOAD_SECONDS", defaultReloadInterval))
+		opts = append(opts, grpc.Creds(credentials.NewTLS(certificates.tlsConfig())))
+		if certificates.mutualTLS() {
+			opts = append(opts, grpc.ChainUnaryInterceptor(clientIdentityInterceptor()))
+			opts = append(opts, grpc.ChainStreamInterceptor(clientIdentityStreamInterceptor()))
+		}
+	} else if len(clientCAFiles) > 0 {
+		logging.Log.Fatalf(&logging.ContextMap{}, "FLOWKIT_CLIENT_CA_FILES requires USE_SSL")
 	}
 
-	// Add API key authentication interceptor if an API key is provided
-	if config.GlobalConfig.FLOWKIT_API_KEY != "" {
-		opts = append(opts, grpc.UnaryInterceptor(apiKeyAuthInterceptor(config.GlobalConfig.FLOWKIT_API_KEY)))
+	// Add API key authentication interceptors if an API key or an API key file is provided
+	keys, err := newAPIKeyStore(config.GlobalConfig.FLOWKIT_API_KEY, config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_API_KEY_FILE"])
+	if err != nil {
+		logging.Log.Fatalf(&logging.ContextMap{}, "failed to load API keys: %v", err)
+	}
+	if !keys.empty() {
+		go keys.watch(ctx, durationVariable("FLOWKIT_API_KEY_FILE_RELOAD_SECONDS", defaultReloadInterval))
+		opts = append(opts, grpc.ChainUnaryInterceptor(apiKeyAuthInterceptor(keys)))
+		opts = append(opts, grpc.ChainStreamInterceptor(apiKeyStreamInterceptor(keys)))
 	}
 
 	// Set gRPC message size limits
 	opts = append(opts, grpc.MaxRecvMsgSize(1024*1024*1024)) // 1 GB receive limit
 	opts = append(opts, grpc.MaxSendMsgSize(1024*1024*1024)) // 1 GB send limit
 
-	// Create the gRPC server with the options
-	s := grpc.NewServer(opts...)
-	aaliflowkitgrpc.RegisterExternalFunctionsServer(s, &server{})
-	logging.Log.Infof(&logging.ContextMap{}, "Aali FlowKit started successfully; gRPC server listening on address '%s'...\n", webserverAddress)
-	if err := s.Serve(lis); err != nil {
-		logging.Log.Fatalf(&logging.ContextMap{}, "failed to serve: %v", err)
+	// Probe the dependencies in the background for the grpc.health.v1 service
+	probes, err := dependencyProbes()
+	if err != nil {
+		logging.Log.Fatalf(&logging.ContextMap{}, "failed to configure health probes: %v", err)
 	}
-}
+	healthChecker := newHealthChecker(probes)
+	go healthChecker.run(ctx, durationVariable("FLOWKIT_HEALTH_PROBE_SECONDS", defaultHealthProbeInterval))
 
-// apiKeyAuthInterceptor is a gRPC server interceptor that checks for a valid API key in the metadata of the request
-// The API key is passed as a string parameter
-//
-// Parameters:
-// - apiKey: a string containing the API key
-//
-// Returns:
-// - grpc.UnaryServerInterceptor: a gRPC server interceptor
-func apiKeyAuthInterceptor(apiKey string) grpc.UnaryServerInterceptor {
-	return func(
-		ctx context.Context,
-		req interface{},
-		info *grpc.UnaryServerInfo,
-		handler grpc.UnaryHandler,
-	) (interface{}, error) {
-		// Extract API key from metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
-		}
+	// Run the jobs submitted with SubmitFunction in the background
+	jobManager, err := newJobManager()
+	if err != nil {
+		logging.Log.Fatalf(&logging.ContextMap{}, "failed to create job manager: %v", err)
+	}
+	go jobManager.RunPruning(ctx, durationVariable("FLOWKIT_JOB_RETENTION_SECONDS", defaultJobRetention))
 
-		receivedApiKeys := md["x-api-key"]
-		if len(receivedApiKeys) == 0 || receivedApiKeys[0] != apiKey {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
-		}
+	// Keep the frames of StreamFunction for ResumeStream
+	streams, err := newStreamRegistry()
+	if err != nil {
+		logging.Log.Fatalf(&logging.ContextMap{}, "failed to configure resumable streams: %v", err)
+	}
+
+	// Create the gRPC server with the options
+	s := grpc.NewServer(opts...)
+	flowkitServer := &server{health: healthChecker, jobs: jobManager, streams: streams}
+	aaliflowkitgrpc.RegisterExternalFunctionsServer(s, flowkitServer)
+	s.RegisterService(&jobsServiceDesc, flowkitServer)
+	s.RegisterService(&pipelinesServiceDesc, flowkitServer)
+	s.RegisterService(&streamsServiceDesc, flowkitServer)
+	healthpb.RegisterHealthServer(s, healthChecker.server)
+	logging.Log.Infof(&logging.ContextMap{}, "Aali FlowKit started successfully; gRPC server listening on address '%s'...\n", webserverAddress)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.Serve(lis)
+	}()
 
-		// Continue handling the request
-		return handler(ctx, req)
+	select {
+	case err := <-serveErr:
+		logging.Log.Fatalf(&logging.ContextMap{}, "failed to serve: %v", err)
+	case <-ctx.Done():
 	}
+	stop()
+	shutdown(s, healthChecker, jobManager, streams, durationVariable("FLOWKIT_SHUTDOWN_GRACE_SECONDS", defaultShutdownGracePeriod))
 }
 
 // HealthCheck checks the health of the gRPC server
+// The status is "OK" if all configured dependencies are reachable, otherwise it lists the failing ones.
+// The standard grpc.health.v1 service gives the status of each dependency, see healthChecker.
 //
 // Parameters:
 // - ctx: the context of the request
@@ -135,6 +183,15 @@ func apiKeyAuthInterceptor(apiKey string) grpc.UnaryServerInterceptor {
 // - aaliflowkitgrpc.HealthCheckResponse: a response indicating the health of the server
 // - error: an error if the health check fails
 func (s *server) HealthCheck(ctx context.Context, req *aaliflowkitgrpc.HealthRequest) (*aaliflowkitgrpc.HealthResponse, error) {
+	if s.health != nil {
+		unhealthy := s.health.unhealthy()
+		if len(unhealthy) > 0 {
+			return &aaliflowkitgrpc.HealthResponse{
+				Status: "NOT_SERVING: " + strings.Join(unhealthy, ", "),
+			}, nil
+		}
+	}
+
 	// return a successful health check response
 	return &aaliflowkitgrpc.HealthResponse{
 		Status: "OK",
@@ -166,6 +223,12 @@ func (s *server) GetVersion(ctx context.Context, req *aaliflowkitgrpc.VersionReq
 }
 
 // ListFunctions lists all available function from the external functions package
+// Only the functions allowed for the API key of the request are listed.
+// The functions can be filtered and paginated with request metadata, see parseFunctionFilter.
+// The response header carries the entity tag of the response, if the request has the
+// same tag in "if-none-match", no function is returned and "x-not-modified" is set.
+// If the request has the "x-function-metadata: true" metadata, the documentation of the functions
+// that does not fit in their definitions is sent as JSON in the "x-function-metadata-bin" response header.
 //
 // Parameters:
 // - ctx: the context of the request
@@ -175,9 +238,38 @@ func (s *server) GetVersion(ctx context.Context, req *aaliflowkitgrpc.VersionReq
 // - aaliflowkitgrpc.ListOfFunctions: a list of all available functions
 // - error: an error if the function fails
 func (s *server) ListFunctions(ctx context.Context, req *aaliflowkitgrpc.ListFunctionsRequest) (*aaliflowkitgrpc.ListFunctionsResponse, error) {
+	filter, err := parseFunctionFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	functions, nextPageToken := filterFunctions(allowedFunctions(ctx, internalstates.AvailableFunctions), filter)
+
+	// the client already has this catalog
+	etag := catalogETag(functions, filter, internalstates.FlowkitVersion)
+	header := metadata.Pairs(etagKey, etag)
+	md, _ := metadata.FromIncomingContext(ctx)
+	notModified := slices.Contains(md.Get(ifNoneMatchKey), etag)
+	if notModified {
+		header.Set(notModifiedKey, "true")
+	} else if nextPageToken != "" {
+		header.Set(nextPageTokenKey, nextPageToken)
+	}
+	err = grpc.SetHeader(ctx, header)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error sending response header: %v", err)
+	}
+	if notModified {
+		return &aaliflowkitgrpc.ListFunctionsResponse{}, nil
+	}
 
-	// return all available functions
-	return &aaliflowkitgrpc.ListFunctionsResponse{Functions: internalstates.AvailableFunctions}, nil
+	if requestsFunctionMetadata(ctx) {
+		err := sendFunctionMetadata(ctx, functions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &aaliflowkitgrpc.ListFunctionsResponse{Functions: functions}, nil
 }
 
 // RunFunction runs a function from the external functions package
@@ -191,104 +283,94 @@ func (s *server) ListFunctions(ctx context.Context, req *aaliflowkitgrpc.ListFun
 // Returns:
 // - aaliflowkitgrpc.FunctionOutputs: the outputs of the function
 // - error: an error if the function fails
-func (s *server) RunFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs) (output *aaliflowkitgrpc.FunctionOutputs, err error) {
+func (s *server) RunFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs) (*aaliflowkitgrpc.FunctionOutputs, error) {
+	return runFunction(ctx, "RunFunction", req)
+}
+
+// runFunction runs a function and encodes its outputs
+// It serves RunFunction and the jobs submitted with SubmitFunction.
+//
+// Parameters:
+// - ctx: the context of the call
+// - method: the name of the gRPC method calling the function
+// - req: the request to run a function
+//
+// Returns:
+// - aaliflowkitgrpc.FunctionOutputs: the outputs of the function
+// - error: an error if the function fails
+func runFunction(ctx context.Context, method string, req *aaliflowkitgrpc.FunctionInputs) (output *aaliflowkitgrpc.FunctionOutputs, err error) {
+	ctx, endCall := traceFunction(ctx, method, req.Name)
+	defer func() { endCall(err) }()
+
 	defer func() {
 		r := recover()
 		if r != nil {
-			err = fmt.Errorf("error occured in gRPC server aali-flowkit during RunFunction of '%v': %v", req.Name, r)
+			err = recoveredError(ctx, method, req.Name, r)
 		}
 	}()
 
-	// get function definition from available functions
-	functionDefinition, ok := internalstates.AvailableFunctions[req.Name]
-	if !ok {
-		return nil, fmt.Errorf("function with name %s not found", req.Name)
+	// call the function
+	adapter, results, err := callFunction(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	// create input slice
-	inputs := make([]interface{}, len(functionDefinition.Input))
-
-	// unmarshal json string values for each input into the correct type
-	for i, input := range req.Inputs {
-		var err error
-		inputs[i], err = typeconverters.ConvertStringToGivenType(input.Value, functionDefinition.Input[i].GoType)
-		if err != nil {
-			return nil, fmt.Errorf("error converting input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-		}
-
-		// check for option sets and convert values
-		if len(functionDefinition.Input[i].Options) > 0 {
-			// convert value to correct type
-			inputs[i], err = convertOptionSetValues(functionDefinition.Name, input.Name, inputs[i])
-			if err != nil {
-				return nil, fmt.Errorf("error converting option set input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-			}
-		}
+	// the outputs are discarded if the request was cancelled or its deadline exceeded
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
 	}
 
-	// get externalfunctions package and the function
-	function, exists := externalfunctions.ExternalFunctionsMap[functionDefinition.Name]
-	if !exists {
-		return nil, fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
-	}
-	funcValue := reflect.ValueOf(function)
-	if !funcValue.IsValid() {
-		return nil, fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
-	}
-
-	// Prepare arguments for the function
-	args := []reflect.Value{}
-	for i, input := range inputs {
-		if input == nil && i < len(functionDefinition.Input) {
-			// Handle missing parameters with standard datatype defaults
-			expectedType := functionDefinition.Input[i].GoType
-			switch expectedType {
-			case "bool":
-				args = append(args, reflect.ValueOf(false))
-			case "int":
-				args = append(args, reflect.ValueOf(0))
-			case "string":
-				args = append(args, reflect.ValueOf(""))
-			case "float64":
-				args = append(args, reflect.ValueOf(0.0))
-			case "map[uint]float32":
-				args = append(args, reflect.ValueOf(make(map[uint]float32)))
-			default:
-				// Catch-all default for other types
-				args = append(args, reflect.Zero(reflect.TypeOf(input)))
-			}
-		} else {
-			args = append(args, reflect.ValueOf(input))
-		}
+	outputs, err := encodeOutputs(adapter, results)
+	if err != nil {
+		return nil, err
 	}
 
-	// Call the function
-	results := funcValue.Call(args)
+	// return outputs
+	return &aaliflowkitgrpc.FunctionOutputs{Name: req.Name, Outputs: outputs}, nil
+}
 
+// encodeOutputs encodes the outputs of a function to their string values
+//
+// Parameters:
+// - adapter: the adapter of the function
+// - results: the outputs returned by the function
+//
+// Returns:
+// - []*aaliflowkitgrpc.FunctionOutput: the encoded outputs
+// - error: an error if an output cannot be encoded
+func encodeOutputs(adapter externalfunctions.FunctionAdapter, results []any) ([]*aaliflowkitgrpc.FunctionOutput, error) {
 	// create output slice
 	outputs := []*aaliflowkitgrpc.FunctionOutput{}
 	for i, result := range results {
 		// marshal value to json string
-		value, err := typeconverters.ConvertGivenTypeToString(result.Interface(), functionDefinition.Output[i].GoType)
+		value, err := adapter.EncodeOutput(i, result)
 		if err != nil {
-			return nil, fmt.Errorf("error converting output %s to string: %v", functionDefinition.Output[i].Name, err)
+			return nil, err
 		}
 
 		// append output to slice
 		outputs = append(outputs, &aaliflowkitgrpc.FunctionOutput{
-			Name:   functionDefinition.Output[i].Name,
-			GoType: functionDefinition.Output[i].GoType,
+			Name:   adapter.Outputs[i].Name,
+			GoType: adapter.Outputs[i].GoType,
 			Value:  value,
 		})
 	}
-
-	// return outputs
-	return &aaliflowkitgrpc.FunctionOutputs{Name: req.Name, Outputs: outputs}, nil
+	return outputs, nil
 }
 
 // StreamFunction streams a function from the external functions package
 // The function is identified by the function id
 // The function inputs are passed as a list of FunctionInput
+// The messages of the stream outputs of the function, its outputs of type *chan T, are sent as they arrive.
+// With the legacy format, the function can only have a single *chan string stream output and its
+// messages are sent as is. With the events format, see streamFormat, the messages of all stream outputs
+// are sent as events tagged with the name of their output, and a done event with the other outputs ends the stream.
+// If the request has the "x-progress: true" metadata, the progress reported by the function is sent
+// in progress frames, see progressValue; functions without stream output then send their outputs
+// as JSON in the last frame.
+// If the request has the "x-resumable: true" metadata, the stream ID is sent in the x-stream-id response header
+// and the function keeps running when the client disconnects, so that the client can reconnect with ResumeStream.
+// Other streams stop their function as soon as the client disconnects.
 //
 // Parameters:
 // - req: the request to stream a function
@@ -297,158 +379,261 @@ func (s *server) RunFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionI
 // Returns:
 // - error: an error if the function fails
 func (s *server) StreamFunction(req *aaliflowkitgrpc.FunctionInputs, stream aaliflowkitgrpc.ExternalFunctions_StreamFunctionServer) (err error) {
+	// the stream context is cancelled when the client disconnects or the deadline is exceeded
+	ctx, endCall := traceFunction(stream.Context(), "StreamFunction", req.Name)
+
+	// without resumable stream, the function stops when the client disconnects
+	if !requestsResumable(ctx) {
+		defer func() { endCall(err) }()
+		return streamFunction(ctx, req, stream)
+	}
+	if s.streams == nil {
+		err = status.Error(codes.FailedPrecondition, "resumable streams are disabled")
+		endCall(err)
+		return err
+	}
+
+	// the function of a resumable stream runs detached from the request, its frames are buffered
+	// so that a client can reconnect with ResumeStream and the ID sent in the x-stream-id header;
+	// the call ends, with its span, when the function ends
+	functionCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	session := s.streams.create(jobOwner(ctx), cancel)
+	err = stream.SetHeader(metadata.Pairs(streamIDHeaderKey, session.id))
+	if err != nil {
+		s.streams.remove(session)
+		endCall(err)
+		return err
+	}
+	go func() {
+		functionErr := streamFunction(functionCtx, req, session)
+		session.finish(functionErr)
+		endCall(functionErr)
+	}()
+	return s.streams.follow(ctx, session, -1, stream)
+}
+
+// streamFunction calls a function and sends the frames of StreamFunction
+//
+// Parameters:
+// - ctx: the context of the call
+// - req: the request to stream a function
+// - sender: receives the frames
+//
+// Returns:
+// - error: an error if the function fails
+func streamFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs, sender frameSender) (err error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			err = fmt.Errorf("error occured in gRPC server aali-flowkit during StreamFunction of '%v': %v", req.Name, r)
+			err = recoveredError(ctx, "StreamFunction", req.Name, r)
 		}
 	}()
 
-	// get function definition from available functions
-	functionDefinition, ok := internalstates.AvailableFunctions[req.Name]
-	if !ok {
-		return fmt.Errorf("function with id %s not found", req.Name)
+	// the messages of the function are written in the format asked by the client
+	format, err := streamFormat(ctx)
+	if err != nil {
+		return err
 	}
+	ctx = externalfunctions.ContextWithStreamFormat(ctx, format)
+	events := format == externalfunctions.StreamFormatEvents
 
-	// create input slice
-	inputs := make([]interface{}, len(functionDefinition.Input))
+	// check the stream outputs before starting the function
+	adapter, err := lookupFunction(req.Name)
+	if err != nil {
+		return err
+	}
+	if !events && !legacyStreams(adapter) {
+		return status.Errorf(codes.FailedPrecondition, "function %s has several or typed stream outputs, stream it with the %s: %s metadata", req.Name, streamFormatRequestKey, externalfunctions.StreamFormatEvents)
+	}
 
-	// unmarshal json string values for each input into the correct type
-	for i, input := range req.Inputs {
-		var err error
-		inputs[i], err = typeconverters.ConvertStringToGivenType(input.Value, functionDefinition.Input[i].GoType)
-		if err != nil {
-			return fmt.Errorf("error converting input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-		}
+	// call the function, sending its progress while it runs if the client follows it
+	frames := &streamFrames{stream: sender, format: format}
+	var progress <-chan externalfunctions.Progress
+	var results []any
+	if requestsProgress(ctx) {
+		var report func(externalfunctions.Progress)
+		progress, report = progressUpdates()
+		ctx = externalfunctions.ContextWithProgressReporter(ctx, externalfunctions.NewProgressReporter(report))
+		adapter, results, err = callWithProgress(ctx, req, progress, frames)
+	} else {
+		adapter, results, err = callFunction(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
 
-		// check for option sets and convert values
-		if len(functionDefinition.Input[i].Options) > 0 {
-			// convert value to correct type
-			inputs[i], err = convertOptionSetValues(functionDefinition.Name, input.Name, inputs[i])
-			if err != nil {
-				return fmt.Errorf("error converting option set input '%s' of function '%s' to type '%s': %v", input.Name, req.Name, functionDefinition.Input[i].GoType, err)
-			}
+	streams := newStreamOutputs(adapter, results)
+	if len(streams.indexes) == 0 && !events {
+		if progress == nil {
+			return status.Errorf(codes.FailedPrecondition, "function %s has no stream output", req.Name)
 		}
-	}
 
-	// get externalfunctions package and the function
-	function, exists := externalfunctions.ExternalFunctionsMap[functionDefinition.Name]
-	if !exists {
-		return fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
-	}
-	funcValue := reflect.ValueOf(function)
-	if !funcValue.IsValid() {
-		return fmt.Errorf("function %s not found in externalfunctions package", functionDefinition.Name)
-	}
-
-	// Prepare arguments for the function
-	args := []reflect.Value{}
-	for i, input := range inputs {
-		if input == nil && i < len(functionDefinition.Input) {
-			// Handle missing parameters with standard datatype defaults
-			expectedType := functionDefinition.Input[i].GoType
-			switch expectedType {
-			case "bool":
-				args = append(args, reflect.ValueOf(false))
-			case "int":
-				args = append(args, reflect.ValueOf(0))
-			case "string":
-				args = append(args, reflect.ValueOf(""))
-			case "float64":
-				args = append(args, reflect.ValueOf(0.0))
-			case "map[uint]float32":
-				args = append(args, reflect.ValueOf(make(map[uint]float32)))
-			default:
-				// Catch-all default for other types
-				args = append(args, reflect.Zero(reflect.TypeOf(input)))
-			}
-		} else {
-			args = append(args, reflect.ValueOf(input))
+		// the outputs of the function are sent in the last frame
+		outputs, err := encodeOutputs(adapter, results)
+		if err != nil {
+			return err
 		}
+		value, err := json.Marshal(jobOutputs(outputs))
+		if err != nil {
+			return status.Errorf(codes.Internal, "error encoding outputs of function %s: %v", req.Name, err)
+		}
+		err = frames.message(string(value))
+		if err != nil {
+			return err
+		}
+		return frames.end()
 	}
 
-	// Call the function
-	results := funcValue.Call(args)
-
-	// get stream channel from results
-	var streamChannel *chan string
-	for i, output := range functionDefinition.Output {
-		if output.GoType == "*chan string" {
-			streamChannel = results[i].Interface().(*chan string)
-		}
+	// send heartbeat frames while the stream outputs are silent, so that proxies keep the connection open
+	var heartbeat <-chan time.Time
+	interval := durationVariable("FLOWKIT_STREAM_HEARTBEAT_SECONDS", 0)
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		heartbeat = ticker.C
 	}
 
-	// listen to channel and send to stream
-	var counter int32
-	var previousOutput *aaliflowkitgrpc.StreamOutput
-	for message := range *streamChannel {
-		// create output
-		output := &aaliflowkitgrpc.StreamOutput{
-			MessageCounter: counter,
-			IsLast:         false,
-			Value:          message,
+	// listen to the stream outputs and send their messages to the stream
+	// the function tears down its upstream calls through the same context when the request is cancelled
+	for {
+		message, open, err := streams.receive(ctx, progress, heartbeat)
+		if err != nil {
+			return err
 		}
-
-		// send output to stream
-		if counter > 0 {
-			err := stream.Send(previousOutput)
+		if message.progress != nil {
+			err = frames.progress(*message.progress)
 			if err != nil {
 				return err
 			}
+			continue
+		}
+		if message.heartbeat {
+			err = frames.heartbeat(interval)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if !open {
+			break
 		}
 
-		// save output to previous output
-		previousOutput = output
-
-		// increment counter
-		counter++
+		var value string
+		if events {
+			event, err := streams.event(message)
+			if err != nil {
+				return err
+			}
+			value = event.Encode()
+		} else {
+			value = message.value.String()
+		}
+		err = frames.message(value)
+		if err != nil {
+			return err
+		}
 	}
 
-	// send last message
-	output := &aaliflowkitgrpc.StreamOutput{
-		MessageCounter: counter,
-		IsLast:         true,
-		Value:          previousOutput.Value,
-	}
-	err = stream.Send(output)
-	if err != nil {
-		return err
+	// the events format ends the stream with a done event carrying the outputs that are not streamed
+	if events {
+		outputs, err := streams.finalOutputs(results)
+		if err != nil {
+			return err
+		}
+		err = frames.message(doneEvent(outputs).Encode())
+		if err != nil {
+			return err
+		}
 	}
 
-	return nil
+	// send last message
+	return frames.end()
 }
 
-// convertOptionSetValues converts the option set values for the given function and input
+// recoveredError converts the value recovered from a panicking function to a gRPC status error
+// Typed function errors keep their code and details, any other value is reported as an internal error.
 //
 // Parameters:
-// - functionName: a string containing the function name
-// - inputName: a string containing the input name
-// - inputValue: an interface containing the input value
+// - ctx: the context of the request
+// - method: the name of the gRPC method
+// - functionName: the name of the function
+// - recovered: the value recovered from the panic
 //
 // Returns:
-// - interface: an interface containing the converted value
-// - error: an error containing the error message
-func convertOptionSetValues(functionName string, inputName string, inputValue interface{}) (interface{}, error) {
-	defer func() {
-		r := recover()
-		if r != nil {
-			logging.Log.Errorf(&logging.ContextMap{}, "Panic occured in convertOptionSetValues: %v", r)
-		}
-	}()
+// - error: the gRPC status error
+func recoveredError(ctx context.Context, method string, functionName string, recovered interface{}) error {
+	// the function failed because the request was cancelled or its deadline exceeded
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
 
-	switch functionName {
+	functionError := *externalfunctions.ErrorFromPanic(recovered)
+	functionError.WithFunction(functionName)
+	functionError.Message = fmt.Sprintf("error occured in gRPC server aali-flowkit during %v of '%v': %v", method, functionName, functionError.Message)
+	return &functionError
+}
 
-	case "AppendMessageHistory":
+// logContext returns the logging context of a request, carrying the identity of the client and the name of its API key
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - *logging.ContextMap: the logging context
+func logContext(ctx context.Context) *logging.ContextMap {
+	logCtx := &logging.ContextMap{}
+	if identity, ok := externalfunctions.ClientIdentityFromContext(ctx); ok {
+		logCtx.Set(clientIdentityLogKey, identity.String())
+	}
+	if key := apiKeyFromContext(ctx); key != nil {
+		logCtx.Set(apiKeyLogKey, key.Name)
+	}
+	return logCtx
+}
 
-		switch inputName {
+// callFunction calls a function from the external functions package through its typed adapter
+// The function is identified by the name of the request and its inputs are bound by name, see externalfunctions.FunctionAdapter.Bind.
+//
+// Parameters:
+// - ctx: the context of the request, passed to functions that accept one
+// - req: the request to run the function
+//
+// Returns:
+// - externalfunctions.FunctionAdapter: the adapter of the function
+// - []any: the outputs of the function
+// - error: an error if the function does not exist or its inputs are invalid
+func callFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs) (externalfunctions.FunctionAdapter, []any, error) {
+	adapter, err := lookupFunction(req.Name)
+	if err != nil {
+		return externalfunctions.FunctionAdapter{}, nil, err
+	}
 
-		case "role":
-			return externalfunctions.AppendMessageHistoryRole(inputValue.(string)), nil
+	results, err := adapter.Call(ctx, req.Inputs)
+	if err != nil {
+		return externalfunctions.FunctionAdapter{}, nil, err
+	}
+	return adapter, results, nil
+}
 
-		default:
-			return nil, fmt.Errorf("unsupported input for function %v: '%s'", functionName, inputName)
-		}
+// lookupFunction returns the typed adapter of an available function
+//
+// Parameters:
+// - name: the name of the function
+//
+// Returns:
+// - externalfunctions.FunctionAdapter: the adapter of the function
+// - error: a NotFound error if the function does not exist
+func lookupFunction(name string) (externalfunctions.FunctionAdapter, error) {
+	// get function definition from available functions
+	functionDefinition, ok := internalstates.AvailableFunctions[name]
+	if !ok {
+		return externalfunctions.FunctionAdapter{}, externalfunctions.NewNotFoundError("", "function with name %s not found", name).WithFunction(name)
 	}
 
-	return nil, fmt.Errorf("unsupported function: '%s'", functionName)
+	// get the adapter of the function
+	adapter, exists := externalfunctions.FunctionAdapters[functionDefinition.Name]
+	if !exists {
+		return externalfunctions.FunctionAdapter{}, externalfunctions.NewInternalError(nil, "function %s not found in externalfunctions package", functionDefinition.Name).WithFunction(name)
+	}
+	return adapter, nil
 }
@@ -0,0 +1,284 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// health services of the dependencies of flowkit
+const (
+	llmHealthService     = "aali-llm"
+	qdrantHealthService  = "qdrant"
+	graphDbHealthService = "aali-graphdb"
+)
+
+// defaultHealthProbeInterval is the default interval between two probes of the dependencies
+const defaultHealthProbeInterval = 10 * time.Second
+
+// defaultHealthProbeTimeout is the timeout of a dependency probe
+const defaultHealthProbeTimeout = 5 * time.Second
+
+// dependencyProbe checks that a dependency of flowkit can be reached
+type dependencyProbe struct {
+	service string
+	address string
+}
+
+// healthChecker probes the dependencies of flowkit and publishes their status on the grpc.health.v1 service
+// Every dependency has its own service, the server ("" and the ExternalFunctions service)
+// is serving when all configured dependencies are reachable.
+type healthChecker struct {
+	server  *health.Server
+	probes  []dependencyProbe
+	timeout time.Duration
+	dial    func(ctx context.Context, address string) error
+
+	mutex    sync.RWMutex
+	failures map[string]error
+}
+
+// newHealthChecker creates the health checker, all services are not serving until the first probe
+//
+// Parameters:
+// - probes: the dependencies to probe
+//
+// Returns:
+// - *healthChecker: the health checker
+func newHealthChecker(probes []dependencyProbe) *healthChecker {
+	checker := &healthChecker{
+		server:   health.NewServer(),
+		probes:   probes,
+		timeout:  defaultHealthProbeTimeout,
+		dial:     dialAddress,
+		failures: map[string]error{},
+	}
+	for _, service := range checker.services() {
+		checker.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	for _, probe := range probes {
+		checker.failures[probe.service] = errors.New("not probed yet")
+	}
+	return checker
+}
+
+// flowkitServices returns the services of flowkit, served while all the dependencies are healthy
+// The empty name is the overall health of the server.
+//
+// Returns:
+// - []string: the names of the services
+func flowkitServices() []string {
+	return []string{"", aaliflowkitgrpc.ExternalFunctions_ServiceDesc.ServiceName, jobsServiceName, pipelinesServiceName, streamsServiceName}
+}
+
+// services returns the health services published by the checker
+//
+// Returns:
+// - []string: the names of the services
+func (checker *healthChecker) services() []string {
+	services := flowkitServices()
+	for _, probe := range checker.probes {
+		services = append(services, probe.service)
+	}
+	return services
+}
+
+// probe checks every dependency once and updates the status of the services
+//
+// Parameters:
+// - ctx: the context of the probes
+func (checker *healthChecker) probe(ctx context.Context) {
+	failures := make([]error, len(checker.probes))
+	var wg sync.WaitGroup
+	for i, probe := range checker.probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, checker.timeout)
+			defer cancel()
+			failures[i] = checker.dial(probeCtx, probe.address)
+		}()
+	}
+	wg.Wait()
+
+	checker.mutex.Lock()
+	defer checker.mutex.Unlock()
+	serving := healthpb.HealthCheckResponse_SERVING
+	for i, probe := range checker.probes {
+		previous, wasFailing := checker.failures[probe.service]
+		status := healthpb.HealthCheckResponse_SERVING
+		if failures[i] != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			serving = healthpb.HealthCheckResponse_NOT_SERVING
+			checker.failures[probe.service] = failures[i]
+			if !wasFailing || previous.Error() != failures[i].Error() {
+				logging.Log.Warnf(&logging.ContextMap{}, "health probe of %s at %s failed: %v", probe.service, probe.address, failures[i])
+			}
+		} else {
+			delete(checker.failures, probe.service)
+			if wasFailing {
+				logging.Log.Infof(&logging.ContextMap{}, "health probe of %s at %s succeeded", probe.service, probe.address)
+			}
+		}
+		checker.server.SetServingStatus(probe.service, status)
+	}
+	for _, service := range flowkitServices() {
+		checker.server.SetServingStatus(service, serving)
+	}
+}
+
+// run probes the dependencies at the given interval until the context is done
+//
+// Parameters:
+// - ctx: the context stopping the probes
+// - interval: the interval between two probes
+func (checker *healthChecker) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		checker.probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// unhealthy returns the services of the dependencies that cannot be reached
+//
+// Returns:
+// - []string: the sorted names of the failing services
+func (checker *healthChecker) unhealthy() []string {
+	checker.mutex.RLock()
+	defer checker.mutex.RUnlock()
+	services := []string{}
+	for service := range checker.failures {
+		services = append(services, service)
+	}
+	slices.Sort(services)
+	return services
+}
+
+// dependencyProbes returns the probes of the dependencies set in the configuration
+// Dependencies without endpoint are not probed.
+//
+// Returns:
+// - []dependencyProbe: the probes
+// - error: an error if an endpoint is invalid
+func dependencyProbes() ([]dependencyProbe, error) {
+	endpoints := []dependencyProbe{
+		{service: llmHealthService, address: config.GlobalConfig.LLM_HANDLER_ENDPOINT},
+		{service: graphDbHealthService, address: config.GlobalConfig.GRAPHDB_ADDRESS},
+	}
+	if config.GlobalConfig.QDRANT_HOST != "" {
+		endpoints = append(endpoints, dependencyProbe{
+			service: qdrantHealthService,
+			address: net.JoinHostPort(config.GlobalConfig.QDRANT_HOST, strconv.Itoa(config.GlobalConfig.QDRANT_PORT)),
+		})
+	}
+
+	probes := []dependencyProbe{}
+	for _, endpoint := range endpoints {
+		if endpoint.address == "" {
+			continue
+		}
+		address, err := probeAddress(endpoint.address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint of %s: %v", endpoint.service, err)
+		}
+		probes = append(probes, dependencyProbe{service: endpoint.service, address: address})
+	}
+	return probes, nil
+}
+
+// probeAddress returns the TCP address of an endpoint
+// The endpoint is either a URL, such as "ws://aali-llm:9003", or a "host:port" address.
+// URLs without port use the default port of their scheme.
+//
+// Parameters:
+// - endpoint: the endpoint
+//
+// Returns:
+// - string: the "host:port" address
+// - error: an error if the endpoint is invalid
+func probeAddress(endpoint string) (string, error) {
+	if !strings.Contains(endpoint, "://") {
+		_, _, err := net.SplitHostPort(endpoint)
+		if err != nil {
+			return "", err
+		}
+		return endpoint, nil
+	}
+
+	endpointUrl, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if endpointUrl.Hostname() == "" {
+		return "", fmt.Errorf("endpoint %s has no host", endpoint)
+	}
+	port := endpointUrl.Port()
+	if port == "" {
+		switch endpointUrl.Scheme {
+		case "http", "ws":
+			port = "80"
+		case "https", "wss":
+			port = "443"
+		default:
+			return "", fmt.Errorf("endpoint %s has no port", endpoint)
+		}
+	}
+	return net.JoinHostPort(endpointUrl.Hostname(), port), nil
+}
+
+// dialAddress checks that a TCP connection can be opened to an address
+//
+// Parameters:
+// - ctx: the context of the probe
+// - address: the "host:port" address
+//
+// Returns:
+// - error: an error if the connection fails
+func dialAddress(ctx context.Context, address string) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
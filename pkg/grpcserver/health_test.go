@@ -0,0 +1,102 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestProbeAddress(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{endpoint: "ws://aali-llm:9003", want: "aali-llm:9003"},
+		{endpoint: "wss://aali-llm", want: "aali-llm:443"},
+		{endpoint: "http://aali-graphdb:8080", want: "aali-graphdb:8080"},
+		{endpoint: "aali-graphdb:8080", want: "aali-graphdb:8080"},
+		{endpoint: "aali-graphdb", wantErr: true},
+		{endpoint: "grpc://qdrant", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := probeAddress(tt.endpoint)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("probeAddress(%q) = %q, %v, want %q", tt.endpoint, got, err, tt.want)
+		}
+	}
+}
+
+func TestHealthCheckerProbe(t *testing.T) {
+	checker := newHealthChecker([]dependencyProbe{
+		{service: llmHealthService, address: "aali-llm:9003"},
+		{service: qdrantHealthService, address: "qdrant:6334"},
+	})
+	reachable := map[string]bool{"aali-llm:9003": true}
+	checker.dial = func(ctx context.Context, address string) error {
+		if !reachable[address] {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	s := &server{health: checker}
+
+	check := func(service string, want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		response, err := checker.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check(%q) error = %v", service, err)
+		}
+		if response.Status != want {
+			t.Errorf("Check(%q) = %v, want %v", service, response.Status, want)
+		}
+	}
+
+	// not serving until the first probe
+	check("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	checker.probe(context.Background())
+	check(llmHealthService, healthpb.HealthCheckResponse_SERVING)
+	check(qdrantHealthService, healthpb.HealthCheckResponse_NOT_SERVING)
+	check("", healthpb.HealthCheckResponse_NOT_SERVING)
+	check(aaliflowkitgrpc.ExternalFunctions_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	response, _ := s.HealthCheck(context.Background(), &aaliflowkitgrpc.HealthRequest{})
+	if response.Status != "NOT_SERVING: qdrant" {
+		t.Errorf("HealthCheck() = %q", response.Status)
+	}
+
+	reachable["qdrant:6334"] = true
+	checker.probe(context.Background())
+	check(qdrantHealthService, healthpb.HealthCheckResponse_SERVING)
+	check("", healthpb.HealthCheckResponse_SERVING)
+	response, _ = s.HealthCheck(context.Background(), &aaliflowkitgrpc.HealthRequest{})
+	if response.Status != "OK" {
+		t.Errorf("HealthCheck() = %q", response.Status)
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/jobs"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// jobsServiceName is the name of the gRPC service of the jobs
+const jobsServiceName = "aaliflowkitgrpc.Jobs"
+
+// clientIdentityOwnerPrefix prefixes the identity of a client owning jobs without an API key
+const clientIdentityOwnerPrefix = "client-certificate:"
+
+// defaultJobRetention is the default time the ended jobs are kept
+const defaultJobRetention = 24 * time.Hour
+
+// Request metadata keys of the ListJobs filters
+// Statuses and functions can be given as several values or as a comma separated list.
+const (
+	filterStatusKey   = "x-filter-status"
+	filterFunctionKey = "x-filter-function"
+)
+
+// jobPageTimeFormat formats the submission time in the page tokens of ListJobs
+// The width is fixed so that the tokens sort like the jobs.
+const jobPageTimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+// jobsServer is the server of the aaliflowkitgrpc.Jobs service
+// The jobs are sent as google.protobuf.Struct messages with the fields of jobs.Job.
+type jobsServer interface {
+	SubmitFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs) (*structpb.Struct, error)
+	GetJob(ctx context.Context, req *wrapperspb.StringValue) (*structpb.Struct, error)
+	CancelJob(ctx context.Context, req *wrapperspb.StringValue) (*structpb.Struct, error)
+	ListJobs(ctx context.Context, req *emptypb.Empty) (*structpb.ListValue, error)
+}
+
+// jobsServiceDesc is the description of the aaliflowkitgrpc.Jobs service
+var jobsServiceDesc = grpc.ServiceDesc{
+	ServiceName: jobsServiceName,
+	HandlerType: (*jobsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod(jobsServiceName, "SubmitFunction", jobsServer.SubmitFunction),
+		unaryMethod(jobsServiceName, "GetJob", jobsServer.GetJob),
+		unaryMethod(jobsServiceName, "CancelJob", jobsServer.CancelJob),
+		unaryMethod(jobsServiceName, "ListJobs", jobsServer.ListJobs),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "jobs",
+}
+
+// newJobManager creates the job manager from the configuration
+// The jobs are kept in the BoltDB file set in FLOWKIT_JOB_STORE_FILE, or in memory.
+// FLOWKIT_MAX_RUNNING_JOBS bounds the number of jobs running at the same time.
+//
+// Returns:
+// - *jobs.Manager: the job manager
+// - error: an error if the configuration is invalid or the store cannot be opened
+func newJobManager() (*jobs.Manager, error) {
+	maxRunning := 0
+	if value := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_MAX_RUNNING_JOBS"]; value != "" {
+		var err error
+		maxRunning, err = strconv.Atoi(value)
+		if err != nil || maxRunning < 0 {
+			return nil, fmt.Errorf("invalid FLOWKIT_MAX_RUNNING_JOBS %q", value)
+		}
+	}
+
+	store := jobs.NewMemoryStore()
+	if path := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_JOB_STORE_FILE"]; path != "" {
+		var err error
+		store, err = jobs.NewBoltStore(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	manager, err := jobs.NewManager(store, maxRunning)
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+	return manager, nil
+}
+
+// SubmitFunction runs a function in the background as a job
+// The function and its inputs are checked before the job is created; the job keeps running
+// when the client disconnects, its status and outputs are read with GetJob.
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the request to run a function
+//
+// Returns:
+// - *structpb.Struct: the queued job
+// - error: an error if the function does not exist, streams its outputs or its inputs are invalid
+func (s *server) SubmitFunction(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs) (*structpb.Struct, error) {
+	adapter, err := lookupFunction(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, output := range adapter.Outputs {
+		if output.IsStream() {
+			return nil, status.Errorf(codes.FailedPrecondition, "function %s streams its outputs and cannot run as a job", req.Name)
+		}
+	}
+	_, err = adapter.Bind(req.Inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobs.Submit(ctx, req.Name, jobOwner(ctx), func(ctx context.Context, report func(externalfunctions.Progress)) ([]jobs.Output, error) {
+		ctx = externalfunctions.ContextWithProgressReporter(ctx, externalfunctions.NewProgressReporter(report))
+		functionOutputs, err := runFunction(ctx, "SubmitFunction", req)
+		if err != nil {
+			return nil, err
+		}
+		return jobOutputs(functionOutputs.Outputs), nil
+	})
+	if err != nil {
+		return nil, jobError(err, "")
+	}
+	return jobStruct(job)
+}
+
+// GetJob returns a job submitted with the API key of the request
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the ID of the job
+//
+// Returns:
+// - *structpb.Struct: the job
+// - error: a NotFound error if the job does not exist
+func (s *server) GetJob(ctx context.Context, req *wrapperspb.StringValue) (*structpb.Struct, error) {
+	job, err := s.ownedJob(ctx, req.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return jobStruct(job)
+}
+
+// CancelJob cancels a queued or running job submitted with the API key of the request
+// Cancelling an ended job returns it unchanged.
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the ID of the job
+//
+// Returns:
+// - *structpb.Struct: the job after the cancellation
+// - error: a NotFound error if the job does not exist
+func (s *server) CancelJob(ctx context.Context, req *wrapperspb.StringValue) (*structpb.Struct, error) {
+	_, err := s.ownedJob(ctx, req.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.jobs.Cancel(req.GetValue())
+	if err != nil {
+		return nil, jobError(err, req.GetValue())
+	}
+	return jobStruct(job)
+}
+
+// ListJobs lists the jobs submitted with the API key of the request, the most recent first
+// The jobs can be filtered by status and function with the "x-filter-status" and "x-filter-function"
+// metadata and paginated like ListFunctions, with "x-page-size", "x-page-token" and the "x-next-page-token" header.
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: an empty request
+//
+// Returns:
+// - *structpb.ListValue: the jobs of the page
+// - error: an error if the filter is invalid or the jobs cannot be read
+func (s *server) ListJobs(ctx context.Context, req *emptypb.Empty) (*structpb.ListValue, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	statuses := listValues(md.Get(filterStatusKey))
+	functions := listValues(md.Get(filterFunctionKey))
+	pageSize, pageToken, err := parsePage(md)
+	if err != nil {
+		return nil, err
+	}
+
+	allJobs, err := s.jobs.List()
+	if err != nil {
+		return nil, jobError(err, "")
+	}
+	owner := jobOwner(ctx)
+	page := []jobs.Job{}
+	nextPageToken := ""
+	for _, job := range allJobs {
+		if job.Owner != owner || (pageToken != "" && jobPageKey(job) > pageToken) {
+			continue
+		}
+		if len(statuses) > 0 && !slices.Contains(statuses, string(job.Status)) {
+			continue
+		}
+		if len(functions) > 0 && !slices.Contains(functions, job.Function) {
+			continue
+		}
+		if pageSize > 0 && len(page) == pageSize {
+			nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(jobPageKey(job)))
+			break
+		}
+		page = append(page, job)
+	}
+
+	if nextPageToken != "" {
+		err := grpc.SetHeader(ctx, metadata.Pairs(nextPageTokenKey, nextPageToken))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error sending response header: %v", err)
+		}
+	}
+	list := &structpb.ListValue{Values: make([]*structpb.Value, len(page))}
+	for i, job := range page {
+		encoded, err := jobStruct(job)
+		if err != nil {
+			return nil, err
+		}
+		list.Values[i] = structpb.NewStructValue(encoded)
+	}
+	return list, nil
+}
+
+// ownedJob returns a job if it was submitted with the API key of the request
+// The jobs of other keys are reported as not found, so that their IDs are not disclosed.
+//
+// Parameters:
+// - ctx: the context of the request
+// - id: the ID of the job
+//
+// Returns:
+// - jobs.Job: the job
+// - error: a NotFound error if the job does not exist or belongs to another key
+func (s *server) ownedJob(ctx context.Context, id string) (jobs.Job, error) {
+	job, err := s.jobs.Get(id)
+	if err == nil && job.Owner != jobOwner(ctx) {
+		err = jobs.ErrNotFound
+	}
+	if err != nil {
+		return jobs.Job{}, jobError(err, id)
+	}
+	return job, nil
+}
+
+// jobOwner returns the owner of the jobs and resumable streams of a request
+// The owner is the name of the API key, or the verified identity of the client if the request has no key,
+// prefixed so that a client certificate can never match the name of a key.
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - string: the owner, empty without authentication
+func jobOwner(ctx context.Context) string {
+	if key := apiKeyFromContext(ctx); key != nil {
+		return key.Name
+	}
+	if identity, ok := externalfunctions.ClientIdentityFromContext(ctx); ok {
+		return clientIdentityOwnerPrefix + identity.String()
+	}
+	return ""
+}
+
+// jobPageKey returns the position of a job in the pages of ListJobs
+// The keys decrease with the order of the jobs, from the most recently submitted.
+//
+// Parameters:
+// - job: the job
+//
+// Returns:
+// - string: the key of the job
+func jobPageKey(job jobs.Job) string {
+	return job.Submitted.UTC().Format(jobPageTimeFormat) + "/" + job.ID
+}
+
+// jobOutputs converts the outputs of a function to the outputs of a job
+//
+// Parameters:
+// - outputs: the encoded outputs of the function
+//
+// Returns:
+// - []jobs.Output: the outputs of the job
+func jobOutputs(outputs []*aaliflowkitgrpc.FunctionOutput) []jobs.Output {
+	converted := make([]jobs.Output, len(outputs))
+	for i, output := range outputs {
+		converted[i] = jobs.Output{Name: output.Name, GoType: output.GoType, Value: output.Value}
+	}
+	return converted
+}
+
+// jobError converts an error of the job manager to a gRPC status error
+//
+// Parameters:
+// - err: the error
+// - id: the ID of the job, if any
+//
+// Returns:
+// - error: the gRPC status error
+func jobError(err error, id string) error {
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return status.Errorf(codes.NotFound, "job %s not found", id)
+	case errors.Is(err, jobs.ErrClosed):
+		return status.Errorf(codes.Unavailable, "the server is shutting down")
+	default:
+		return status.Errorf(codes.Internal, "error accessing the job store: %v", err)
+	}
+}
+
+// jobStruct encodes a job as a google.protobuf.Struct message
+//
+// Parameters:
+// - job: the job
+//
+// Returns:
+// - *structpb.Struct: the encoded job
+// - error: an error if the job cannot be encoded
+func jobStruct(job jobs.Job) (*structpb.Struct, error) {
+	message, err := encodeStruct(job)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encoding job %s: %v", job.ID, err)
+	}
+	return message, nil
+}
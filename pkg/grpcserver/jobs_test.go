@@ -0,0 +1,167 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/jobs"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testHeaderStream records the header set by a unary handler
+type testHeaderStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (stream *testHeaderStream) Method() string {
+	return "/" + jobsServiceName + "/ListJobs"
+}
+
+func (stream *testHeaderStream) SetHeader(md metadata.MD) error {
+	stream.header = metadata.Join(stream.header, md)
+	return nil
+}
+
+func TestJobsService(t *testing.T) {
+	previousFunctions := internalstates.AvailableFunctions
+	internalstates.AvailableFunctions = testFunctions
+	defer func() { internalstates.AvailableFunctions = previousFunctions }()
+
+	manager, err := jobs.NewManager(jobs.NewMemoryStore(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Close(time.Second)
+	s := &server{jobs: manager}
+	grpc.NewServer().RegisterService(&jobsServiceDesc, s)
+
+	ingestion := context.WithValue(context.Background(), apiKeyContextKey{}, &apiKey{Name: "ingestion"})
+	other := context.WithValue(context.Background(), apiKeyContextKey{}, &apiKey{Name: "other"})
+
+	_, err = s.SubmitFunction(ingestion, &aaliflowkitgrpc.FunctionInputs{Name: "DoesNotExist"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("SubmitFunction(DoesNotExist) code = %v, want NotFound", status.Code(err))
+	}
+
+	ids := []string{}
+	for _, value := range []string{"first", "second", "third"} {
+		submitted, err := s.SubmitFunction(ingestion, &aaliflowkitgrpc.FunctionInputs{
+			Name:   "AssignStringToString",
+			Inputs: []*aaliflowkitgrpc.FunctionInput{{Name: "inputString", GoType: "string", Value: value}},
+		})
+		if err != nil {
+			t.Fatalf("SubmitFunction() failed: %v", err)
+		}
+		ids = append(ids, submitted.Fields["id"].GetStringValue())
+		time.Sleep(time.Millisecond)
+	}
+
+	// the job is read once it succeeded
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := s.GetJob(ingestion, wrapperspb.String(ids[0]))
+		if err != nil {
+			t.Fatalf("GetJob() failed: %v", err)
+		}
+		if job.Fields["status"].GetStringValue() == string(jobs.StatusSucceeded) {
+			outputs := job.Fields["outputs"].GetListValue().GetValues()
+			if len(outputs) != 1 || outputs[0].GetStructValue().Fields["value"].GetStringValue() != "first" {
+				t.Errorf("job outputs = %v", outputs)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job did not succeed: %v", job)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// the jobs of other keys are not disclosed
+	_, err = s.GetJob(other, wrapperspb.String(ids[0]))
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetJob() with another key code = %v, want NotFound", status.Code(err))
+	}
+	_, err = s.CancelJob(other, wrapperspb.String(ids[0]))
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("CancelJob() with another key code = %v, want NotFound", status.Code(err))
+	}
+	list, err := s.ListJobs(other, &emptypb.Empty{})
+	if err != nil || len(list.Values) != 0 {
+		t.Errorf("ListJobs() with another key = %v, %v, want no job", list, err)
+	}
+
+	// the jobs are listed from the most recent, two per page
+	stream := &testHeaderStream{}
+	ctx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ingestion, metadata.Pairs(pageSizeKey, "2")), stream)
+	list, err = s.ListJobs(ctx, &emptypb.Empty{})
+	if err != nil || len(list.Values) != 2 || list.Values[0].GetStructValue().Fields["id"].GetStringValue() != ids[2] {
+		t.Fatalf("ListJobs() first page = %v, %v", list, err)
+	}
+	token := stream.header.Get(nextPageTokenKey)
+	if len(token) != 1 {
+		t.Fatalf("ListJobs() next page token = %v", token)
+	}
+	ctx = metadata.NewIncomingContext(ingestion, metadata.Pairs(pageSizeKey, "2", pageTokenKey, token[0]))
+	list, err = s.ListJobs(ctx, &emptypb.Empty{})
+	if err != nil || len(list.Values) != 1 || list.Values[0].GetStructValue().Fields["id"].GetStringValue() != ids[0] {
+		t.Errorf("ListJobs() second page = %v, %v", list, err)
+	}
+
+	ctx = metadata.NewIncomingContext(ingestion, metadata.Pairs(filterStatusKey, "failed,cancelled"))
+	list, err = s.ListJobs(ctx, &emptypb.Empty{})
+	if err != nil || len(list.Values) != 0 {
+		t.Errorf("ListJobs() of failed jobs = %v, %v, want no job", list, err)
+	}
+}
+
+func TestJobOwner(t *testing.T) {
+	certificate := externalfunctions.ContextWithClientIdentity(context.Background(), externalfunctions.ClientIdentity{CommonName: "ingestion"})
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "No authentication", ctx: context.Background(), want: ""},
+		{name: "API key", ctx: context.WithValue(certificate, apiKeyContextKey{}, &apiKey{Name: "ingestion"}), want: "ingestion"},
+		{name: "Client certificate", ctx: certificate, want: clientIdentityOwnerPrefix + "ingestion"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jobOwner(tt.ctx); got != tt.want {
+				t.Errorf("jobOwner() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Request metadata keys of the ListFunctions filters
+// Categories and tags can be given as several values or as a comma separated list.
+const (
+	filterCategoryKey   = "x-filter-category"
+	filterNamePrefixKey = "x-filter-name-prefix"
+	filterSearchKey     = "x-filter-search"
+	filterTagsKey       = "x-filter-tags"
+	pageSizeKey         = "x-page-size"
+	pageTokenKey        = "x-page-token"
+	ifNoneMatchKey      = "if-none-match"
+)
+
+// Response header keys of ListFunctions
+const (
+	etagKey          = "etag"
+	nextPageTokenKey = "x-next-page-token"
+	notModifiedKey   = "x-not-modified"
+)
+
+// functionFilter selects the functions returned by ListFunctions
+type functionFilter struct {
+	Categories []string `json:"categories,omitempty"`
+	NamePrefix string   `json:"namePrefix,omitempty"`
+	Search     []string `json:"search,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	PageSize   int      `json:"pageSize,omitempty"`
+	PageToken  string   `json:"pageToken,omitempty"`
+}
+
+// parseFunctionFilter reads the ListFunctions filter from the request metadata
+// Without filter metadata, all functions are returned in a single page.
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - functionFilter: the filter
+// - error: an InvalidArgument error if the page size or token is invalid
+func parseFunctionFilter(ctx context.Context) (functionFilter, error) {
+	filter := functionFilter{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return filter, nil
+	}
+
+	filter.Categories = listValues(md.Get(filterCategoryKey))
+	filter.Tags = listValues(md.Get(filterTagsKey))
+	if values := md.Get(filterNamePrefixKey); len(values) > 0 {
+		filter.NamePrefix = values[0]
+	}
+	if values := md.Get(filterSearchKey); len(values) > 0 {
+		filter.Search = strings.Fields(strings.ToLower(values[0]))
+	}
+
+	var err error
+	filter.PageSize, filter.PageToken, err = parsePage(md)
+	return filter, err
+}
+
+// parsePage reads the page size and the decoded page token from the request metadata
+//
+// Parameters:
+// - md: the metadata of the request
+//
+// Returns:
+// - int: the page size, 0 for a single page
+// - string: the decoded page token, empty for the first page
+// - error: an InvalidArgument error if the page size or token is invalid
+func parsePage(md metadata.MD) (int, string, error) {
+	pageSize := 0
+	if values := md.Get(pageSizeKey); len(values) > 0 {
+		size, err := strconv.Atoi(values[0])
+		if err != nil || size < 0 {
+			return 0, "", status.Errorf(codes.InvalidArgument, "invalid page size %q", values[0])
+		}
+		pageSize = size
+	}
+	pageToken := ""
+	if values := md.Get(pageTokenKey); len(values) > 0 && values[0] != "" {
+		token, err := base64.RawURLEncoding.DecodeString(values[0])
+		if err != nil {
+			return 0, "", status.Errorf(codes.InvalidArgument, "invalid page token %q", values[0])
+		}
+		pageToken = string(token)
+	}
+	return pageSize, pageToken, nil
+}
+
+// matches checks whether a function is selected by the filter, regardless of the pagination
+// The search words must all appear in the name, display name or description of the function,
+// and the function must have all the tags of the filter.
+//
+// Parameters:
+// - name: the name of the function
+// - definition: the definition of the function
+//
+// Returns:
+// - bool: true if the function is selected
+func (filter functionFilter) matches(name string, definition *aaliflowkitgrpc.FunctionDefinition) bool {
+	if len(filter.Categories) > 0 && !slices.Contains(filter.Categories, definition.Category) {
+		return false
+	}
+	if !strings.HasPrefix(name, filter.NamePrefix) {
+		return false
+	}
+
+	text := strings.ToLower(strings.Join([]string{name, definition.DisplayName, definition.Description}, "\n"))
+	for _, word := range filter.Search {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+
+	functionTags := externalfunctions.FunctionsMetadata[name].Tags
+	for _, tag := range filter.Tags {
+		if !slices.Contains(functionTags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterFunctions selects a page of functions
+// The functions are sorted by name, the page token is the name of the first function of the page.
+//
+// Parameters:
+// - functions: all available functions
+// - filter: the filter
+//
+// Returns:
+// - map[string]*aaliflowkitgrpc.FunctionDefinition: the functions of the page
+// - string: the token of the next page, empty for the last page
+func filterFunctions(functions map[string]*aaliflowkitgrpc.FunctionDefinition, filter functionFilter) (map[string]*aaliflowkitgrpc.FunctionDefinition, string) {
+	names := []string{}
+	for name, definition := range functions {
+		if name >= filter.PageToken && filter.matches(name, definition) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	nextPageToken := ""
+	if filter.PageSize > 0 && len(names) > filter.PageSize {
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(names[filter.PageSize]))
+		names = names[:filter.PageSize]
+	}
+
+	page := make(map[string]*aaliflowkitgrpc.FunctionDefinition, len(names))
+	for _, name := range names {
+		page[name] = functions[name]
+	}
+	return page, nextPageToken
+}
+
+// catalogETag computes the entity tag of a ListFunctions response
+// The tag changes whenever a listed function, its metadata, the server version or the filter changes.
+//
+// Parameters:
+// - functions: the functions of the response
+// - filter: the filter of the request
+// - version: the version of the server
+//
+// Returns:
+// - string: the quoted entity tag
+func catalogETag(functions map[string]*aaliflowkitgrpc.FunctionDefinition, filter functionFilter, version string) string {
+	hash := sha256.New()
+	// maps are encoded with sorted keys, so that the tag is stable
+	encoder := json.NewEncoder(hash)
+	encoder.Encode(version)
+	encoder.Encode(filter)
+	encoder.Encode(functions)
+	for _, name := range slices.Sorted(maps.Keys(functions)) {
+		encoder.Encode(externalfunctions.FunctionsMetadata[name])
+	}
+	return `"` + hex.EncodeToString(hash.Sum(nil))[:32] + `"`
+}
+
+// listValues splits metadata values that may be comma separated lists
+//
+// Parameters:
+// - values: the metadata values
+//
+// Returns:
+// - []string: the non-empty values
+func listValues(values []string) []string {
+	list := []string{}
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+	return list
+}
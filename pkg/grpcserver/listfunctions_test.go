@@ -0,0 +1,106 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var testFunctions = map[string]*aaliflowkitgrpc.FunctionDefinition{
+	"QdrantCreateCollection": {Name: "QdrantCreateCollection", Category: "qdrant", Description: "creates a collection in Qdrant"},
+	"QdrantInsertData":       {Name: "QdrantInsertData", Category: "qdrant", Description: "inserts data points into a collection"},
+	"GeneralGraphDbQuery":    {Name: "GeneralGraphDbQuery", Category: "knowledge_db", Description: "runs a query on the graph database"},
+	"AssignStringToString":   {Name: "AssignStringToString", Category: "generic", Description: "assigns a string"},
+}
+
+func TestFilterFunctions(t *testing.T) {
+	tests := []struct {
+		name   string
+		md     metadata.MD
+		want   []string
+		paging bool
+	}{
+		{name: "no filter", md: metadata.MD{}, want: []string{"AssignStringToString", "GeneralGraphDbQuery", "QdrantCreateCollection", "QdrantInsertData"}},
+		{name: "categories", md: metadata.Pairs(filterCategoryKey, "generic, knowledge_db"), want: []string{"AssignStringToString", "GeneralGraphDbQuery"}},
+		{name: "name prefix", md: metadata.Pairs(filterNamePrefixKey, "Qdrant"), want: []string{"QdrantCreateCollection", "QdrantInsertData"}},
+		{name: "search", md: metadata.Pairs(filterSearchKey, "Collection INSERTS"), want: []string{"QdrantInsertData"}},
+		{name: "page", md: metadata.Pairs(pageSizeKey, "3"), want: []string{"AssignStringToString", "GeneralGraphDbQuery", "QdrantCreateCollection"}, paging: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := parseFunctionFilter(metadata.NewIncomingContext(context.Background(), tt.md))
+			if err != nil {
+				t.Fatalf("parseFunctionFilter() error = %v", err)
+			}
+			page, nextPageToken := filterFunctions(testFunctions, filter)
+			if got := slices.Sorted(maps.Keys(page)); !slices.Equal(got, tt.want) {
+				t.Errorf("filterFunctions() = %v, want %v", got, tt.want)
+			}
+			if (nextPageToken != "") != tt.paging {
+				t.Errorf("next page token = %q", nextPageToken)
+			}
+		})
+	}
+}
+
+func TestFilterFunctionsNextPage(t *testing.T) {
+	filter := functionFilter{PageSize: 3}
+	_, nextPageToken := filterFunctions(testFunctions, filter)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pageSizeKey, "3", pageTokenKey, nextPageToken))
+	filter, err := parseFunctionFilter(ctx)
+	if err != nil {
+		t.Fatalf("parseFunctionFilter() error = %v", err)
+	}
+	page, nextPageToken := filterFunctions(testFunctions, filter)
+	if len(page) != 1 || page["QdrantInsertData"] == nil || nextPageToken != "" {
+		t.Errorf("second page = %v, next page token %q", page, nextPageToken)
+	}
+}
+
+func TestCatalogETag(t *testing.T) {
+	etag := catalogETag(testFunctions, functionFilter{}, "1.0.0")
+	if etag != catalogETag(testFunctions, functionFilter{}, "1.0.0") {
+		t.Errorf("entity tag is not stable")
+	}
+	if etag == catalogETag(testFunctions, functionFilter{NamePrefix: "Qdrant"}, "1.0.0") {
+		t.Errorf("entity tag does not depend on the filter")
+	}
+	if etag == catalogETag(testFunctions, functionFilter{}, "1.0.1") {
+		t.Errorf("entity tag does not depend on the version")
+	}
+}
+
+func TestParseFunctionFilterInvalidPageSize(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pageSizeKey, "-1"))
+	if _, err := parseFunctionFilter(ctx); err == nil {
+		t.Errorf("parseFunctionFilter() accepted a negative page size")
+	}
+}
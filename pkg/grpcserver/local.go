@@ -0,0 +1,88 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RunLocal runs a function in-process exactly like RunFunction, for the command line
+//
+// Parameters:
+// - ctx: the context of the call, its incoming metadata is read like the metadata of a request
+// - req: the request to run a function
+//
+// Returns:
+// - aaliflowkitgrpc.FunctionOutputs: the outputs of the function
+// - error: an error if the function fails
+func RunLocal(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs) (*aaliflowkitgrpc.FunctionOutputs, error) {
+	return runFunction(ctx, "RunFunction", req)
+}
+
+// StreamLocal runs a function in-process exactly like StreamFunction, for the command line
+//
+// Parameters:
+// - ctx: the context of the call, its incoming metadata is read like the metadata of a request
+// - req: the request to stream a function
+// - send: receives the frames of the stream
+//
+// Returns:
+// - error: an error if the function fails or send returns an error
+func StreamLocal(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs, send func(*aaliflowkitgrpc.StreamOutput) error) error {
+	return (&server{}).StreamFunction(req, &localStream{ctx: ctx, send: send})
+}
+
+// CloseClients closes the clients shared by the functions, for the command line
+// The server closes them itself on shutdown.
+func CloseClients() {
+	closeClients()
+}
+
+// localStream is the server stream of StreamLocal
+type localStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(*aaliflowkitgrpc.StreamOutput) error
+}
+
+func (stream *localStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *localStream) Send(output *aaliflowkitgrpc.StreamOutput) error {
+	return stream.send(output)
+}
+
+func (stream *localStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (stream *localStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (stream *localStream) SetTrailer(metadata.MD) {}
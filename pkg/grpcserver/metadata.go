@@ -0,0 +1,87 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// functionMetadataRequestKey is the request metadata key asking ListFunctions for the function metadata
+	functionMetadataRequestKey = "x-function-metadata"
+	// functionMetadataHeaderKey is the response header carrying the function metadata
+	functionMetadataHeaderKey = "x-function-metadata-bin"
+)
+
+// requestsFunctionMetadata checks whether the client asked for the function metadata
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - bool: true if the request has the "x-function-metadata: true" metadata
+func requestsFunctionMetadata(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(functionMetadataRequestKey)
+	return len(values) > 0 && values[0] == "true"
+}
+
+// sendFunctionMetadata sends the metadata of the listed functions as JSON in the response header
+// The metadata is not part of the function definitions of aali-sharedtypes, the header lets
+// workflow builders show the descriptions, defaults and examples of the inputs and outputs.
+//
+// Parameters:
+// - ctx: the context of the request
+// - functions: the listed functions
+//
+// Returns:
+// - error: an error if the metadata cannot be sent
+func sendFunctionMetadata(ctx context.Context, functions map[string]*aaliflowkitgrpc.FunctionDefinition) error {
+	functionsMetadata := make(map[string]externalfunctions.FunctionMetadata, len(functions))
+	for name := range functions {
+		if functionMetadata, exists := externalfunctions.FunctionsMetadata[name]; exists {
+			functionsMetadata[name] = functionMetadata
+		}
+	}
+
+	encoded, err := json.Marshal(functionsMetadata)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error encoding function metadata: %v", err)
+	}
+	err = grpc.SetHeader(ctx, metadata.Pairs(functionMetadataHeaderKey, string(encoded)))
+	if err != nil {
+		return status.Errorf(codes.Internal, "error sending function metadata: %v", err)
+	}
+	return nil
+}
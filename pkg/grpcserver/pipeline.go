@@ -0,0 +1,124 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/ansys/aali-flowkit/pkg/jobs"
+	"github.com/ansys/aali-flowkit/pkg/pipeline"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// pipelinesServiceName is the name of the gRPC service running pipelines
+const pipelinesServiceName = "aaliflowkitgrpc.Pipelines"
+
+// pipelinesServer is the server of the aaliflowkitgrpc.Pipelines service
+// The request is a google.protobuf.Struct message with the fields of pipeline.Pipeline and the
+// response has the fields of pipelineResponse.
+type pipelinesServer interface {
+	RunPipeline(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error)
+}
+
+// pipelinesServiceDesc is the description of the aaliflowkitgrpc.Pipelines service
+var pipelinesServiceDesc = grpc.ServiceDesc{
+	ServiceName: pipelinesServiceName,
+	HandlerType: (*pipelinesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod(pipelinesServiceName, "RunPipeline", pipelinesServer.RunPipeline),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pipelines",
+}
+
+// pipelineResponse is the response of RunPipeline
+// The outputs are encoded like the outputs of RunFunction and named by their "step.output" reference.
+type pipelineResponse struct {
+	Outputs []jobs.Output         `json:"outputs"`
+	Steps   []pipeline.StepResult `json:"steps"`
+}
+
+// RunPipeline runs a DAG of function calls and returns the requested outputs
+// The outputs of the steps are passed to the downstream steps as native Go values. The call fails
+// only if the pipeline is invalid, a function is not allowed for the API key or the request is
+// cancelled; the failed and skipped steps are reported in the response.
+//
+// Parameters:
+// - ctx: the context of the request
+// - req: the pipeline
+//
+// Returns:
+// - *structpb.Struct: the outputs and the status and timing of every step
+// - error: an error if the pipeline cannot run
+func (s *server) RunPipeline(ctx context.Context, req *structpb.Struct) (res *structpb.Struct, err error) {
+	ctx, span := telemetry.Tracer().Start(telemetry.ExtractMetadata(ctx), "RunPipeline",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", pipelinesServiceName),
+			attribute.String("rpc.method", "RunPipeline"),
+		),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	var request pipeline.Pipeline
+	err = decodeStruct(req, &request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pipeline: %v", err)
+	}
+	plan, err := pipeline.Compile(request, lookupFunction)
+	if err != nil {
+		return nil, err
+	}
+	// the steps, and the steps of the macros they call, are authorized against the key of the request
+	ctx = pipeline.ContextWithAuthorizer(ctx, authorizeFunction)
+	err = plan.Authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plan.Run(ctx, "RunPipeline", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := pipelineResponse{Outputs: []jobs.Output{}, Steps: result.Steps}
+	for _, output := range result.Outputs {
+		value, err := output.Encode()
+		if err != nil {
+			return nil, err
+		}
+		response.Outputs = append(response.Outputs, jobs.Output{Name: output.Ref, GoType: output.GoType, Value: value})
+	}
+	res, err = encodeStruct(response)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encoding pipeline response: %v", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRunPipeline(t *testing.T) {
+	previousFunctions := internalstates.AvailableFunctions
+	internalstates.AvailableFunctions = testFunctions
+	defer func() { internalstates.AvailableFunctions = previousFunctions }()
+
+	request, err := structpb.NewStruct(map[string]any{
+		"steps": []any{
+			map[string]any{"id": "first", "function": "AssignStringToString", "inputs": map[string]any{"inputString": map[string]any{"value": "hello"}}},
+			map[string]any{"id": "second", "function": "AssignStringToString", "inputs": map[string]any{"inputString": map[string]any{"from": "first.outputString"}}},
+		},
+		"outputs": []any{"second.outputString"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &server{}
+	generic := context.WithValue(context.Background(), apiKeyContextKey{}, &apiKey{Name: "generic", Categories: []string{"generic"}})
+	qdrant := context.WithValue(context.Background(), apiKeyContextKey{}, &apiKey{Name: "qdrant", Categories: []string{"qdrant"}})
+
+	response, err := s.RunPipeline(generic, request)
+	if err != nil {
+		t.Fatalf("RunPipeline() failed: %v", err)
+	}
+	outputs := response.Fields["outputs"].GetListValue().GetValues()
+	if len(outputs) != 1 || outputs[0].GetStructValue().Fields["name"].GetStringValue() != "second.outputString" ||
+		outputs[0].GetStructValue().Fields["value"].GetStringValue() != "hello" {
+		t.Errorf("outputs = %v", outputs)
+	}
+	for _, step := range response.Fields["steps"].GetListValue().GetValues() {
+		if step.GetStructValue().Fields["status"].GetStringValue() != "succeeded" {
+			t.Errorf("step = %v", step)
+		}
+	}
+
+	// every function of the pipeline must be allowed for the API key
+	_, err = s.RunPipeline(qdrant, request)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RunPipeline() with another category code = %v, want PermissionDenied", status.Code(err))
+	}
+
+	// invalid pipelines are rejected before any step runs
+	request.Fields["outputs"] = structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("third.outputString")}})
+	_, err = s.RunPipeline(generic, request)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("RunPipeline() with an unknown output code = %v, want InvalidArgument", status.Code(err))
+	}
+	request.Fields["unknown"] = structpb.NewBoolValue(true)
+	_, err = s.RunPipeline(generic, request)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("RunPipeline() with an unknown field code = %v, want InvalidArgument", status.Code(err))
+	}
+}
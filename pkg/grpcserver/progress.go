@@ -0,0 +1,288 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// progressRequestKey is the request metadata key asking StreamFunction for progress frames
+const progressRequestKey = "x-progress"
+
+// requestsProgress checks whether the client follows the progress of the function
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - bool: true if the request has the "x-progress: true" metadata
+func requestsProgress(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(progressRequestKey)
+	return len(values) > 0 && values[0] == "true"
+}
+
+// progressUpdates creates the channel forwarding the progress of a function to the stream
+// The channel only keeps the latest progress, so a slow client does not block the function.
+//
+// Returns:
+// - <-chan externalfunctions.Progress: the channel of the progress updates
+// - func(externalfunctions.Progress): reports a progress update, to pass to the progress reporter
+func progressUpdates() (<-chan externalfunctions.Progress, func(externalfunctions.Progress)) {
+	updates := make(chan externalfunctions.Progress, 1)
+	report := func(progress externalfunctions.Progress) {
+		for {
+			select {
+			case updates <- progress:
+				return
+			default:
+			}
+			// drop the previous update that was not sent yet
+			select {
+			case <-updates:
+			default:
+			}
+		}
+	}
+	return updates, report
+}
+
+// progressValue encodes the value of a progress frame
+// With the legacy format, progress frames use the in-band marker encoding of the stream messages:
+// $&$progress$&$:$&$<progress as JSON>$&$. With the events format, they are progress events.
+//
+// Parameters:
+// - progress: the progress of the function
+// - format: the format of the stream messages
+//
+// Returns:
+// - string: the value of the frame
+// - error: an error if the progress cannot be encoded
+func progressValue(progress externalfunctions.Progress, format externalfunctions.StreamFormat) (string, error) {
+	if format == externalfunctions.StreamFormatEvents {
+		return externalfunctions.StreamEvent{Type: externalfunctions.StreamEventProgress, Progress: &progress}.Encode(), nil
+	}
+	encoded, err := json.Marshal(progress)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "error encoding progress: %v", err)
+	}
+	return fmt.Sprintf("$&$progress$&$:$&$%s$&$", encoded), nil
+}
+
+// callWithProgress calls a function in the background and sends its progress until it returns
+//
+// Parameters:
+// - ctx: the context of the request, carrying the progress reporter
+// - req: the request to run the function
+// - progress: the progress updates of the function
+// - frames: the frames of the stream
+//
+// Returns:
+// - externalfunctions.FunctionAdapter: the adapter of the function
+// - []any: the outputs of the function
+// - error: an error if the function fails or the progress cannot be sent
+func callWithProgress(ctx context.Context, req *aaliflowkitgrpc.FunctionInputs, progress <-chan externalfunctions.Progress, frames *streamFrames) (externalfunctions.FunctionAdapter, []any, error) {
+	type callResult struct {
+		adapter externalfunctions.FunctionAdapter
+		results []any
+		err     error
+	}
+	done := make(chan callResult, 1)
+	go func() {
+		defer func() {
+			r := recover()
+			if r != nil {
+				done <- callResult{err: recoveredError(ctx, "StreamFunction", req.Name, r)}
+			}
+		}()
+		adapter, results, err := callFunction(ctx, req)
+		done <- callResult{adapter: adapter, results: results, err: err}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return externalfunctions.FunctionAdapter{}, nil, status.FromContextError(ctx.Err()).Err()
+		case update := <-progress:
+			err := frames.progress(update)
+			if err != nil {
+				return externalfunctions.FunctionAdapter{}, nil, err
+			}
+		case result := <-done:
+			return result.adapter, result.results, result.err
+		}
+	}
+}
+
+// frameSender sends the frames of StreamFunction, to the client or to the buffer of a resumable stream
+type frameSender interface {
+	Send(output *aaliflowkitgrpc.StreamOutput) error
+}
+
+// streamFrames numbers and sends the frames of StreamFunction
+// Each message is held back until the next one arrives, so that the last one is sent with IsLast;
+// progress frames are sent between messages.
+type streamFrames struct {
+	stream frameSender
+	// format is the format of the messages, it defaults to the legacy format
+	format  externalfunctions.StreamFormat
+	counter int32
+	// pending is the last message, not sent yet
+	pending *aaliflowkitgrpc.StreamOutput
+	// pendingProgress is the last progress, held back while a message is pending
+	pendingProgress *externalfunctions.Progress
+	// sent is the time the last frame was sent
+	sent time.Time
+}
+
+// message queues a message of the function, sending the previous one
+//
+// Parameters:
+// - value: the message
+//
+// Returns:
+// - error: an error if a frame cannot be sent
+func (frames *streamFrames) message(value string) error {
+	if frames.pending != nil {
+		err := frames.send(frames.pending)
+		if err != nil {
+			return err
+		}
+		frames.pending = nil
+		err = frames.sendProgress()
+		if err != nil {
+			return err
+		}
+	}
+	frames.pending = &aaliflowkitgrpc.StreamOutput{MessageCounter: frames.counter, IsLast: false, Value: value}
+	frames.counter++
+	return nil
+}
+
+// progress sends a progress frame, or holds it back until the pending message is sent
+//
+// Parameters:
+// - progress: the progress of the function
+//
+// Returns:
+// - error: an error if the frame cannot be sent
+func (frames *streamFrames) progress(progress externalfunctions.Progress) error {
+	frames.pendingProgress = &progress
+	if frames.pending != nil {
+		return nil
+	}
+	return frames.sendProgress()
+}
+
+// end sends the last frame, repeating the value of the last message
+//
+// Returns:
+// - error: an error if a frame cannot be sent
+func (frames *streamFrames) end() error {
+	value := ""
+	if frames.pending != nil {
+		value = frames.pending.Value
+		frames.pending = nil
+	}
+	err := frames.sendProgress()
+	if err != nil {
+		return err
+	}
+	return frames.send(&aaliflowkitgrpc.StreamOutput{MessageCounter: frames.counter, IsLast: true, Value: value})
+}
+
+// sendProgress sends the progress held back, if any
+//
+// Returns:
+// - error: an error if the frame cannot be sent
+func (frames *streamFrames) sendProgress() error {
+	if frames.pendingProgress == nil {
+		return nil
+	}
+	value, err := progressValue(*frames.pendingProgress, frames.format)
+	frames.pendingProgress = nil
+	if err != nil {
+		return err
+	}
+	output := &aaliflowkitgrpc.StreamOutput{MessageCounter: frames.counter, IsLast: false, Value: value}
+	frames.counter++
+	return frames.send(output)
+}
+
+// heartbeat sends a heartbeat frame if no frame was sent for the heartbeat interval
+// The message held back is sent instead if there is one, as the function is silent. With the legacy format,
+// the heartbeat frames have an empty value, with the events format they are heartbeat events.
+//
+// Parameters:
+// - interval: the heartbeat interval
+//
+// Returns:
+// - error: an error if a frame cannot be sent
+func (frames *streamFrames) heartbeat(interval time.Duration) error {
+	if time.Since(frames.sent) < interval {
+		return nil
+	}
+	if frames.pending != nil {
+		err := frames.send(frames.pending)
+		if err != nil {
+			return err
+		}
+		frames.pending = nil
+		return frames.sendProgress()
+	}
+	if frames.pendingProgress != nil {
+		return frames.sendProgress()
+	}
+
+	value := ""
+	if frames.format == externalfunctions.StreamFormatEvents {
+		value = externalfunctions.StreamEvent{Type: externalfunctions.StreamEventHeartbeat}.Encode()
+	}
+	output := &aaliflowkitgrpc.StreamOutput{MessageCounter: frames.counter, IsLast: false, Value: value}
+	frames.counter++
+	return frames.send(output)
+}
+
+// send sends a frame
+//
+// Parameters:
+// - output: the frame
+//
+// Returns:
+// - error: an error if the frame cannot be sent
+func (frames *streamFrames) send(output *aaliflowkitgrpc.StreamOutput) error {
+	frames.sent = time.Now()
+	return frames.stream.Send(output)
+}
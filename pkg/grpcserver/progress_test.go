@@ -0,0 +1,134 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc"
+)
+
+// testFunctionStream records the frames sent by StreamFunction
+type testFunctionStream struct {
+	grpc.ServerStream
+	frames []*aaliflowkitgrpc.StreamOutput
+}
+
+func (stream *testFunctionStream) Send(output *aaliflowkitgrpc.StreamOutput) error {
+	stream.frames = append(stream.frames, output)
+	return nil
+}
+
+// testFrame is an expected frame of StreamFunction
+type testFrame struct {
+	counter int32
+	isLast  bool
+	value   string
+}
+
+func TestStreamFrames(t *testing.T) {
+	// without progress, the frames are numbered as they always were
+	stream := &testFunctionStream{}
+	frames := &streamFrames{stream: stream}
+	for _, message := range []string{"a", "b", "c"} {
+		frames.message(message)
+	}
+	frames.end()
+	checkFrames(t, stream.frames, []testFrame{{0, false, "a"}, {1, false, "b"}, {3, true, "c"}})
+
+	// progress frames are sent between the messages, the last message is still sent last
+	first, _ := progressValue(externalfunctions.Progress{Phase: "embeddings", Done: 0, Total: 2}, externalfunctions.StreamFormatLegacy)
+	second, _ := progressValue(externalfunctions.Progress{Phase: "embeddings", Done: 1, Total: 2}, externalfunctions.StreamFormatLegacy)
+	stream = &testFunctionStream{}
+	frames = &streamFrames{stream: stream}
+	frames.progress(externalfunctions.Progress{Phase: "embeddings", Done: 0, Total: 2})
+	frames.message("a")
+	frames.progress(externalfunctions.Progress{Phase: "embeddings", Done: 1, Total: 2})
+	frames.message("b")
+	frames.end()
+	checkFrames(t, stream.frames, []testFrame{{0, false, first}, {1, false, "a"}, {2, false, second}, {4, true, "b"}})
+
+	// with the events format, progress frames are progress events
+	event, _ := progressValue(externalfunctions.Progress{Phase: "embeddings", Done: 1, Total: 2}, externalfunctions.StreamFormatEvents)
+	decoded, err := externalfunctions.DecodeStreamEvent(event)
+	if err != nil || decoded.Type != externalfunctions.StreamEventProgress || decoded.Progress.Done != 1 {
+		t.Errorf("got progress event %q, %v", event, err)
+	}
+	stream = &testFunctionStream{}
+	frames = &streamFrames{stream: stream, format: externalfunctions.StreamFormatEvents}
+	frames.progress(externalfunctions.Progress{Phase: "embeddings", Done: 1, Total: 2})
+	frames.message(doneEvent(nil).Encode())
+	frames.end()
+	checkFrames(t, stream.frames, []testFrame{
+		{0, false, event},
+		{2, true, `{"type":"done"}`},
+	})
+}
+
+func TestStreamFramesHeartbeat(t *testing.T) {
+	// a silent function first releases the message held back, then sends empty frames
+	stream := &testFunctionStream{}
+	frames := &streamFrames{stream: stream}
+	frames.message("a")
+	frames.heartbeat(0)
+	frames.heartbeat(0)
+	frames.heartbeat(time.Hour)
+	frames.message("b")
+	frames.end()
+	checkFrames(t, stream.frames, []testFrame{{0, false, "a"}, {1, false, ""}, {3, true, "b"}})
+
+	// with the events format, the heartbeat frames are heartbeat events
+	stream = &testFunctionStream{}
+	frames = &streamFrames{stream: stream, format: externalfunctions.StreamFormatEvents}
+	frames.heartbeat(0)
+	checkFrames(t, stream.frames, []testFrame{{0, false, `{"type":"heartbeat"}`}})
+}
+
+func TestProgressUpdates(t *testing.T) {
+	updates, report := progressUpdates()
+	report(externalfunctions.Progress{Done: 1})
+	report(externalfunctions.Progress{Done: 2})
+	if update := <-updates; update.Done != 2 {
+		t.Errorf("got update %+v, want the latest one", update)
+	}
+	select {
+	case update := <-updates:
+		t.Errorf("got stale update %+v", update)
+	default:
+	}
+}
+
+func checkFrames(t *testing.T, got []*aaliflowkitgrpc.StreamOutput, want []testFrame) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d frames, want %d", len(got), len(want))
+	}
+	for i, frame := range want {
+		if got[i].MessageCounter != frame.counter || got[i].IsLast != frame.isLast || got[i].Value != frame.value {
+			t.Errorf("frame %d = {%d %v %q}, want %+v", i, got[i].MessageCounter, got[i].IsLast, got[i].Value, frame)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+)
+
+// defaultReloadInterval is the default interval at which the files read by the server are checked for changes
+const defaultReloadInterval = 10 * time.Second
+
+// watchReloads calls reload at the given interval until the context is done
+// Failed reloads are logged, the caller keeps its previous state.
+//
+// Parameters:
+// - ctx: the context stopping the reloads
+// - interval: the interval between two reloads
+// - name: the name of what is reloaded, for the logs
+// - reload: the function reloading the state if its files changed
+func watchReloads(ctx context.Context, interval time.Duration, name string, reload func() (bool, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		reloaded, err := reload()
+		if err != nil {
+			logging.Log.Errorf(&logging.ContextMap{}, "keeping previous %s: %v", name, err)
+		} else if reloaded {
+			logging.Log.Infof(&logging.ContextMap{}, "reloaded %s", name)
+		}
+	}
+}
+
+// durationVariable returns the duration set in seconds in a workflow config variable
+//
+// Parameters:
+// - variable: the name of the workflow config variable
+// - defaultValue: the duration used if the variable is not set or invalid
+//
+// Returns:
+// - time.Duration: the duration
+func durationVariable(variable string, defaultValue time.Duration) time.Duration {
+	value, ok := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES[variable]
+	if !ok {
+		return defaultValue
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds <= 0 {
+		logging.Log.Warnf(&logging.ContextMap{}, "invalid %s '%s', using %v", variable, value, defaultValue)
+		return defaultValue
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// modTimes returns the modification times of files
+//
+// Parameters:
+// - paths: the paths of the files
+//
+// Returns:
+// - []time.Time: the modification times, in the order of the paths
+// - error: an error if a file cannot be read
+func modTimes(paths ...string) ([]time.Time, error) {
+	times := make([]time.Time, len(paths))
+	for i, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		times[i] = info.ModTime()
+	}
+	return times, nil
+}
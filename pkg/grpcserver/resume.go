@@ -0,0 +1,427 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// streamsServiceName is the name of the gRPC service resuming the streams of StreamFunction
+const streamsServiceName = "aaliflowkitgrpc.Streams"
+
+// streamIDHeaderKey is the response header carrying the ID of a resumable stream
+const streamIDHeaderKey = "x-stream-id"
+
+// resumableRequestKey is the request metadata key asking StreamFunction for a resumable stream
+const resumableRequestKey = "x-resumable"
+
+// defaultStreamRetention is the default time a stream is kept without a client following it
+const defaultStreamRetention = 5 * time.Minute
+
+// defaultStreamBufferFrames is the default number of frames buffered per stream for ResumeStream
+const defaultStreamBufferFrames = 4096
+
+// streamsServer is the server of the aaliflowkitgrpc.Streams service
+// The request of ResumeStream is a google.protobuf.Struct message with the fields of resumeRequest,
+// the frames are StreamOutput messages like the frames of StreamFunction.
+type streamsServer interface {
+	ResumeStream(req *structpb.Struct, stream aaliflowkitgrpc.ExternalFunctions_StreamFunctionServer) error
+}
+
+// streamsServiceDesc is the description of the aaliflowkitgrpc.Streams service
+var streamsServiceDesc = grpc.ServiceDesc{
+	ServiceName: streamsServiceName,
+	HandlerType: (*streamsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName: "ResumeStream",
+			Handler: func(srv any, stream grpc.ServerStream) error {
+				in := new(structpb.Struct)
+				err := stream.RecvMsg(in)
+				if err != nil {
+					return err
+				}
+				return srv.(streamsServer).ResumeStream(in, &frameStream{ServerStream: stream})
+			},
+			ServerStreams: true,
+		},
+	},
+	Metadata: "streams",
+}
+
+// frameStream sends the StreamOutput frames of ResumeStream
+type frameStream struct {
+	grpc.ServerStream
+}
+
+func (stream *frameStream) Send(output *aaliflowkitgrpc.StreamOutput) error {
+	return stream.ServerStream.SendMsg(output)
+}
+
+// requestsResumable checks whether the client asks for a resumable stream
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - bool: true if the request has the "x-resumable: true" metadata
+func requestsResumable(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(resumableRequestKey)
+	return len(values) > 0 && values[0] == "true"
+}
+
+// resumeRequest is the request of ResumeStream
+type resumeRequest struct {
+	// StreamID is the ID of the stream, from the x-stream-id header of StreamFunction
+	StreamID string `json:"streamId"`
+	// LastCounter is the MessageCounter of the last frame received, all buffered frames are sent without it
+	LastCounter *int32 `json:"lastCounter"`
+}
+
+// ResumeStream sends the frames of a StreamFunction call that follow the last frame received by the client
+// The missed frames are replayed from the buffer of the stream, then the frames are sent as the function
+// produces them, until the stream ends with the status of the function.
+//
+// Parameters:
+// - req: the request, with the ID of the stream and the last counter received
+// - stream: the stream to send the frames
+//
+// Returns:
+// - error: a NotFound error if the stream does not exist or expired, an OutOfRange error if the missed
+// frames are no longer buffered, or the error of the function
+func (s *server) ResumeStream(req *structpb.Struct, stream aaliflowkitgrpc.ExternalFunctions_StreamFunctionServer) error {
+	ctx := stream.Context()
+	var request resumeRequest
+	err := decodeStruct(req, &request)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid resume request: %v", err)
+	}
+	if request.StreamID == "" {
+		return status.Error(codes.InvalidArgument, "invalid resume request: streamId is required")
+	}
+	if s.streams == nil {
+		return status.Error(codes.FailedPrecondition, "resumable streams are disabled")
+	}
+
+	session, err := s.streams.get(request.StreamID, jobOwner(ctx))
+	if err != nil {
+		return err
+	}
+	after := int32(-1)
+	if request.LastCounter != nil {
+		after = *request.LastCounter
+	}
+	return s.streams.follow(ctx, session, after, stream)
+}
+
+// newStreamRegistry creates the registry of the resumable streams from the configuration
+// FLOWKIT_STREAM_BUFFER_FRAMES bounds the frames buffered per stream, 0 disables resumable streams;
+// FLOWKIT_STREAM_RETENTION_SECONDS is the time a stream is kept without a client following it.
+//
+// Returns:
+// - *streamRegistry: the registry, nil if resumable streams are disabled
+// - error: an error if the configuration is invalid
+func newStreamRegistry() (*streamRegistry, error) {
+	maxFrames := defaultStreamBufferFrames
+	if value := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_STREAM_BUFFER_FRAMES"]; value != "" {
+		var err error
+		maxFrames, err = strconv.Atoi(value)
+		if err != nil || maxFrames < 0 {
+			return nil, fmt.Errorf("invalid FLOWKIT_STREAM_BUFFER_FRAMES %q", value)
+		}
+	}
+	if maxFrames == 0 {
+		return nil, nil
+	}
+	return &streamRegistry{
+		sessions:  map[string]*streamSession{},
+		retention: durationVariable("FLOWKIT_STREAM_RETENTION_SECONDS", defaultStreamRetention),
+		maxFrames: maxFrames,
+	}, nil
+}
+
+// streamRegistry keeps the resumable streams of StreamFunction
+// A stream is removed, and its function cancelled right away, once no client has followed it for the retention window.
+type streamRegistry struct {
+	mu        sync.Mutex
+	sessions  map[string]*streamSession
+	retention time.Duration
+	maxFrames int
+}
+
+// streamSession is a resumable stream
+// The frames of the function are buffered up to the limit of the registry, the oldest ones are dropped first.
+type streamSession struct {
+	id    string
+	owner string
+	// cancel cancels the function of the stream
+	cancel    context.CancelFunc
+	maxFrames int
+
+	mu     sync.Mutex
+	frames []*aaliflowkitgrpc.StreamOutput
+	// dropped is the counter of the last frame dropped from the buffer, -1 if none was dropped
+	dropped int32
+	done    bool
+	err     error
+	// changed is closed and replaced whenever a frame is added or the stream ends
+	changed   chan struct{}
+	followers int
+	expiry    *time.Timer
+}
+
+// create registers a new stream
+//
+// Parameters:
+// - owner: the name of the API key of the client, empty without authentication
+// - cancel: cancels the function of the stream
+//
+// Returns:
+// - *streamSession: the stream
+func (registry *streamRegistry) create(owner string, cancel context.CancelFunc) *streamSession {
+	session := &streamSession{
+		id:        uuid.NewString(),
+		owner:     owner,
+		cancel:    cancel,
+		maxFrames: registry.maxFrames,
+		dropped:   -1,
+		changed:   make(chan struct{}),
+	}
+	registry.mu.Lock()
+	registry.sessions[session.id] = session
+	registry.mu.Unlock()
+	return session
+}
+
+// get returns a stream of a client
+//
+// Parameters:
+// - id: the ID of the stream
+// - owner: the name of the API key of the client, the streams of other keys are not disclosed
+//
+// Returns:
+// - *streamSession: the stream
+// - error: a NotFound error if the stream does not exist, expired or belongs to another key
+func (registry *streamRegistry) get(id string, owner string) (*streamSession, error) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	session, ok := registry.sessions[id]
+	if !ok || session.owner != owner {
+		return nil, status.Errorf(codes.NotFound, "stream %s not found", id)
+	}
+	return session, nil
+}
+
+// remove removes a stream and cancels its function
+//
+// Parameters:
+// - session: the stream
+func (registry *streamRegistry) remove(session *streamSession) {
+	registry.mu.Lock()
+	delete(registry.sessions, session.id)
+	registry.mu.Unlock()
+	session.cancel()
+}
+
+// close removes all streams and cancels their functions, on shutdown
+func (registry *streamRegistry) close() {
+	registry.mu.Lock()
+	sessions := registry.sessions
+	registry.sessions = map[string]*streamSession{}
+	registry.mu.Unlock()
+	for _, session := range sessions {
+		session.mu.Lock()
+		if session.expiry != nil {
+			session.expiry.Stop()
+		}
+		session.mu.Unlock()
+		session.cancel()
+	}
+}
+
+// follow sends the frames of a stream to a client until the stream ends or the client disconnects
+//
+// Parameters:
+// - ctx: the context of the request of the client
+// - session: the stream
+// - after: the counter of the last frame received by the client, -1 to send all buffered frames
+// - sender: the stream of the client
+//
+// Returns:
+// - error: the error of the function, or an error if the frames cannot be sent
+func (registry *streamRegistry) follow(ctx context.Context, session *streamSession, after int32, sender frameSender) error {
+	registry.attach(session)
+	defer registry.detach(session)
+
+	for {
+		frames, changed, done, err := session.since(after)
+		if err != nil {
+			return err
+		}
+		for _, frame := range frames {
+			err = sender.Send(frame)
+			if err != nil {
+				return err
+			}
+			after = frame.MessageCounter
+		}
+		if done {
+			return session.result()
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-changed:
+		}
+	}
+}
+
+// attach counts a client following a stream, which is then kept
+//
+// Parameters:
+// - session: the stream
+func (registry *streamRegistry) attach(session *streamSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.followers++
+	if session.expiry != nil {
+		session.expiry.Stop()
+		session.expiry = nil
+	}
+}
+
+// detach stops counting a client following a stream
+// The stream is removed after the retention window if no other client follows it by then.
+//
+// Parameters:
+// - session: the stream
+func (registry *streamRegistry) detach(session *streamSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.followers--
+	if session.followers > 0 {
+		return
+	}
+	var expiry *time.Timer
+	expiry = time.AfterFunc(registry.retention, func() {
+		session.mu.Lock()
+		expired := session.expiry == expiry
+		session.mu.Unlock()
+		if expired {
+			registry.remove(session)
+		}
+	})
+	session.expiry = expiry
+}
+
+// Send adds a frame of the function to the buffer of the stream
+// It never blocks, so the function keeps running at its own pace whatever its clients do.
+//
+// Parameters:
+// - frame: the frame
+//
+// Returns:
+// - error: always nil
+func (session *streamSession) Send(frame *aaliflowkitgrpc.StreamOutput) error {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.frames = append(session.frames, frame)
+	if len(session.frames) > session.maxFrames {
+		session.dropped = session.frames[0].MessageCounter
+		session.frames = session.frames[1:]
+	}
+	session.notify()
+	return nil
+}
+
+// finish ends the stream with the error of the function
+//
+// Parameters:
+// - err: the error of the function, nil if it succeeded
+func (session *streamSession) finish(err error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.done = true
+	session.err = err
+	session.notify()
+}
+
+// result returns the error of the function, once the stream has ended
+//
+// Returns:
+// - error: the error of the function
+func (session *streamSession) result() error {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.err
+}
+
+// since returns the buffered frames after a counter
+//
+// Parameters:
+// - after: the counter of the last frame received by the client
+//
+// Returns:
+// - []*aaliflowkitgrpc.StreamOutput: the frames after the counter
+// - <-chan struct{}: closed once the next frame is added or the stream ends
+// - bool: true if the stream has ended, the frames are then the last ones
+// - error: an OutOfRange error if frames after the counter were dropped from the buffer
+func (session *streamSession) since(after int32) ([]*aaliflowkitgrpc.StreamOutput, <-chan struct{}, bool, error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if after < session.dropped {
+		return nil, nil, false, status.Errorf(codes.OutOfRange, "frames after %d of stream %s are no longer buffered", after, session.id)
+	}
+	frames := []*aaliflowkitgrpc.StreamOutput{}
+	for _, frame := range session.frames {
+		if frame.MessageCounter > after {
+			frames = append(frames, frame)
+		}
+	}
+	return frames, session.changed, session.done, nil
+}
+
+// notify wakes up the clients waiting for the stream, the lock of the session must be held
+func (session *streamSession) notify() {
+	close(session.changed)
+	session.changed = make(chan struct{})
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// testFrameSender records the counters of the frames it receives
+type testFrameSender struct {
+	counters []int32
+}
+
+func (sender *testFrameSender) Send(output *aaliflowkitgrpc.StreamOutput) error {
+	sender.counters = append(sender.counters, output.MessageCounter)
+	return nil
+}
+
+func TestStreamRegistry(t *testing.T) {
+	registry := &streamRegistry{sessions: map[string]*streamSession{}, retention: 50 * time.Millisecond, maxFrames: 3}
+	cancelled := make(chan struct{})
+	session := registry.create("ingestion", func() { close(cancelled) })
+	failure := status.Error(codes.Unavailable, "aali-llm is not reachable")
+	go func() {
+		for counter := int32(0); counter < 4; counter++ {
+			session.Send(&aaliflowkitgrpc.StreamOutput{MessageCounter: counter, IsLast: counter == 3})
+			time.Sleep(time.Millisecond)
+		}
+		session.finish(failure)
+	}()
+
+	// the first client follows the stream live until the end
+	sender := &testFrameSender{}
+	err := registry.follow(context.Background(), session, -1, sender)
+	if !errors.Is(err, failure) {
+		t.Errorf("follow() error = %v, want the error of the function", err)
+	}
+	if len(sender.counters) != 4 {
+		t.Errorf("follow() sent frames %v, want 4 frames", sender.counters)
+	}
+
+	// other clients resume from the buffer
+	if _, err := registry.get(session.id, "other"); status.Code(err) != codes.NotFound {
+		t.Errorf("get() with another key code = %v, want NotFound", status.Code(err))
+	}
+	resumed, err := registry.get(session.id, "ingestion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender = &testFrameSender{}
+	registry.follow(context.Background(), resumed, 1, sender)
+	if len(sender.counters) != 2 || sender.counters[0] != 2 || sender.counters[1] != 3 {
+		t.Errorf("resumed frames = %v, want [2 3]", sender.counters)
+	}
+	err = registry.follow(context.Background(), resumed, -1, &testFrameSender{})
+	if status.Code(err) != codes.OutOfRange {
+		t.Errorf("resuming dropped frames code = %v, want OutOfRange", status.Code(err))
+	}
+
+	// the stream expires once nobody follows it for the retention window
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not expire")
+	}
+	if _, err := registry.get(session.id, "ingestion"); status.Code(err) != codes.NotFound {
+		t.Errorf("get() of an expired stream code = %v, want NotFound", status.Code(err))
+	}
+}
+
+func TestStreamRegistryExpiryCancelsFunction(t *testing.T) {
+	registry := &streamRegistry{sessions: map[string]*streamSession{}, retention: 20 * time.Millisecond, maxFrames: 3}
+	cancelled := make(chan struct{})
+	session := registry.create("", func() { close(cancelled) })
+
+	// the client disconnects while the function is running and does not come back
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := registry.follow(ctx, session, -1, &testFrameSender{})
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("follow() code = %v, want Canceled", status.Code(err))
+	}
+
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the function of the expired stream was not cancelled")
+	}
+}
+
+func TestResumeStreamRequest(t *testing.T) {
+	s := &server{streams: &streamRegistry{sessions: map[string]*streamSession{}, retention: time.Minute, maxFrames: 10}}
+	stream := &localStream{ctx: context.Background(), send: func(*aaliflowkitgrpc.StreamOutput) error { return nil }}
+
+	tests := []struct {
+		name     string
+		request  map[string]any
+		wantCode codes.Code
+	}{
+		{name: "missing stream ID", request: map[string]any{"lastCounter": 3}, wantCode: codes.InvalidArgument},
+		{name: "unknown field", request: map[string]any{"streamId": "abc", "counter": 3}, wantCode: codes.InvalidArgument},
+		{name: "unknown stream", request: map[string]any{"streamId": "abc", "lastCounter": 3}, wantCode: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := structpb.NewStruct(tt.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.ResumeStream(req, stream)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ResumeStream() code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestResumableStreamFunction(t *testing.T) {
+	registerTestRAG(t)
+	s := &server{streams: &streamRegistry{sessions: map[string]*streamSession{}, retention: time.Minute, maxFrames: 10}}
+	defer s.streams.close()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(streamFormatRequestKey, "events"))
+
+	// streams are only resumable on request
+	err := s.StreamFunction(&aaliflowkitgrpc.FunctionInputs{Name: "TestRAG"}, &localStream{ctx: ctx, send: (&testFrameSender{}).Send})
+	if err != nil {
+		t.Fatalf("StreamFunction() failed: %v", err)
+	}
+	if len(s.streams.sessions) != 0 {
+		t.Fatalf("got %d streams without %s, want 0", len(s.streams.sessions), resumableRequestKey)
+	}
+
+	// the frames of the call are sent live and kept for ResumeStream
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(streamFormatRequestKey, "events", resumableRequestKey, "true"))
+	sender := &testFrameSender{}
+	stream := &localStream{ctx: ctx, send: sender.Send}
+	err = s.StreamFunction(&aaliflowkitgrpc.FunctionInputs{Name: "TestRAG"}, stream)
+	if err != nil {
+		t.Fatalf("StreamFunction() failed: %v", err)
+	}
+	if len(s.streams.sessions) != 1 {
+		t.Fatalf("got %d streams, want 1", len(s.streams.sessions))
+	}
+	var id string
+	for id = range s.streams.sessions {
+	}
+
+	req, err := structpb.NewStruct(map[string]any{"streamId": id, "lastCounter": sender.counters[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resumed := &testFrameSender{}
+	err = s.ResumeStream(req, &localStream{ctx: ctx, send: resumed.Send})
+	if err != nil {
+		t.Fatalf("ResumeStream() failed: %v", err)
+	}
+	if !slices.Equal(resumed.counters, sender.counters[1:]) {
+		t.Errorf("resumed frames %v, want %v", resumed.counters, sender.counters[1:])
+	}
+}
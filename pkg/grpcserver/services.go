@@ -0,0 +1,108 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The services below are not part of the aali-sharedtypes protobuf definitions: their requests and
+// responses are well-known protobuf types, mostly google.protobuf.Struct messages carrying the JSON
+// encoding of Go values, so that any gRPC client can call them.
+
+// unaryMethod describes a unary method of a service without generated code
+//
+// Parameters:
+// - serviceName: the full name of the service
+// - name: the name of the method
+// - call: the method of the server
+//
+// Returns:
+// - grpc.MethodDesc: the description of the method
+func unaryMethod[Server any, Req any, Res any](serviceName string, name string, call func(Server, context.Context, *Req) (Res, error)) grpc.MethodDesc {
+	fullMethod := "/" + serviceName + "/" + name
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+			in := new(Req)
+			err := dec(in)
+			if err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(Server), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+			handler := func(ctx context.Context, req any) (any, error) {
+				return call(srv.(Server), ctx, req.(*Req))
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
+
+// encodeStruct encodes a Go value as a google.protobuf.Struct message through its JSON encoding
+//
+// Parameters:
+// - value: the value, encoding to a JSON object
+//
+// Returns:
+// - *structpb.Struct: the encoded value
+// - error: an error if the value cannot be encoded
+func encodeStruct(value any) (*structpb.Struct, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	message := &structpb.Struct{}
+	err = protojson.Unmarshal(encoded, message)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// decodeStruct decodes a google.protobuf.Struct message into a Go value through its JSON encoding
+// Unknown fields are rejected.
+//
+// Parameters:
+// - message: the message
+// - value: a pointer to the value
+//
+// Returns:
+// - error: an error if the message does not match the value
+func decodeStruct(message *structpb.Struct, value any) error {
+	encoded, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(value)
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/jobs"
+	"github.com/ansys/aali-flowkit/pkg/privatefunctions/llmclient"
+	qdrant_utils "github.com/ansys/aali-flowkit/pkg/privatefunctions/qdrant"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+)
+
+// defaultShutdownGracePeriod is the default time given to in-flight calls and streams to finish on shutdown
+const defaultShutdownGracePeriod = 30 * time.Second
+
+// clientCloseTimeout bounds the disconnection of the shared database clients on shutdown
+const clientCloseTimeout = 10 * time.Second
+
+// stoppableServer is the part of grpc.Server used to stop it
+type stoppableServer interface {
+	GracefulStop()
+	Stop()
+}
+
+// shutdown stops the server and closes the clients shared by the functions
+// The server stops accepting new calls and in-flight calls and streams are drained within the grace period,
+// the calls still running after it are cancelled. The unfinished jobs are then stopped and marked as failed,
+// the functions of the resumable streams are cancelled, and the pooled Qdrant, MongoDB and aali-llm
+// clients are closed, in this order.
+//
+// Parameters:
+// - s: the gRPC server
+// - checker: the health checker, reporting the server as not serving during the shutdown
+// - jobManager: the manager of the jobs
+// - streams: the resumable streams, nil if they are disabled
+// - gracePeriod: the time given to in-flight calls to finish
+func shutdown(s stoppableServer, checker *healthChecker, jobManager *jobs.Manager, streams *streamRegistry, gracePeriod time.Duration) {
+	logging.Log.Infof(&logging.ContextMap{}, "Shutting down Aali FlowKit; draining in-flight calls for up to %v...", gracePeriod)
+	if checker != nil {
+		checker.server.Shutdown()
+	}
+
+	if !stopGracefully(s, gracePeriod) {
+		logging.Log.Warnf(&logging.ContextMap{}, "Grace period of %v exceeded; cancelled the remaining calls.", gracePeriod)
+	}
+
+	if jobManager != nil {
+		err := jobManager.Close(clientCloseTimeout)
+		if err != nil {
+			logging.Log.Errorf(&logging.ContextMap{}, "error closing job store: %v", err)
+		}
+	}
+
+	if streams != nil {
+		streams.close()
+	}
+
+	closeClients()
+	logging.Log.Infof(&logging.ContextMap{}, "Aali FlowKit stopped.")
+}
+
+// stopGracefully stops the server once its in-flight calls are done, or cancels them after the grace period
+//
+// Parameters:
+// - s: the gRPC server
+// - gracePeriod: the time given to in-flight calls to finish
+//
+// Returns:
+// - bool: true if all calls finished within the grace period
+func stopGracefully(s stoppableServer, gracePeriod time.Duration) bool {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(gracePeriod)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		s.Stop()
+		<-stopped
+		return false
+	}
+}
+
+// closeClients closes the clients shared by the functions
+func closeClients() {
+	qdrant_utils.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), clientCloseTimeout)
+	defer cancel()
+	externalfunctions.CloseMongoDbClients(ctx)
+
+	llmclient.Shutdown()
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"testing"
+	"time"
+)
+
+// testServer is a server whose in-flight calls finish when done is closed
+type testServer struct {
+	done    chan struct{}
+	stopped bool
+}
+
+func (s *testServer) GracefulStop() {
+	<-s.done
+}
+
+func (s *testServer) Stop() {
+	s.stopped = true
+	close(s.done)
+}
+
+func TestStopGracefully(t *testing.T) {
+	// the in-flight calls finish within the grace period
+	s := &testServer{done: make(chan struct{})}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(s.done)
+	}()
+	if !stopGracefully(s, time.Second) || s.stopped {
+		t.Errorf("stopGracefully() did not wait for the in-flight calls")
+	}
+
+	// the in-flight calls are cancelled after the grace period
+	s = &testServer{done: make(chan struct{})}
+	if stopGracefully(s, 10*time.Millisecond) || !s.stopped {
+		t.Errorf("stopGracefully() did not stop the server after the grace period")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// streamFormatRequestKey is the request metadata key choosing the format of the StreamFunction messages
+const streamFormatRequestKey = "x-stream-format"
+
+// streamFormat returns the format of the StreamFunction messages of a request
+// The "x-stream-format" request metadata takes precedence over the FLOWKIT_STREAM_FORMAT default of the server,
+// the legacy format is used if neither is set.
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - externalfunctions.StreamFormat: the format of the messages
+// - error: an InvalidArgument error if the request asks for an unknown format
+func streamFormat(ctx context.Context) (externalfunctions.StreamFormat, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if values := md.Get(streamFormatRequestKey); len(values) > 0 {
+			format, err := externalfunctions.ParseStreamFormat(values[0])
+			if err != nil {
+				return "", status.Errorf(codes.InvalidArgument, "invalid %s metadata: %v", streamFormatRequestKey, err)
+			}
+			return format, nil
+		}
+	}
+
+	format, err := externalfunctions.ParseStreamFormat(config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_STREAM_FORMAT"])
+	if err != nil {
+		logging.Log.Warnf(&logging.ContextMap{}, "invalid FLOWKIT_STREAM_FORMAT, using %s: %v", externalfunctions.StreamFormatLegacy, err)
+		return externalfunctions.StreamFormatLegacy, nil
+	}
+	return format, nil
+}
+
+// doneEvent creates the event ending a stream in the events format
+//
+// Parameters:
+// - outputs: the outputs of the function that are not streamed
+//
+// Returns:
+// - externalfunctions.StreamEvent: the done event
+func doneEvent(outputs []*aaliflowkitgrpc.FunctionOutput) externalfunctions.StreamEvent {
+	event := externalfunctions.StreamEvent{Type: externalfunctions.StreamEventDone}
+	for _, output := range outputs {
+		event.Outputs = append(event.Outputs, externalfunctions.StreamEventOutput{Name: output.Name, GoType: output.GoType, Value: output.Value})
+	}
+	return event
+}
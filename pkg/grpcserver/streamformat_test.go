@@ -0,0 +1,70 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamFormat(t *testing.T) {
+	previous := config.GlobalConfig
+	t.Cleanup(func() { config.GlobalConfig = previous })
+
+	tests := []struct {
+		name          string
+		serverDefault string
+		md            metadata.MD
+		want          externalfunctions.StreamFormat
+		wantCode      codes.Code
+	}{
+		{name: "legacy by default", want: externalfunctions.StreamFormatLegacy},
+		{name: "server default", serverDefault: "events", want: externalfunctions.StreamFormatEvents},
+		{name: "invalid server default", serverDefault: "binary", want: externalfunctions.StreamFormatLegacy},
+		{name: "request", md: metadata.Pairs(streamFormatRequestKey, "events"), want: externalfunctions.StreamFormatEvents},
+		{name: "request overrides server default", serverDefault: "events", md: metadata.Pairs(streamFormatRequestKey, "legacy"), want: externalfunctions.StreamFormatLegacy},
+		{name: "invalid request", md: metadata.Pairs(streamFormatRequestKey, "binary"), wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"FLOWKIT_STREAM_FORMAT": tt.serverDefault}}
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			format, err := streamFormat(ctx)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("got error %v, want code %v", err, tt.wantCode)
+			}
+			if format != tt.want {
+				t.Errorf("got format %q, want %q", format, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// legacyStreams checks whether the stream outputs of a function can be sent in the legacy format
+// The legacy format has no way to tell streams apart, so it is limited to functions with a single *chan string output.
+//
+// Parameters:
+// - adapter: the adapter of the function
+//
+// Returns:
+// - bool: true if the function has at most one stream output, of type *chan string
+func legacyStreams(adapter externalfunctions.FunctionAdapter) bool {
+	streams := 0
+	for _, output := range adapter.Outputs {
+		if !output.IsStream() {
+			continue
+		}
+		streams++
+		if output.GoType != "*chan string" {
+			return false
+		}
+	}
+	return streams <= 1
+}
+
+// streamOutputs receives the messages of the stream outputs of a function call
+// The channels are received from together, in the order the function sends on them.
+type streamOutputs struct {
+	adapter externalfunctions.FunctionAdapter
+	// indexes are the indexes of the stream outputs in the outputs of the function
+	indexes []int
+	// channels are the channels of the stream outputs, the zero value once closed
+	channels []reflect.Value
+	open     int
+}
+
+// newStreamOutputs collects the stream outputs of a function call
+// Stream outputs the function returned as nil pointers are considered closed.
+//
+// Parameters:
+// - adapter: the adapter of the function
+// - results: the outputs of the function
+//
+// Returns:
+// - *streamOutputs: the stream outputs
+func newStreamOutputs(adapter externalfunctions.FunctionAdapter, results []any) *streamOutputs {
+	streams := &streamOutputs{adapter: adapter}
+	for i, output := range adapter.Outputs {
+		if !output.IsStream() {
+			continue
+		}
+		channel := reflect.ValueOf(results[i])
+		if channel.IsValid() && !channel.IsNil() {
+			channel = channel.Elem()
+		}
+		if !channel.IsValid() || channel.IsNil() {
+			channel = reflect.Value{}
+		} else {
+			streams.open++
+		}
+		streams.indexes = append(streams.indexes, i)
+		streams.channels = append(streams.channels, channel)
+	}
+	return streams
+}
+
+// streamed checks whether a function output is a stream output
+//
+// Parameters:
+// - index: the index of the output
+//
+// Returns:
+// - bool: true for stream outputs
+func (streams *streamOutputs) streamed(index int) bool {
+	for _, streamIndex := range streams.indexes {
+		if streamIndex == index {
+			return true
+		}
+	}
+	return false
+}
+
+// streamMessage is what StreamFunction receives while the function streams:
+// a message of a stream output, a progress update or the tick of the heartbeat
+type streamMessage struct {
+	// output is the index of the stream output in the outputs of the function
+	output int
+	value  reflect.Value
+	// progress is the progress update, if any
+	progress *externalfunctions.Progress
+	// heartbeat is true for the ticks of the heartbeat
+	heartbeat bool
+}
+
+// receive waits for the next message of the stream outputs, progress update or heartbeat tick
+// Closed channels are skipped; ok is false once all of them are closed.
+//
+// Parameters:
+// - ctx: the context of the request
+// - progress: the progress updates of the function, nil if the client does not follow them
+// - heartbeat: the ticks of the heartbeat, nil without heartbeat
+//
+// Returns:
+// - streamMessage: the message
+// - bool: false once all stream outputs are closed
+// - error: an error if the request is cancelled
+func (streams *streamOutputs) receive(ctx context.Context, progress <-chan externalfunctions.Progress, heartbeat <-chan time.Time) (streamMessage, bool, error) {
+	cases := make([]reflect.SelectCase, 0, len(streams.channels)+3)
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(progress)})
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(heartbeat)})
+	for _, channel := range streams.channels {
+		// the select ignores the cases of the closed channels, whose value is the zero value
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: channel})
+	}
+
+	for streams.open > 0 {
+		chosen, value, open := reflect.Select(cases)
+		switch chosen {
+		case 0:
+			return streamMessage{}, false, status.FromContextError(ctx.Err()).Err()
+		case 1:
+			update := value.Interface().(externalfunctions.Progress)
+			return streamMessage{progress: &update}, true, nil
+		case 2:
+			return streamMessage{heartbeat: true}, true, nil
+		}
+		if !open {
+			streams.channels[chosen-3] = reflect.Value{}
+			cases[chosen].Chan = reflect.Value{}
+			streams.open--
+			continue
+		}
+		return streamMessage{output: streams.indexes[chosen-3], value: value}, true, nil
+	}
+	return streamMessage{}, false, nil
+}
+
+// event converts a message of a stream output to an event of the events format
+// The messages of *chan string outputs are the events written by the function, or tokens if they are not events;
+// the messages of the other stream outputs are sent as value events with the message encoded as JSON.
+//
+// Parameters:
+// - message: the message
+//
+// Returns:
+// - externalfunctions.StreamEvent: the event, with the name of its stream output
+// - error: an error if the message cannot be encoded
+func (streams *streamOutputs) event(message streamMessage) (externalfunctions.StreamEvent, error) {
+	output := streams.adapter.Outputs[message.output]
+	if text, ok := message.value.Interface().(string); ok {
+		event, err := externalfunctions.DecodeStreamEvent(text)
+		if err != nil {
+			event = externalfunctions.StreamEvent{Type: externalfunctions.StreamEventToken, Delta: text}
+		}
+		event.Stream = output.Name
+		return event, nil
+	}
+
+	value, err := json.Marshal(message.value.Interface())
+	if err != nil {
+		return externalfunctions.StreamEvent{}, status.Errorf(codes.Internal, "error encoding message of stream output %s of function %s: %v", output.Name, streams.adapter.Name, err)
+	}
+	return externalfunctions.StreamEvent{Type: externalfunctions.StreamEventValue, Stream: output.Name, Value: value}, nil
+}
+
+// finalOutputs encodes the outputs of a function call that are not streamed, for the done event
+//
+// Parameters:
+// - results: the outputs of the function
+//
+// Returns:
+// - []*aaliflowkitgrpc.FunctionOutput: the encoded outputs
+// - error: an error if an output cannot be encoded
+func (streams *streamOutputs) finalOutputs(results []any) ([]*aaliflowkitgrpc.FunctionOutput, error) {
+	outputs := []*aaliflowkitgrpc.FunctionOutput{}
+	for i, result := range results {
+		if streams.streamed(i) {
+			continue
+		}
+		value, err := streams.adapter.EncodeOutput(i, result)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, &aaliflowkitgrpc.FunctionOutput{
+			Name:   streams.adapter.Outputs[i].Name,
+			GoType: streams.adapter.Outputs[i].GoType,
+			Value:  value,
+		})
+	}
+	return outputs, nil
+}
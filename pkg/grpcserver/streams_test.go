@@ -0,0 +1,132 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// registerTestRAG registers a function streaming its answer and its citations, and counting the citations
+func registerTestRAG(t *testing.T) {
+	t.Helper()
+	outputs := []externalfunctions.AdapterParameter{
+		{Name: "answer", GoType: "*chan string", Type: reflect.TypeFor[*chan string]()},
+		{Name: "citations", GoType: "*chan sharedtypes.DbResponse", Type: reflect.TypeFor[*chan sharedtypes.DbResponse]()},
+		{Name: "count", GoType: "int", Type: reflect.TypeFor[int]()},
+	}
+	adapter := externalfunctions.NewFunctionAdapter("TestRAG", nil, outputs, func(ctx context.Context, inputs []any) []any {
+		answer := make(chan string, 2)
+		citations := make(chan sharedtypes.DbResponse, 1)
+		answer <- "Hello"
+		citations <- sharedtypes.DbResponse{DocumentName: "guide.pdf"}
+		answer <- " world"
+		close(answer)
+		close(citations)
+		return []any{&answer, &citations, 1}
+	})
+
+	previousFunctions := internalstates.AvailableFunctions
+	internalstates.AvailableFunctions = map[string]*aaliflowkitgrpc.FunctionDefinition{"TestRAG": {Name: "TestRAG"}}
+	externalfunctions.FunctionAdapters["TestRAG"] = adapter
+	t.Cleanup(func() {
+		internalstates.AvailableFunctions = previousFunctions
+		delete(externalfunctions.FunctionAdapters, "TestRAG")
+	})
+}
+
+func TestStreamFunctionStreamOutputs(t *testing.T) {
+	registerTestRAG(t)
+	req := &aaliflowkitgrpc.FunctionInputs{Name: "TestRAG"}
+
+	// the legacy format cannot tell the streams apart
+	err := StreamLocal(context.Background(), req, func(*aaliflowkitgrpc.StreamOutput) error { return nil })
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("legacy StreamFunction code = %v, want FailedPrecondition", status.Code(err))
+	}
+
+	// the events format tags the messages with their stream and ends with the other outputs
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(streamFormatRequestKey, "events"))
+	frames := []*aaliflowkitgrpc.StreamOutput{}
+	err = StreamLocal(ctx, req, func(output *aaliflowkitgrpc.StreamOutput) error {
+		frames = append(frames, output)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamFunction() failed: %v", err)
+	}
+
+	answer := ""
+	citations := []string{}
+	var done externalfunctions.StreamEvent
+	for i, frame := range frames {
+		event, err := externalfunctions.DecodeStreamEvent(frame.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case event.Type == externalfunctions.StreamEventToken && event.Stream == "answer":
+			answer += event.Delta
+		case event.Type == externalfunctions.StreamEventValue && event.Stream == "citations":
+			citations = append(citations, string(event.Value))
+		case event.Type == externalfunctions.StreamEventDone:
+			if i != len(frames)-1 || !frame.IsLast {
+				t.Errorf("done event in frame %d of %d, IsLast %v", i, len(frames), frame.IsLast)
+			}
+			done = event
+		default:
+			t.Errorf("unexpected event %+v", event)
+		}
+	}
+	if answer != "Hello world" {
+		t.Errorf("answer = %q, want %q", answer, "Hello world")
+	}
+	if len(citations) != 1 || decodeCitation(t, citations[0]).DocumentName != "guide.pdf" {
+		t.Errorf("citations = %q", citations)
+	}
+	wantOutputs := []externalfunctions.StreamEventOutput{{Name: "count", GoType: "int", Value: "1"}}
+	if !reflect.DeepEqual(done.Outputs, wantOutputs) {
+		t.Errorf("done outputs = %+v, want %+v", done.Outputs, wantOutputs)
+	}
+}
+
+// decodeCitation decodes the value of a citation event
+func decodeCitation(t *testing.T, value string) sharedtypes.DbResponse {
+	t.Helper()
+	var citation sharedtypes.DbResponse
+	err := json.Unmarshal([]byte(value), &citation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return citation
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+)
+
+// unknownFunctionName is the function name of the telemetry of calls to functions that do not exist
+// It keeps the names sent by clients out of the metric attributes.
+const unknownFunctionName = "unknown"
+
+// traceFunction starts the server span of a function call and returns the function ending it
+// The span is a child of the span sent by the client in the "traceparent" metadata, if any.
+// Ending the call also records it in the RED metrics of the function.
+//
+// Parameters:
+// - ctx: the context of the request
+// - method: the gRPC method calling the function
+// - functionName: the name of the function
+//
+// Returns:
+// - context.Context: the context carrying the span, to pass to the function
+// - func(error): ends the call with its error
+func traceFunction(ctx context.Context, method string, functionName string) (context.Context, func(error)) {
+	start := time.Now()
+	if _, ok := internalstates.AvailableFunctions[functionName]; !ok {
+		functionName = unknownFunctionName
+	}
+
+	ctx, span := telemetry.Tracer().Start(telemetry.ExtractMetadata(ctx), method+" "+functionName,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", aaliflowkitgrpc.ExternalFunctions_ServiceDesc.ServiceName),
+			attribute.String("rpc.method", method),
+			attribute.String("flowkit.function", functionName),
+		),
+	)
+	return ctx, func(err error) {
+		code := status.Code(err)
+		span.SetAttributes(attribute.String("rpc.grpc.status_code", code.String()))
+		telemetry.EndSpan(span, err)
+		telemetry.RecordFunctionCall(ctx, method, functionName, start, code)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// certificateStore holds the certificate of the server and the CAs verifying client certificates
+// The files are reloaded when they change, so certificates can be renewed without restarting the server.
+type certificateStore struct {
+	certFile      string
+	keyFile       string
+	clientCAFiles []string
+	clientAuth    tls.ClientAuthType
+
+	mutex       sync.RWMutex
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	modTimes    []time.Time
+}
+
+// newCertificateStore creates the certificate store and loads its files
+//
+// Parameters:
+// - certFile: the path of the server certificate
+// - keyFile: the path of the private key of the server certificate
+// - clientCAFiles: the paths of the PEM bundles of the CAs of client certificates; empty to disable mutual TLS
+// - clientAuth: the verification of client certificates when mutual TLS is enabled
+//
+// Returns:
+// - *certificateStore: the certificate store
+// - error: an error if the files cannot be loaded
+func newCertificateStore(certFile string, keyFile string, clientCAFiles []string, clientAuth tls.ClientAuthType) (*certificateStore, error) {
+	store := &certificateStore{certFile: certFile, keyFile: keyFile, clientCAFiles: clientCAFiles, clientAuth: clientAuth}
+	_, err := store.reload()
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// mutualTLS checks whether client certificates are verified
+//
+// Returns:
+// - bool: true if client CAs are configured
+func (store *certificateStore) mutualTLS() bool {
+	return len(store.clientCAFiles) > 0
+}
+
+// reload loads the certificate files if one of them changed since the last load
+// The previous certificates are kept if a file cannot be read or is invalid.
+//
+// Returns:
+// - bool: true if the certificates were reloaded
+// - error: an error if the files cannot be loaded
+func (store *certificateStore) reload() (bool, error) {
+	files := append([]string{store.certFile, store.keyFile}, store.clientCAFiles...)
+	times, err := modTimes(files...)
+	if err != nil {
+		return false, fmt.Errorf("error reading certificate: %v", err)
+	}
+	store.mutex.RLock()
+	unchanged := slices.EqualFunc(times, store.modTimes, time.Time.Equal)
+	store.mutex.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(store.certFile, store.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("error loading server certificate: %v", err)
+	}
+	var clientCAs *x509.CertPool
+	if store.mutualTLS() {
+		clientCAs = x509.NewCertPool()
+		for _, file := range store.clientCAFiles {
+			bundle, err := os.ReadFile(file)
+			if err != nil {
+				return false, fmt.Errorf("error reading client CA bundle: %v", err)
+			}
+			if !clientCAs.AppendCertsFromPEM(bundle) {
+				return false, fmt.Errorf("client CA bundle %s has no PEM certificate", file)
+			}
+		}
+	}
+
+	store.mutex.Lock()
+	store.certificate = &certificate
+	store.clientCAs = clientCAs
+	store.modTimes = times
+	store.mutex.Unlock()
+	return true, nil
+}
+
+// watch reloads the certificate files at the given interval until the context is done
+//
+// Parameters:
+// - ctx: the context stopping the reloads
+// - interval: the interval between two checks of the files
+func (store *certificateStore) watch(ctx context.Context, interval time.Duration) {
+	watchReloads(ctx, interval, "certificates", store.reload)
+}
+
+// tlsConfig returns the TLS configuration of the server
+// Every handshake uses the latest loaded certificates.
+//
+// Returns:
+// - *tls.Config: the TLS configuration
+func (store *certificateStore) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			store.mutex.RLock()
+			defer store.mutex.RUnlock()
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*store.certificate},
+				NextProtos:   []string{"h2"},
+			}
+			if store.mutualTLS() {
+				config.ClientCAs = store.clientCAs
+				config.ClientAuth = store.clientAuth
+			}
+			return config, nil
+		},
+	}
+}
+
+// parseClientAuth parses the verification mode of client certificates
+//
+// Parameters:
+// - value: "require" or empty to require a valid client certificate, "optional" to only verify certificates that are sent
+//
+// Returns:
+// - tls.ClientAuthType: the verification mode
+// - error: an error if the value is unknown
+func parseClientAuth(value string) (tls.ClientAuthType, error) {
+	switch value {
+	case "", "require":
+		return tls.RequireAndVerifyClientCert, nil
+	case "optional":
+		return tls.VerifyClientCertIfGiven, nil
+	default:
+		return tls.NoClientCert, fmt.Errorf("invalid client authentication '%s', expected 'require' or 'optional'", value)
+	}
+}
+
+// identityFromCertificate returns the identity of a client from its certificate
+//
+// Parameters:
+// - certificate: the verified client certificate
+//
+// Returns:
+// - externalfunctions.ClientIdentity: the common name and subject alternative names of the certificate
+func identityFromCertificate(certificate *x509.Certificate) externalfunctions.ClientIdentity {
+	identity := externalfunctions.ClientIdentity{
+		CommonName:     certificate.Subject.CommonName,
+		DNSNames:       certificate.DNSNames,
+		EmailAddresses: certificate.EmailAddresses,
+	}
+	for _, uri := range certificate.URIs {
+		identity.URIs = append(identity.URIs, uri.String())
+	}
+	return identity
+}
+
+// withClientIdentity adds the identity of the verified client certificate of a request to its context
+//
+// Parameters:
+// - ctx: the context of the request
+//
+// Returns:
+// - context.Context: the context carrying the identity, unchanged if the client sent no certificate
+func withClientIdentity(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ctx
+	}
+	return externalfunctions.ContextWithClientIdentity(ctx, identityFromCertificate(tlsInfo.State.VerifiedChains[0][0]))
+}
+
+// clientIdentityInterceptor is a gRPC server interceptor that adds the identity of the client certificate to the context of the request
+//
+// Returns:
+// - grpc.UnaryServerInterceptor: a gRPC server interceptor
+func clientIdentityInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx = withClientIdentity(ctx)
+		logging.Log.Debugf(logContext(ctx), "gRPC call %s", info.FullMethod)
+		return handler(ctx, req)
+	}
+}
+
+// clientIdentityStreamInterceptor is the stream counterpart of clientIdentityInterceptor
+//
+// Returns:
+// - grpc.StreamServerInterceptor: a gRPC server interceptor
+func clientIdentityStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := withClientIdentity(stream.Context())
+		logging.Log.Debugf(logContext(ctx), "gRPC stream %s", info.FullMethod)
+		return handler(srv, &contextServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// contextServerStream is a server stream with a replaced context
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream
+//
+// Returns:
+// - context.Context: the context of the stream
+func (stream *contextServerStream) Context() context.Context {
+	return stream.ctx
+}
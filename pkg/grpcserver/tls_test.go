@@ -0,0 +1,200 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package grpcserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPEM     []byte
+	keyPEM      []byte
+}
+
+func newTestCertificate(t *testing.T, template *x509.Certificate, parent *testCertificate) *testCertificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	parentCertificate, parentKey := template, key
+	if parent != nil {
+		parentCertificate, parentKey = parent.certificate, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCertificate, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPEM:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:      pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func writeTestFile(t *testing.T, path string, content []byte, modTime time.Time) {
+	t.Helper()
+	err := os.WriteFile(path, content, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chtimes(path, modTime, modTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCertificateStoreMutualTLS(t *testing.T) {
+	ca := newTestCertificate(t, &x509.Certificate{Subject: pkix.Name{CommonName: "test-ca"}, IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+	server := newTestCertificate(t, &x509.Certificate{Subject: pkix.Name{CommonName: "flowkit"}, DNSNames: []string{"flowkit"}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}, ca)
+	agentURI, _ := url.Parse("spiffe://cluster/aali-agent")
+	client := newTestCertificate(t, &x509.Certificate{Subject: pkix.Name{CommonName: "aali-agent"}, URIs: []*url.URL{agentURI}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}, ca)
+
+	dir := t.TempDir()
+	now := time.Now()
+	certFile, keyFile, caFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt")
+	writeTestFile(t, certFile, server.certPEM, now)
+	writeTestFile(t, keyFile, server.keyPEM, now)
+	writeTestFile(t, caFile, ca.certPEM, now)
+
+	store, err := newCertificateStore(certFile, keyFile, []string{caFile}, tls.RequireAndVerifyClientCert)
+	if err != nil {
+		t.Fatalf("newCertificateStore() error = %v", err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.certificate)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	// handshake returns the connection states seen by the server and the client
+	handshake := func(clientCertificates []tls.Certificate) (tls.ConnectionState, tls.ConnectionState, error) {
+		clientStates := make(chan tls.ConnectionState, 1)
+		go func() {
+			conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{RootCAs: roots, ServerName: "flowkit", Certificates: clientCertificates})
+			if err != nil {
+				clientStates <- tls.ConnectionState{}
+				return
+			}
+			defer conn.Close()
+			clientStates <- conn.ConnectionState()
+			_, _ = conn.Read(make([]byte, 1))
+		}()
+		serverConn, err := listener.Accept()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer serverConn.Close()
+		conn := tls.Server(serverConn, store.tlsConfig())
+		err = conn.Handshake()
+		return conn.ConnectionState(), <-clientStates, err
+	}
+
+	// a client without certificate is rejected
+	if _, _, err := handshake(nil); err == nil {
+		t.Error("handshake without client certificate succeeded")
+	}
+
+	// the identity of a verified client is added to the context
+	clientCertificate, err := tls.X509KeyPair(client.certPEM, client.keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, _, err := handshake([]tls.Certificate{clientCertificate})
+	if err != nil {
+		t.Fatalf("handshake() error = %v", err)
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	identity, ok := externalfunctions.ClientIdentityFromContext(withClientIdentity(ctx))
+	if !ok || identity.CommonName != "aali-agent" || !slices.Equal(identity.URIs, []string{"spiffe://cluster/aali-agent"}) {
+		t.Errorf("client identity = %+v, %v", identity, ok)
+	}
+
+	// a renewed server certificate is served after a reload
+	renewed := newTestCertificate(t, &x509.Certificate{Subject: pkix.Name{CommonName: "flowkit-renewed"}, DNSNames: []string{"flowkit"}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}, ca)
+	writeTestFile(t, certFile, renewed.certPEM, now.Add(time.Second))
+	writeTestFile(t, keyFile, renewed.keyPEM, now.Add(time.Second))
+	reloaded, err := store.reload()
+	if err != nil || !reloaded {
+		t.Fatalf("reload() = %v, %v", reloaded, err)
+	}
+	_, clientState, err := handshake([]tls.Certificate{clientCertificate})
+	if err != nil {
+		t.Fatalf("handshake() after reload error = %v", err)
+	}
+	if len(clientState.PeerCertificates) == 0 || clientState.PeerCertificates[0].Subject.CommonName != "flowkit-renewed" {
+		t.Errorf("server certificate not renewed: %v", clientState.PeerCertificates)
+	}
+}
+
+func TestParseClientAuth(t *testing.T) {
+	tests := map[string]tls.ClientAuthType{
+		"":         tls.RequireAndVerifyClientCert,
+		"require":  tls.RequireAndVerifyClientCert,
+		"optional": tls.VerifyClientCertIfGiven,
+	}
+	for value, want := range tests {
+		got, err := parseClientAuth(value)
+		if err != nil || got != want {
+			t.Errorf("parseClientAuth(%q) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+	if _, err := parseClientAuth("none"); err == nil {
+		t.Error("parseClientAuth(none) succeeded")
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package jobs runs functions in the background and keeps their status and results in a store,
+// so that long-running calls survive the disconnection of the client that submitted them.
+package jobs
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+)
+
+// Status is the state of a job
+type Status string
+
+// Job statuses
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+// Done checks whether a job with this status has ended
+//
+// Returns:
+//   - bool: true for succeeded, failed and cancelled jobs
+func (status Status) Done() bool {
+	return status == StatusSucceeded || status == StatusFailed || status == StatusCancelled
+}
+
+// Job is a function call running in the background
+type Job struct {
+	ID       string `json:"id"`
+	Function string `json:"function"`
+	// Owner is the name of the API key or the client certificate that submitted the job, empty without authentication
+	Owner     string    `json:"owner,omitempty"`
+	Status    Status    `json:"status"`
+	Submitted time.Time `json:"submitted"`
+	Started   time.Time `json:"started,omitzero"`
+	Ended     time.Time `json:"ended,omitzero"`
+	// Progress is the last progress reported by the function, if it reports any
+	Progress *externalfunctions.Progress `json:"progress,omitempty"`
+	Outputs  []Output                    `json:"outputs,omitempty"`
+	Error    *Error                      `json:"error,omitempty"`
+}
+
+// Output is an output of the function of a succeeded job
+type Output struct {
+	Name   string `json:"name"`
+	GoType string `json:"goType"`
+	Value  string `json:"value"`
+}
+
+// Error is the error of a failed job
+type Error struct {
+	// Code is the name of the gRPC status code of the error
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrNotFound is returned for jobs that are not in the store
+var ErrNotFound = errors.New("job not found")
+
+// Store keeps the jobs
+// Implementations are safe for concurrent use.
+type Store interface {
+	// Put creates or replaces a job
+	Put(job Job) error
+	// Get returns a job, or ErrNotFound
+	Get(id string) (Job, error)
+	// List returns all jobs, the most recently submitted first
+	List() ([]Job, error)
+	// Delete removes a job, deleting a missing job is not an error
+	Delete(id string) error
+	// Close releases the resources of the store
+	Close() error
+}
+
+// sortJobs sorts jobs from the most recently submitted to the oldest
+//
+// Parameters:
+//   - jobs: the jobs to sort
+func sortJobs(jobs []Job) {
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].Submitted.Equal(jobs[j].Submitted) {
+			return jobs[i].Submitted.After(jobs[j].Submitted)
+		}
+		return jobs[i].ID > jobs[j].ID
+	})
+}
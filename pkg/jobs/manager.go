@@ -0,0 +1,481 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pruneInterval is the interval at which the jobs past their retention are deleted
+const pruneInterval = time.Minute
+
+// progressInterval is the minimum interval at which the progress of a running job is written to the store,
+// the latest progress is kept in memory in between
+const progressInterval = time.Second
+
+// interruptedMessage is the error message of the jobs stopped with the server
+const interruptedMessage = "the server stopped before the job ended"
+
+// ErrClosed is returned for jobs submitted after the manager is closed
+var ErrClosed = errors.New("job manager is closed")
+
+// RunFunc runs the function of a job and returns its outputs
+// The context is cancelled when the job is cancelled or the manager is closed.
+// The progress passed to report is recorded in the job while it runs.
+type RunFunc func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error)
+
+// Manager runs the submitted jobs in the background and records their progress in a store
+type Manager struct {
+	store Store
+	// slots bounds the number of running jobs, nil if unbounded
+	slots   chan struct{}
+	mutex   sync.Mutex
+	cancels map[string]context.CancelFunc
+	running sync.WaitGroup
+	closed  bool
+	// progressMutex guards progress, it is never held while locking mutex
+	progressMutex sync.Mutex
+	progress      map[string]*jobProgress
+}
+
+// jobProgress is the latest progress reported by a running job
+type jobProgress struct {
+	latest externalfunctions.Progress
+	// stored is the time the progress was last written to the store
+	stored time.Time
+}
+
+// NewManager creates a job manager
+// The jobs of the store that were queued or running when the previous server stopped are marked as failed.
+//
+// Parameters:
+//   - store: the store of the jobs
+//   - maxRunning: the maximum number of jobs running at the same time, the others are queued; 0 for no limit
+//
+// Returns:
+//   - *Manager: the manager
+//   - error: an error if the store cannot be read
+func NewManager(store Store, maxRunning int) (*Manager, error) {
+	manager := &Manager{store: store, cancels: map[string]context.CancelFunc{}, progress: map[string]*jobProgress{}}
+	if maxRunning > 0 {
+		manager.slots = make(chan struct{}, maxRunning)
+	}
+
+	jobs, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		if job.Status.Done() {
+			continue
+		}
+		interrupt(&job)
+		err := store.Put(job)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return manager, nil
+}
+
+// Submit records a job and runs it in the background
+// The job outlives the request submitting it but keeps the values of its context,
+// such as the trace or the API key of the request.
+//
+// Parameters:
+//   - ctx: the context of the request submitting the job
+//   - function: the name of the function of the job
+//   - owner: the name of the API key submitting the job
+//   - run: runs the function
+//
+// Returns:
+//   - Job: the queued job
+//   - error: ErrClosed if the manager is closed, or an error if the job cannot be stored
+func (manager *Manager) Submit(ctx context.Context, function string, owner string, run RunFunc) (Job, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if manager.closed {
+		return Job{}, ErrClosed
+	}
+
+	job := Job{
+		ID:        uuid.NewString(),
+		Function:  function,
+		Owner:     owner,
+		Status:    StatusQueued,
+		Submitted: time.Now(),
+	}
+	err := manager.store.Put(job)
+	if err != nil {
+		return Job{}, err
+	}
+
+	jobCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	manager.cancels[job.ID] = cancel
+	manager.running.Add(1)
+	go manager.run(jobCtx, job.ID, run)
+	return job, nil
+}
+
+// Get returns a job
+// The progress of a running job is the latest one it reported, which may not be stored yet.
+//
+// Parameters:
+//   - id: the ID of the job
+//
+// Returns:
+//   - Job: the job
+//   - error: ErrNotFound if there is no job with this ID
+func (manager *Manager) Get(id string) (Job, error) {
+	job, err := manager.store.Get(id)
+	if err != nil {
+		return job, err
+	}
+	manager.withLatestProgress(&job)
+	return job, nil
+}
+
+// List returns all jobs, the most recently submitted first
+//
+// Returns:
+//   - []Job: the jobs
+//   - error: an error if the store cannot be read
+func (manager *Manager) List() ([]Job, error) {
+	jobs, err := manager.store.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		manager.withLatestProgress(&jobs[i])
+	}
+	return jobs, nil
+}
+
+// Cancel cancels a queued or running job
+// The job is marked as cancelled right away, its function is stopped through its context.
+// Cancelling an ended job does nothing.
+//
+// Parameters:
+//   - id: the ID of the job
+//
+// Returns:
+//   - Job: the job after the cancellation
+//   - error: ErrNotFound if there is no job with this ID
+func (manager *Manager) Cancel(id string) (Job, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	job, err := manager.store.Get(id)
+	if err != nil || job.Status.Done() {
+		return job, err
+	}
+
+	manager.withLatestProgress(&job)
+	job.Status = StatusCancelled
+	job.Ended = time.Now()
+	job.Error = &Error{Code: codes.Canceled.String(), Message: "the job was cancelled"}
+	err = manager.store.Put(job)
+	if err != nil {
+		return Job{}, err
+	}
+	if cancel, ok := manager.cancels[id]; ok {
+		cancel()
+	}
+	return job, nil
+}
+
+// Prune deletes the jobs that ended more than the retention ago
+//
+// Parameters:
+//   - retention: the time the ended jobs are kept
+//
+// Returns:
+//   - int: the number of deleted jobs
+//   - error: an error if the store cannot be read or updated
+func (manager *Manager) Prune(retention time.Duration) (int, error) {
+	jobs, err := manager.store.List()
+	if err != nil {
+		return 0, err
+	}
+	deadline := time.Now().Add(-retention)
+	deleted := 0
+	for _, job := range jobs {
+		if job.Status.Done() && job.Ended.Before(deadline) {
+			err := manager.store.Delete(job.ID)
+			if err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// RunPruning prunes the ended jobs every minute until the context is done
+//
+// Parameters:
+//   - ctx: the context stopping the pruning
+//   - retention: the time the ended jobs are kept
+func (manager *Manager) RunPruning(ctx context.Context, retention time.Duration) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		_, err := manager.Prune(retention)
+		if err != nil {
+			logging.Log.Errorf(&logging.ContextMap{}, "error pruning jobs: %v", err)
+		}
+	}
+}
+
+// Close stops the manager
+// No job can be submitted anymore, the queued and running jobs are marked as failed and cancelled.
+// The store is closed once they returned, or after the timeout.
+//
+// Parameters:
+//   - timeout: the time given to the cancelled functions to return
+//
+// Returns:
+//   - error: an error if the store cannot be closed
+func (manager *Manager) Close(timeout time.Duration) error {
+	manager.mutex.Lock()
+	manager.closed = true
+	for id, cancel := range manager.cancels {
+		job, err := manager.store.Get(id)
+		if err == nil && !job.Status.Done() {
+			manager.withLatestProgress(&job)
+			interrupt(&job)
+			err = manager.store.Put(job)
+		}
+		if err != nil {
+			logging.Log.Errorf(&logging.ContextMap{}, "error stopping job %s: %v", id, err)
+		}
+		cancel()
+	}
+	manager.mutex.Unlock()
+
+	returned := make(chan struct{})
+	go func() {
+		manager.running.Wait()
+		close(returned)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-returned:
+	case <-timer.C:
+		logging.Log.Warnf(&logging.ContextMap{}, "jobs still running %v after their cancellation", timeout)
+	}
+	return manager.store.Close()
+}
+
+// run runs a job once a slot is free and records its result
+//
+// Parameters:
+//   - ctx: the context of the job
+//   - id: the ID of the job
+//   - run: runs the function
+func (manager *Manager) run(ctx context.Context, id string, run RunFunc) {
+	defer manager.running.Done()
+	defer manager.release(id)
+
+	if manager.slots != nil {
+		select {
+		case manager.slots <- struct{}{}:
+			defer func() { <-manager.slots }()
+		case <-ctx.Done():
+			// cancelled while queued
+			return
+		}
+	}
+
+	started := manager.update(id, func(job *Job) bool {
+		if job.Status != StatusQueued {
+			return false
+		}
+		job.Status = StatusRunning
+		job.Started = time.Now()
+		return true
+	})
+	if !started {
+		return
+	}
+
+	report := func(progress externalfunctions.Progress) {
+		if !manager.recordProgress(id, progress) {
+			return
+		}
+		manager.update(id, func(job *Job) bool {
+			if job.Status != StatusRunning {
+				return false
+			}
+			job.Progress = &progress
+			return true
+		})
+	}
+	outputs, err := call(ctx, run, report)
+	manager.update(id, func(job *Job) bool {
+		// the job was cancelled or the manager closed while it was running
+		if job.Status.Done() {
+			return false
+		}
+		manager.withLatestProgress(job)
+		job.Ended = time.Now()
+		if err != nil {
+			grpcStatus := status.Convert(err)
+			job.Status = StatusFailed
+			job.Error = &Error{Code: grpcStatus.Code().String(), Message: grpcStatus.Message()}
+		} else {
+			job.Status = StatusSucceeded
+			job.Outputs = outputs
+		}
+		return true
+	})
+}
+
+// update changes a job in the store
+// Failures are logged, the job is left as it was.
+//
+// Parameters:
+//   - id: the ID of the job
+//   - change: changes the job, returns false to leave it unchanged
+//
+// Returns:
+//   - bool: true if the job was changed
+func (manager *Manager) update(id string, change func(job *Job) bool) bool {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	job, err := manager.store.Get(id)
+	if err != nil {
+		logging.Log.Errorf(&logging.ContextMap{}, "error reading job %s: %v", id, err)
+		return false
+	}
+	if !change(&job) {
+		return false
+	}
+	err = manager.store.Put(job)
+	if err != nil {
+		logging.Log.Errorf(&logging.ContextMap{}, "error updating job %s: %v", id, err)
+		return false
+	}
+	return true
+}
+
+// release forgets the context of an ended job
+//
+// Parameters:
+//   - id: the ID of the job
+func (manager *Manager) release(id string) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	if cancel, ok := manager.cancels[id]; ok {
+		cancel()
+		delete(manager.cancels, id)
+	}
+
+	manager.progressMutex.Lock()
+	defer manager.progressMutex.Unlock()
+	delete(manager.progress, id)
+}
+
+// recordProgress keeps the latest progress of a running job in memory
+//
+// Parameters:
+//   - id: the ID of the job
+//   - progress: the progress reported by the job
+//
+// Returns:
+//   - bool: true if the progress is due to be written to the store
+func (manager *Manager) recordProgress(id string, progress externalfunctions.Progress) bool {
+	manager.progressMutex.Lock()
+	defer manager.progressMutex.Unlock()
+	recorded, ok := manager.progress[id]
+	if !ok {
+		recorded = &jobProgress{}
+		manager.progress[id] = recorded
+	}
+	recorded.latest = progress
+	if time.Since(recorded.stored) < progressInterval {
+		return false
+	}
+	recorded.stored = time.Now()
+	return true
+}
+
+// withLatestProgress sets the progress of a job that has not ended to the latest one kept in memory
+//
+// Parameters:
+//   - job: the job
+func (manager *Manager) withLatestProgress(job *Job) {
+	if job.Status.Done() {
+		return
+	}
+	manager.progressMutex.Lock()
+	defer manager.progressMutex.Unlock()
+	if recorded, ok := manager.progress[job.ID]; ok {
+		progress := recorded.latest
+		job.Progress = &progress
+	}
+}
+
+// call runs the function of a job, a panic fails the job instead of the server
+//
+// Parameters:
+//   - ctx: the context of the job
+//   - run: runs the function
+//   - report: records the progress of the function
+//
+// Returns:
+//   - []Output: the outputs of the function
+//   - error: the error of the function
+func call(ctx context.Context, run RunFunc, report func(externalfunctions.Progress)) (outputs []Output, err error) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			err = status.Errorf(codes.Internal, "job panicked: %v", r)
+		}
+	}()
+	return run(ctx, report)
+}
+
+// interrupt marks a job stopped with the server as failed
+//
+// Parameters:
+//   - job: the job
+func interrupt(job *Job) {
+	job.Status = StatusFailed
+	job.Ended = time.Now()
+	job.Error = &Error{Code: codes.Aborted.String(), Message: interruptedMessage}
+}
@@ -0,0 +1,261 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+)
+
+// waitForStatus polls a job until it has the given status
+func waitForStatus(t *testing.T, manager *Manager, id string, want Status) Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := manager.Get(id)
+		if err != nil {
+			t.Fatalf("Get(%s) failed: %v", id, err)
+		}
+		if job.Status == want {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("job %s has status %s, want %s", id, job.Status, want)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestManagerRunsJobs(t *testing.T) {
+	manager, err := NewManager(NewMemoryStore(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Close(time.Second)
+
+	job, err := manager.Submit(context.Background(), "Succeeds", "ingestion", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		return []Output{{Name: "result", GoType: "string", Value: "done"}}, nil
+	})
+	if err != nil || job.Status != StatusQueued {
+		t.Fatalf("Submit() = %v, %v, want a queued job", job, err)
+	}
+	job = waitForStatus(t, manager, job.ID, StatusSucceeded)
+	if len(job.Outputs) != 1 || job.Outputs[0].Value != "done" || job.Owner != "ingestion" || job.Started.IsZero() || job.Ended.IsZero() {
+		t.Errorf("succeeded job = %+v", job)
+	}
+
+	job, _ = manager.Submit(context.Background(), "Fails", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		return nil, errors.New("failed")
+	})
+	job = waitForStatus(t, manager, job.ID, StatusFailed)
+	if job.Error == nil || job.Error.Code != "Unknown" || job.Error.Message != "failed" {
+		t.Errorf("failed job error = %+v", job.Error)
+	}
+
+	job, _ = manager.Submit(context.Background(), "Panics", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		panic("boom")
+	})
+	job = waitForStatus(t, manager, job.ID, StatusFailed)
+	if job.Error == nil || job.Error.Code != "Internal" {
+		t.Errorf("panicking job error = %+v", job.Error)
+	}
+
+	// the progress is recorded while the job runs
+	reported := make(chan struct{})
+	release := make(chan struct{})
+	job, _ = manager.Submit(context.Background(), "Reports", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		report(externalfunctions.Progress{Phase: "embeddings", Done: 1, Total: 4})
+		close(reported)
+		<-release
+		return nil, nil
+	})
+	<-reported
+	job = waitForStatus(t, manager, job.ID, StatusRunning)
+	if job.Progress == nil || job.Progress.Phase != "embeddings" || job.Progress.Done != 1 {
+		t.Errorf("running job progress = %+v", job.Progress)
+	}
+	close(release)
+	waitForStatus(t, manager, job.ID, StatusSucceeded)
+
+	// the job survives the cancellation of the request submitting it
+	ctx, cancel := context.WithCancel(context.Background())
+	release = make(chan struct{})
+	job, _ = manager.Submit(ctx, "Slow", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		<-release
+		return nil, ctx.Err()
+	})
+	cancel()
+	close(release)
+	waitForStatus(t, manager, job.ID, StatusSucceeded)
+}
+
+// countingStore counts the jobs written to a store
+type countingStore struct {
+	Store
+	puts atomic.Int32
+}
+
+func (store *countingStore) Put(job Job) error {
+	store.puts.Add(1)
+	return store.Store.Put(job)
+}
+
+func TestManagerThrottlesProgress(t *testing.T) {
+	store := &countingStore{Store: NewMemoryStore()}
+	manager, err := NewManager(store, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Close(time.Second)
+
+	reported := make(chan struct{})
+	release := make(chan struct{})
+	job, _ := manager.Submit(context.Background(), "Reports", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		for done := 1; done <= 1000; done++ {
+			report(externalfunctions.Progress{Phase: "embeddings", Done: done, Total: 1000})
+		}
+		close(reported)
+		<-release
+		return nil, nil
+	})
+	<-reported
+
+	// the job is stored when submitted, when started and with its first progress
+	if puts := store.puts.Load(); puts != 3 {
+		t.Errorf("store written %d times, want 3", puts)
+	}
+	job = waitForStatus(t, manager, job.ID, StatusRunning)
+	if job.Progress == nil || job.Progress.Done != 1000 {
+		t.Errorf("running job progress = %+v, want the latest one", job.Progress)
+	}
+	stored, _ := store.Get(job.ID)
+	if stored.Progress == nil || stored.Progress.Done != 1 {
+		t.Errorf("stored job progress = %+v, want the first one", stored.Progress)
+	}
+
+	// the latest progress is stored when the job ends
+	close(release)
+	waitForStatus(t, manager, job.ID, StatusSucceeded)
+	stored, _ = store.Get(job.ID)
+	if stored.Progress == nil || stored.Progress.Done != 1000 {
+		t.Errorf("ended job progress = %+v, want the latest one", stored.Progress)
+	}
+}
+
+func TestManagerCancel(t *testing.T) {
+	manager, err := NewManager(NewMemoryStore(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Close(time.Second)
+
+	stopped := make(chan struct{})
+	running, _ := manager.Submit(context.Background(), "Running", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		<-ctx.Done()
+		close(stopped)
+		return nil, ctx.Err()
+	})
+	waitForStatus(t, manager, running.ID, StatusRunning)
+
+	// the second job waits for the only slot
+	queued, _ := manager.Submit(context.Background(), "Queued", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		t.Error("cancelled queued job ran")
+		return nil, nil
+	})
+	time.Sleep(20 * time.Millisecond)
+	waitForStatus(t, manager, queued.ID, StatusQueued)
+
+	for _, id := range []string{queued.ID, running.ID} {
+		job, err := manager.Cancel(id)
+		if err != nil || job.Status != StatusCancelled {
+			t.Errorf("Cancel(%s) = %v, %v, want a cancelled job", id, job.Status, err)
+		}
+	}
+	<-stopped
+	time.Sleep(20 * time.Millisecond)
+	waitForStatus(t, manager, running.ID, StatusCancelled)
+
+	if _, err := manager.Cancel("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Cancel(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestBoltStoreSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.db")
+	store, err := NewBoltStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager, err := NewManager(store, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done, _ := manager.Submit(context.Background(), "Done", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		return []Output{{Name: "result", GoType: "string", Value: "kept"}}, nil
+	})
+	waitForStatus(t, manager, done.ID, StatusSucceeded)
+	running, _ := manager.Submit(context.Background(), "Running", "", func(ctx context.Context, report func(externalfunctions.Progress)) ([]Output, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	waitForStatus(t, manager, running.ID, StatusRunning)
+	err = manager.Close(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manager.Submit(context.Background(), "Late", "", nil); !errors.Is(err, ErrClosed) {
+		t.Errorf("Submit() after Close() error = %v, want ErrClosed", err)
+	}
+
+	store, err = NewBoltStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager, err = NewManager(store, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer manager.Close(time.Second)
+
+	jobs, err := manager.List()
+	if err != nil || len(jobs) != 2 {
+		t.Fatalf("List() = %v, %v, want 2 jobs", jobs, err)
+	}
+	if jobs[0].ID != running.ID || jobs[0].Status != StatusFailed || jobs[0].Error.Message != interruptedMessage {
+		t.Errorf("interrupted job = %+v", jobs[0])
+	}
+	if jobs[1].ID != done.ID || jobs[1].Outputs[0].Value != "kept" {
+		t.Errorf("succeeded job = %+v", jobs[1])
+	}
+
+	deleted, err := manager.Prune(0)
+	if err != nil || deleted != 2 {
+		t.Errorf("Prune(0) = %d, %v, want 2 deleted jobs", deleted, err)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// memoryStore keeps the jobs in memory, they are lost when the server stops
+type memoryStore struct {
+	mutex sync.RWMutex
+	jobs  map[string]Job
+}
+
+// NewMemoryStore creates a store keeping the jobs in memory
+//
+// Returns:
+//   - Store: the store
+func NewMemoryStore() Store {
+	return &memoryStore{jobs: map[string]Job{}}
+}
+
+// Put creates or replaces a job
+//
+// Parameters:
+//   - job: the job
+//
+// Returns:
+//   - error: always nil
+func (store *memoryStore) Put(job Job) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.jobs[job.ID] = job
+	return nil
+}
+
+// Get returns a job
+//
+// Parameters:
+//   - id: the ID of the job
+//
+// Returns:
+//   - Job: the job
+//   - error: ErrNotFound if there is no job with this ID
+func (store *memoryStore) Get(id string) (Job, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	job, ok := store.jobs[id]
+	if !ok {
+		return Job{}, ErrNotFound
+	}
+	return job, nil
+}
+
+// List returns all jobs, the most recently submitted first
+//
+// Returns:
+//   - []Job: the jobs
+//   - error: always nil
+func (store *memoryStore) List() ([]Job, error) {
+	store.mutex.RLock()
+	jobs := make([]Job, 0, len(store.jobs))
+	for _, job := range store.jobs {
+		jobs = append(jobs, job)
+	}
+	store.mutex.RUnlock()
+	sortJobs(jobs)
+	return jobs, nil
+}
+
+// Delete removes a job
+//
+// Parameters:
+//   - id: the ID of the job
+//
+// Returns:
+//   - error: always nil
+func (store *memoryStore) Delete(id string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.jobs, id)
+	return nil
+}
+
+// Close does nothing, the jobs are dropped with the store
+//
+// Returns:
+//   - error: always nil
+func (store *memoryStore) Close() error {
+	return nil
+}
+
+// jobsBucket is the bucket of the BoltDB file holding the jobs, keyed by ID and encoded as JSON
+var jobsBucket = []byte("jobs")
+
+// boltStore keeps the jobs in a local BoltDB file, they survive restarts of the server
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens or creates a BoltDB file keeping the jobs
+// The file is locked while the store is open, a second server cannot share it.
+//
+// Parameters:
+//   - path: the path of the file
+//
+// Returns:
+//   - Store: the store
+//   - error: an error if the file cannot be opened
+func NewBoltStore(path string) (Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error opening job store %s: %w", path, err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(jobsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error creating job store %s: %w", path, err)
+	}
+	return &boltStore{db: db}, nil
+}
+
+// Put creates or replaces a job
+//
+// Parameters:
+//   - job: the job
+//
+// Returns:
+//   - error: an error if the job cannot be written
+func (store *boltStore) Put(job Job) error {
+	encoded, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("error encoding job %s: %w", job.ID, err)
+	}
+	return store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(jobsBucket).Put([]byte(job.ID), encoded)
+	})
+}
+
+// Get returns a job
+//
+// Parameters:
+//   - id: the ID of the job
+//
+// Returns:
+//   - Job: the job
+//   - error: ErrNotFound if there is no job with this ID
+func (store *boltStore) Get(id string) (Job, error) {
+	job := Job{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		encoded := tx.Bucket(jobsBucket).Get([]byte(id))
+		if encoded == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(encoded, &job)
+	})
+	return job, err
+}
+
+// List returns all jobs, the most recently submitted first
+//
+// Returns:
+//   - []Job: the jobs
+//   - error: an error if the jobs cannot be read
+func (store *boltStore) List() ([]Job, error) {
+	jobs := []Job{}
+	err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(jobsBucket).ForEach(func(id []byte, encoded []byte) error {
+			job := Job{}
+			err := json.Unmarshal(encoded, &job)
+			if err != nil {
+				return fmt.Errorf("error decoding job %s: %w", id, err)
+			}
+			jobs = append(jobs, job)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortJobs(jobs)
+	return jobs, nil
+}
+
+// Delete removes a job
+//
+// Parameters:
+//   - id: the ID of the job
+//
+// Returns:
+//   - error: an error if the job cannot be deleted
+func (store *boltStore) Delete(id string) error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(jobsBucket).Delete([]byte(id))
+	})
+}
+
+// Close closes the BoltDB file
+//
+// Returns:
+//   - error: an error if the file cannot be closed
+func (store *boltStore) Close() error {
+	return store.db.Close()
+}
@@ -0,0 +1,246 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package jsonschema
+
+import (
+	"encoding"
+	"encoding/json"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema dialect of the generated schemas
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema object
+type Schema map[string]any
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	jsonMarshalerType   = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+)
+
+// Generator generates the JSON Schemas of Go types, following the encoding/json rules
+// Named struct, slice and map types are generated once in the definitions of the generator
+// and referenced with "$ref", which also handles recursive types.
+type Generator struct {
+	// EnumValues returns the allowed values of a named string type, nil if the type is not an enumeration
+	EnumValues func(t reflect.Type) []string
+
+	defs     map[string]Schema
+	defNames map[reflect.Type]string
+}
+
+// NewGenerator creates a generator without definitions
+//
+// Parameters:
+//   - enumValues: returns the allowed values of a named string type, may be nil
+//
+// Returns:
+//   - *Generator: the generator
+func NewGenerator(enumValues func(t reflect.Type) []string) *Generator {
+	return &Generator{
+		EnumValues: enumValues,
+		defs:       map[string]Schema{},
+		defNames:   map[reflect.Type]string{},
+	}
+}
+
+// Defs returns the definitions referenced by the generated schemas, to be sent as "$defs"
+//
+// Returns:
+//   - map[string]Schema: the definitions by name
+func (g *Generator) Defs() map[string]Schema {
+	return g.defs
+}
+
+// Schema generates the schema of a Go type
+// Channels are described by the schema of their elements, they are streamed one element at a time.
+//
+// Parameters:
+//   - t: the Go type
+//
+// Returns:
+//   - Schema: the schema of the type
+func (g *Generator) Schema(t reflect.Type) Schema {
+	// pointers and channels are described by their element type
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Chan {
+		t = t.Elem()
+	}
+
+	// types with a custom encoding cannot be walked
+	if t == timeType {
+		return Schema{"type": "string", "format": "date-time"}
+	}
+	if implementsAny(t, jsonMarshalerType, jsonUnmarshalerType) {
+		return Schema{}
+	}
+	if implementsAny(t, textMarshalerType) {
+		return Schema{"type": "string"}
+	}
+
+	// named composite types are shared through the definitions
+	if t.Name() != "" && (t.Kind() == reflect.Struct || t.Kind() == reflect.Slice || t.Kind() == reflect.Map) {
+		return Schema{"$ref": "#/$defs/" + g.define(t)}
+	}
+	return g.inline(t)
+}
+
+// define adds a named type to the definitions, if not done yet
+//
+// Parameters:
+//   - t: the named type
+//
+// Returns:
+//   - string: the name of the definition
+func (g *Generator) define(t reflect.Type) string {
+	if name, exists := g.defNames[t]; exists {
+		return name
+	}
+
+	// types of different packages may have the same name
+	name := t.Name()
+	if _, taken := g.defs[name]; taken {
+		name = path.Base(t.PkgPath()) + "." + t.Name()
+	}
+	g.defNames[t] = name
+	g.defs[name] = Schema{}
+
+	// the definition is registered before walking the type, so that recursive types reference it
+	g.defs[name] = g.inline(t)
+	return name
+}
+
+// inline generates the schema of a type without using the definitions for the type itself
+//
+// Parameters:
+//   - t: the Go type, not a pointer
+//
+// Returns:
+//   - Schema: the schema of the type
+func (g *Generator) inline(t reflect.Type) Schema {
+	switch t.Kind() {
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.String:
+		schema := Schema{"type": "string"}
+		if g.EnumValues != nil && t.Name() != "" {
+			if values := g.EnumValues(t); values != nil {
+				schema["enum"] = values
+			}
+		}
+		return schema
+	case reflect.Slice, reflect.Array:
+		// byte slices are encoded as base64 strings
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "contentEncoding": "base64"}
+		}
+		schema := Schema{"type": "array", "items": g.Schema(t.Elem())}
+		if t.Kind() == reflect.Array {
+			schema["minItems"] = t.Len()
+			schema["maxItems"] = t.Len()
+		}
+		return schema
+	case reflect.Map:
+		schema := Schema{"type": "object", "additionalProperties": g.Schema(t.Elem())}
+		// integer keys are encoded as strings
+		switch t.Key().Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			schema["propertyNames"] = Schema{"pattern": "^-?[0-9]+$"}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			schema["propertyNames"] = Schema{"pattern": "^[0-9]+$"}
+		}
+		return schema
+	case reflect.Struct:
+		properties := Schema{}
+		g.addFields(t, properties)
+		return Schema{"type": "object", "properties": properties}
+	}
+
+	// interfaces and functions accept any value
+	return Schema{}
+}
+
+// addFields adds the encoded fields of a struct to the properties of its schema
+// Embedded structs without a JSON name are flattened, as encoding/json does.
+//
+// Parameters:
+//   - t: the struct type
+//   - properties: the properties of the schema
+func (g *Generator) addFields(t reflect.Type, properties Schema) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			g.addFields(fieldType, properties)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		schema := g.Schema(field.Type)
+		if strings.Contains(options, "string") {
+			schema = Schema{"type": "string"}
+		}
+		properties[name] = schema
+	}
+}
+
+// implementsAny checks whether a type or its pointer implements one of the interfaces
+//
+// Parameters:
+//   - t: the type
+//   - interfaces: the interface types
+//
+// Returns:
+//   - bool: true if the type implements one of the interfaces
+func implementsAny(t reflect.Type, interfaces ...reflect.Type) bool {
+	for _, iface := range interfaces {
+		if t.Implements(iface) || reflect.PointerTo(t).Implements(iface) {
+			return true
+		}
+	}
+	return false
+}
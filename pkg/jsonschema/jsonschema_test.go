@@ -0,0 +1,78 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testRole string
+
+type testBase struct {
+	ID string `json:"id"`
+}
+
+type testNode struct {
+	testBase
+	Name     string            `json:"name"`
+	Role     testRole          `json:"role,omitempty"`
+	Count    int64             `json:"count,string"`
+	Scores   map[uint]float32  `json:"scores"`
+	Data     []byte            `json:"data"`
+	Children []*testNode       `json:"children"`
+	Labels   map[string]string `json:"-"`
+	internal string
+}
+
+func TestGeneratorSchema(t *testing.T) {
+	generator := NewGenerator(func(t reflect.Type) []string {
+		if t == reflect.TypeFor[testRole]() {
+			return []string{"user", "assistant"}
+		}
+		return nil
+	})
+
+	schema := generator.Schema(reflect.TypeFor[[]testNode]())
+	if !reflect.DeepEqual(schema, Schema{"type": "array", "items": Schema{"$ref": "#/$defs/testNode"}}) {
+		t.Errorf("Schema() = %v", schema)
+	}
+
+	got, err := json.Marshal(generator.Defs()["testNode"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"properties":{` +
+		`"children":{"items":{"$ref":"#/$defs/testNode"},"type":"array"},` +
+		`"count":{"type":"string"},` +
+		`"data":{"contentEncoding":"base64","type":"string"},` +
+		`"id":{"type":"string"},` +
+		`"name":{"type":"string"},` +
+		`"role":{"enum":["user","assistant"],"type":"string"},` +
+		`"scores":{"additionalProperties":{"type":"number"},"propertyNames":{"pattern":"^[0-9]+$"},"type":"object"}` +
+		`},"type":"object"}`
+	if string(got) != want {
+		t.Errorf("testNode definition =\n%s\nwant\n%s", got, want)
+	}
+}
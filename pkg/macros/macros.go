@@ -0,0 +1,335 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package macros registers the macro functions, sequences of existing functions defined in YAML files.
+//
+// A macro names its inputs and outputs and wires them to the steps of a pipeline, see package pipeline.
+// It is registered like the external functions, so that it is listed by ListFunctions and can be called
+// with RunFunction, SubmitFunction or in a pipeline. The types of its inputs and outputs are the types
+// of the inputs and outputs of the steps they are wired to.
+package macros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/pipeline"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+)
+
+// Category is the category of the macro functions
+const Category = "macros"
+
+// File is the content of a macro file
+type File struct {
+	Macros []Macro `yaml:"macros"`
+}
+
+// Macro is a function composed of calls to other functions
+// The steps reference the inputs of the macro as "inputs.name", and the outputs of the macro
+// reference the outputs of the steps as "step.output".
+type Macro struct {
+	Name        string          `yaml:"name"`
+	DisplayName string          `yaml:"displayName"`
+	Description string          `yaml:"description"`
+	Inputs      []Input         `yaml:"inputs"`
+	Outputs     []Output        `yaml:"outputs"`
+	Steps       []pipeline.Step `yaml:"steps"`
+}
+
+// Input is an input of a macro
+type Input struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Required    bool   `yaml:"required"`
+	Default     string `yaml:"default"`
+}
+
+// Output is an output of a macro
+type Output struct {
+	Name        string `yaml:"name"`
+	From        string `yaml:"from"`
+	Description string `yaml:"description"`
+}
+
+// Load reads the macros of the YAML files of a directory and registers them
+// The files are read in the order of their names, and a macro can call the macros defined before it.
+//
+// Parameters:
+//   - dir: the directory of the macro files, with the extension .yaml or .yml
+//
+// Returns:
+//   - []string: the names of the registered macros
+//   - error: an error if a file cannot be read or a macro is invalid
+func Load(dir string) ([]string, error) {
+	macros, err := ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, macro := range macros {
+		err := Register(macro)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, macro.Name)
+	}
+	return names, nil
+}
+
+// ReadDir reads the macros of the YAML files of a directory
+//
+// Parameters:
+//   - dir: the directory of the macro files
+//
+// Returns:
+//   - []Macro: the macros, in the order of the files
+//   - error: an error if a file cannot be read or parsed
+func ReadDir(dir string) ([]Macro, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading macro directory: %w", err)
+	}
+
+	macros := []Macro{}
+	for _, entry := range entries {
+		extension := filepath.Ext(entry.Name())
+		if entry.IsDir() || (extension != ".yaml" && extension != ".yml") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading macro file: %w", err)
+		}
+		var file File
+		err = yaml.UnmarshalStrict(content, &file)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing macro file %s: %w", entry.Name(), err)
+		}
+		macros = append(macros, file.Macros...)
+	}
+	return macros, nil
+}
+
+// Register validates a macro and registers it with the available functions
+//
+// Parameters:
+//   - macro: the macro
+//
+// Returns:
+//   - error: an error if the name of the macro is taken or the macro is invalid
+func Register(macro Macro) error {
+	if macro.Name == "" {
+		return errors.New("macro without name")
+	}
+	if _, exists := internalstates.AvailableFunctions[macro.Name]; exists {
+		return fmt.Errorf("macro %s: a function with this name already exists", macro.Name)
+	}
+	if _, exists := externalfunctions.FunctionAdapters[macro.Name]; exists {
+		return fmt.Errorf("macro %s: a function with this name already exists", macro.Name)
+	}
+
+	definition, adapter, metadata, err := compile(macro)
+	if err != nil {
+		return fmt.Errorf("macro %s: %w", macro.Name, err)
+	}
+	internalstates.AvailableFunctions[macro.Name] = definition
+	externalfunctions.FunctionAdapters[macro.Name] = adapter
+	externalfunctions.FunctionsMetadata[macro.Name] = metadata
+	return nil
+}
+
+// compile validates a macro and creates its definition, adapter and metadata
+//
+// Parameters:
+//   - macro: the macro
+//
+// Returns:
+//   - *aaliflowkitgrpc.FunctionDefinition: the definition of the macro
+//   - externalfunctions.FunctionAdapter: the adapter running the steps of the macro
+//   - externalfunctions.FunctionMetadata: the documentation of the inputs and outputs
+//   - error: an error if the macro is invalid
+func compile(macro Macro) (*aaliflowkitgrpc.FunctionDefinition, externalfunctions.FunctionAdapter, externalfunctions.FunctionMetadata, error) {
+	request := pipeline.Pipeline{Steps: macro.Steps}
+	for _, input := range macro.Inputs {
+		if input.Name == "" || slices.Contains(request.Inputs, input.Name) {
+			return nil, externalfunctions.FunctionAdapter{}, externalfunctions.FunctionMetadata{}, fmt.Errorf("input name %q is empty or used more than once", input.Name)
+		}
+		request.Inputs = append(request.Inputs, input.Name)
+	}
+	outputNames := []string{}
+	for _, output := range macro.Outputs {
+		if output.Name == "" || slices.Contains(outputNames, output.Name) {
+			return nil, externalfunctions.FunctionAdapter{}, externalfunctions.FunctionMetadata{}, fmt.Errorf("output name %q is empty or used more than once", output.Name)
+		}
+		outputNames = append(outputNames, output.Name)
+		request.Outputs = append(request.Outputs, output.From)
+	}
+	plan, err := pipeline.Compile(request, lookup)
+	if err != nil {
+		return nil, externalfunctions.FunctionAdapter{}, externalfunctions.FunctionMetadata{}, err
+	}
+
+	definition := &aaliflowkitgrpc.FunctionDefinition{
+		Name:        macro.Name,
+		DisplayName: macro.DisplayName,
+		Description: macro.Description,
+		Category:    Category,
+	}
+	metadata := externalfunctions.FunctionMetadata{}
+	inputs := []externalfunctions.AdapterParameter{}
+	outputs := []externalfunctions.AdapterParameter{}
+
+	// the inputs have the type of the step inputs they are passed to
+	for _, input := range macro.Inputs {
+		param, inputDefinition, err := inputType(macro, input.Name)
+		if err != nil {
+			return nil, externalfunctions.FunctionAdapter{}, externalfunctions.FunctionMetadata{}, err
+		}
+		param.Name, param.Required, param.Default = input.Name, input.Required, input.Default
+		inputs = append(inputs, param)
+		definition.Input = append(definition.Input, &aaliflowkitgrpc.FunctionInputDefinition{
+			Name:    input.Name,
+			Type:    inputDefinition.Type,
+			GoType:  inputDefinition.GoType,
+			Options: inputDefinition.Options,
+		})
+		metadata.Inputs = append(metadata.Inputs, externalfunctions.ParameterMetadata{
+			Name:        input.Name,
+			Description: input.Description,
+			Required:    input.Required,
+			Default:     input.Default,
+		})
+	}
+
+	// the outputs have the type of the step outputs they reference
+	for _, output := range macro.Outputs {
+		stepID, outputName, _ := strings.Cut(output.From, ".")
+		stepIndex := slices.IndexFunc(macro.Steps, func(step pipeline.Step) bool { return step.ID == stepID })
+		if stepIndex < 0 {
+			return nil, externalfunctions.FunctionAdapter{}, externalfunctions.FunctionMetadata{}, fmt.Errorf("output %q does not reference the output of a step: %q", output.Name, output.From)
+		}
+		step := macro.Steps[stepIndex]
+		adapter := externalfunctions.FunctionAdapters[step.Function]
+		index := slices.IndexFunc(adapter.Outputs, func(param externalfunctions.AdapterParameter) bool { return param.Name == outputName })
+		if index < 0 {
+			return nil, externalfunctions.FunctionAdapter{}, externalfunctions.FunctionMetadata{}, fmt.Errorf("output %q references the unknown output %q of function %q", output.Name, outputName, step.Function)
+		}
+		param := adapter.Outputs[index]
+		param.Name = output.Name
+		outputs = append(outputs, param)
+		definition.Output = append(definition.Output, &aaliflowkitgrpc.FunctionOutputDefinition{
+			Name:   output.Name,
+			Type:   internalstates.AvailableFunctions[step.Function].Output[index].Type,
+			GoType: param.GoType,
+		})
+		metadata.Outputs = append(metadata.Outputs, externalfunctions.ParameterMetadata{Name: output.Name, Description: output.Description})
+	}
+
+	adapter := externalfunctions.NewFunctionAdapter(macro.Name, inputs, outputs, func(ctx context.Context, decoded []any) []any {
+		values := map[string]any{}
+		for i, input := range inputs {
+			if decoded[i] != nil {
+				values[input.Name] = decoded[i]
+			}
+		}
+		// the steps are authorized like the steps of a pipeline, the category of the macro does not grant them
+		err := plan.Authorize(ctx)
+		if err != nil {
+			panic(externalfunctions.NewPermissionDeniedError("", "macro %s: %s", macro.Name, status.Convert(err).Message()))
+		}
+		result, err := plan.Run(ctx, macro.Name, values)
+		if err != nil {
+			panic(err)
+		}
+		for _, step := range result.Steps {
+			if step.Status == pipeline.StatusFailed {
+				panic(step.Err())
+			}
+		}
+		results := make([]any, len(outputs))
+		for i := range outputs {
+			results[i] = result.Outputs[i].Value
+		}
+		return results
+	})
+	return definition, adapter, metadata, nil
+}
+
+// inputType returns the type of an input of a macro, from the step inputs it is passed to
+//
+// Parameters:
+//   - macro: the macro
+//   - name: the name of the input
+//
+// Returns:
+//   - externalfunctions.AdapterParameter: the adapter parameter of a step input
+//   - *aaliflowkitgrpc.FunctionInputDefinition: the definition of the step input
+//   - error: an error if the input is not used or is passed to inputs of different types
+func inputType(macro Macro, name string) (externalfunctions.AdapterParameter, *aaliflowkitgrpc.FunctionInputDefinition, error) {
+	var param externalfunctions.AdapterParameter
+	var inputDefinition *aaliflowkitgrpc.FunctionInputDefinition
+	for _, step := range macro.Steps {
+		for stepInput, input := range step.Inputs {
+			if input.From != pipeline.InputsID+"."+name {
+				continue
+			}
+			adapter := externalfunctions.FunctionAdapters[step.Function]
+			index := slices.IndexFunc(adapter.Inputs, func(param externalfunctions.AdapterParameter) bool { return param.Name == stepInput })
+			if inputDefinition != nil && adapter.Inputs[index].GoType != param.GoType {
+				return param, nil, fmt.Errorf("input %s is passed to inputs of types %s and %s", name, param.GoType, adapter.Inputs[index].GoType)
+			}
+			param = adapter.Inputs[index]
+			inputDefinition = internalstates.AvailableFunctions[step.Function].Input[index]
+		}
+	}
+	if inputDefinition == nil {
+		return param, nil, fmt.Errorf("input %s is not passed to any step", name)
+	}
+	return param, inputDefinition, nil
+}
+
+// lookup returns the adapter of an available function
+//
+// Parameters:
+//   - name: the name of the function
+//
+// Returns:
+//   - externalfunctions.FunctionAdapter: the adapter of the function
+//   - error: an error if the function does not exist
+func lookup(name string) (externalfunctions.FunctionAdapter, error) {
+	_, available := internalstates.AvailableFunctions[name]
+	adapter, exists := externalfunctions.FunctionAdapters[name]
+	if !available || !exists {
+		return externalfunctions.FunctionAdapter{}, fmt.Errorf("function %s not found", name)
+	}
+	return adapter, nil
+}
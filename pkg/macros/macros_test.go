@@ -0,0 +1,212 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package macros
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-flowkit/pkg/pipeline"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const wrapMacro = `
+macros:
+  - name: TestWrapJSON
+    description: wraps a JSON value in an object and extracts it again
+    inputs:
+      - name: value
+        required: true
+      - name: separator
+        default: ":"
+    outputs:
+      - name: extracted
+        from: extract.string
+      - name: json
+        from: concat.string
+    steps:
+      - id: concat
+        function: StringConcat
+        inputs:
+          a: {value: '{"k"'}
+          b: {from: inputs.value}
+          separator: {from: inputs.separator}
+      - id: extract
+        function: ExtractJSONStringField
+        inputs:
+          jsonStr: {from: concat.string}
+          keyPath: {value: k}
+`
+
+const nestedMacro = `
+macros:
+  - name: TestNested
+    inputs:
+      - name: value
+    outputs:
+      - name: output
+        from: assign.outputString
+    steps:
+      - id: wrap
+        function: TestWrapJSON
+        inputs:
+          value: {from: inputs.value}
+      - id: assign
+        function: AssignStringToString
+        inputs:
+          inputString: {from: wrap.extracted}
+`
+
+// withFunctions registers the external functions and removes the macros registered by the test
+func withFunctions(t *testing.T) {
+	previousFunctions := internalstates.AvailableFunctions
+	internalstates.AvailableFunctions = maps.Clone(externalfunctions.FunctionDefinitions)
+	t.Cleanup(func() {
+		for name, definition := range internalstates.AvailableFunctions {
+			if definition.Category == Category {
+				delete(externalfunctions.FunctionAdapters, name)
+				delete(externalfunctions.FunctionsMetadata, name)
+			}
+		}
+		internalstates.AvailableFunctions = previousFunctions
+	})
+}
+
+func TestLoad(t *testing.T) {
+	withFunctions(t)
+	dir := t.TempDir()
+	for name, content := range map[string]string{"01-wrap.yaml": wrapMacro, "02-nested.yml": nestedMacro, "README.md": "not a macro"} {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !slices.Equal(names, []string{"TestWrapJSON", "TestNested"}) {
+		t.Errorf("Load() = %v", names)
+	}
+
+	// the types of the inputs and outputs are the types of the steps
+	definition := internalstates.AvailableFunctions["TestWrapJSON"]
+	if definition.Category != Category || len(definition.Input) != 2 || definition.Input[0].GoType != "string" || len(definition.Output) != 2 {
+		t.Errorf("definition = %+v", definition)
+	}
+
+	adapter := externalfunctions.FunctionAdapters["TestWrapJSON"]
+	outputs, err := adapter.Call(context.Background(), []*aaliflowkitgrpc.FunctionInput{{Name: "value", Value: `"v"}`}})
+	if err != nil {
+		t.Fatalf("Call() error = %v", err)
+	}
+	if !slices.Equal(outputs, []any{"v", `{"k":"v"}`}) {
+		t.Errorf("TestWrapJSON() = %v", outputs)
+	}
+	_, err = adapter.Call(context.Background(), []*aaliflowkitgrpc.FunctionInput{})
+	if err == nil {
+		t.Error("Call() without the required input succeeded")
+	}
+
+	// macros call the macros defined before them and fail like their failing step
+	nested := externalfunctions.FunctionAdapters["TestNested"]
+	outputs, err = nested.Call(context.Background(), []*aaliflowkitgrpc.FunctionInput{{Name: "value", Value: `"x"}`}})
+	if err != nil || !slices.Equal(outputs, []any{"x"}) {
+		t.Errorf("TestNested() = %v, %v", outputs, err)
+	}
+	func() {
+		defer func() {
+			err := externalfunctions.ErrorFromPanic(recover())
+			if err.Code != codes.InvalidArgument {
+				t.Errorf("TestNested() with invalid JSON error = %v, want an invalid input error", err)
+			}
+		}()
+		nested.Call(context.Background(), []*aaliflowkitgrpc.FunctionInput{{Name: "value", Value: "x"}})
+	}()
+
+	// the steps are authorized against the client, whatever the category of the macro
+	denyAssign := pipeline.ContextWithAuthorizer(context.Background(), func(ctx context.Context, function string) error {
+		if function == "AssignStringToString" {
+			return status.Errorf(codes.PermissionDenied, "function %s is not allowed", function)
+		}
+		return nil
+	})
+	if _, err := adapter.Call(denyAssign, []*aaliflowkitgrpc.FunctionInput{{Name: "value", Value: `"v"}`}}); err != nil {
+		t.Errorf("TestWrapJSON() with its steps allowed error = %v", err)
+	}
+	func() {
+		defer func() {
+			err := externalfunctions.ErrorFromPanic(recover())
+			if err.Code != codes.PermissionDenied {
+				t.Errorf("TestNested() with a step denied error = %v, want a permission denied error", err)
+			}
+		}()
+		nested.Call(denyAssign, []*aaliflowkitgrpc.FunctionInput{{Name: "value", Value: `"x"}`}})
+	}()
+}
+
+func TestRegisterInvalid(t *testing.T) {
+	withFunctions(t)
+	tests := []struct {
+		name  string
+		macro Macro
+	}{
+		{name: "existing name", macro: Macro{Name: "AssignStringToString"}},
+		{name: "unused input", macro: Macro{
+			Name:   "TestUnusedInput",
+			Inputs: []Input{{Name: "unused"}},
+			Steps:  []pipeline.Step{{ID: "assign", Function: "AssignStringToString"}},
+		}},
+		{name: "output wired to an input", macro: Macro{
+			Name:    "TestOutputWiredToInput",
+			Inputs:  []Input{{Name: "text"}},
+			Steps:   []pipeline.Step{{ID: "assign", Function: "AssignStringToString", Inputs: map[string]pipeline.Input{"inputString": {From: "inputs.text"}}}},
+			Outputs: []Output{{Name: "text", From: "inputs.text"}},
+		}},
+		{name: "unknown function", macro: Macro{
+			Name:  "TestUnknownFunction",
+			Steps: []pipeline.Step{{ID: "call", Function: "DoesNotExist"}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Register(tt.macro)
+			if err == nil {
+				t.Errorf("Register() succeeded")
+			}
+			if tt.macro.Name != "AssignStringToString" && internalstates.AvailableFunctions[tt.macro.Name] != nil {
+				t.Errorf("invalid macro registered")
+			}
+		})
+	}
+}
@@ -0,0 +1,572 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package pipeline runs a small DAG of function calls server-side.
+//
+// The outputs of the steps are wired to the inputs of the downstream steps by name and stay native Go
+// values between the steps, so that large vectors and database responses are not encoded on the way.
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
+	"github.com/ansys/aali-sharedtypes/pkg/aaliflowkitgrpc"
+	"github.com/ansys/aali-sharedtypes/pkg/typeconverters"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+)
+
+// InputsID is the reserved step id under which the steps reference the inputs of the pipeline
+const InputsID = "inputs"
+
+// Pipeline is a DAG of function calls
+// Outputs lists the outputs returned by the pipeline as "step.output" references; without them the
+// outputs of the steps that no other step depends on are returned. Inputs declares the inputs given
+// to Plan.Run, which the steps reference as "inputs.name"; they are only set by the macro functions.
+type Pipeline struct {
+	Steps   []Step   `json:"steps"`
+	Outputs []string `json:"outputs,omitempty"`
+	Inputs  []string `json:"-"`
+}
+
+// Step is a function call of a pipeline
+type Step struct {
+	ID       string           `json:"id" yaml:"id"`
+	Function string           `json:"function" yaml:"function"`
+	Inputs   map[string]Input `json:"inputs,omitempty" yaml:"inputs"`
+}
+
+// Input is an input of a step: either a value, encoded like the inputs of RunFunction, or the
+// output of another step referenced as "step.output"
+type Input struct {
+	Value *string `json:"value,omitempty" yaml:"value"`
+	From  string  `json:"from,omitempty" yaml:"from"`
+}
+
+// StepStatus is the state of a step after the pipeline ran
+type StepStatus string
+
+// Step statuses
+// A step is skipped when one of the steps it depends on did not succeed.
+const (
+	StatusSucceeded StepStatus = "succeeded"
+	StatusFailed    StepStatus = "failed"
+	StatusSkipped   StepStatus = "skipped"
+)
+
+// StepResult is the status and timing of a step
+type StepResult struct {
+	ID         string     `json:"id"`
+	Function   string     `json:"function"`
+	Status     StepStatus `json:"status"`
+	Started    time.Time  `json:"started,omitzero"`
+	DurationMs float64    `json:"durationMs"`
+	Error      *Error     `json:"error,omitempty"`
+
+	err error
+}
+
+// Err returns the error of a failed step
+//
+// Returns:
+//   - error: the error returned or raised by the function, nil if the step did not fail
+func (result StepResult) Err() error {
+	return result.err
+}
+
+// Error is the error of a failed step
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Value is an output returned by a pipeline
+type Value struct {
+	Ref    string
+	GoType string
+	Value  any
+
+	adapter externalfunctions.FunctionAdapter
+	index   int
+}
+
+// Encode encodes the value like the outputs of RunFunction
+//
+// Returns:
+//   - string: the string value of the output
+//   - error: an error if the output cannot be encoded
+func (value Value) Encode() (string, error) {
+	return value.adapter.EncodeOutput(value.index, value.Value)
+}
+
+// Result is the result of a pipeline run
+// Outputs holds the requested outputs of the steps that succeeded.
+type Result struct {
+	Outputs []Value
+	Steps   []StepResult
+}
+
+// Plan is a validated pipeline, ready to run
+type Plan struct {
+	pipeline Pipeline
+	adapters []externalfunctions.FunctionAdapter
+	// dependencies holds the indexes of the steps each step depends on
+	dependencies [][]int
+	outputs      []reference
+	// consumers holds the number of step inputs and requested outputs using each reference
+	consumers map[string]int
+}
+
+// reference is a resolved "step.output" reference
+// The references to the inputs of the pipeline have no step.
+type reference struct {
+	ref    string
+	step   int
+	output int
+	input  string
+}
+
+// Compile validates a pipeline
+// Step ids must be unique and must not contain dots, the functions must exist and have no stream
+// output, the inputs and references must exist and the steps must not depend on each other in a cycle.
+//
+// Parameters:
+//   - pipeline: the pipeline
+//   - lookup: returns the adapter of a function, or an error if the function does not exist
+//
+// Returns:
+//   - *Plan: the validated pipeline
+//   - error: an invalid input error listing every problem of the pipeline
+func Compile(pipeline Pipeline, lookup func(name string) (externalfunctions.FunctionAdapter, error)) (*Plan, error) {
+	violations := []externalfunctions.InputViolation{}
+	violate := func(input string, format string, args ...any) {
+		violations = append(violations, externalfunctions.InputViolation{Input: input, Description: fmt.Sprintf(format, args...)})
+	}
+
+	plan := &Plan{
+		pipeline:     pipeline,
+		adapters:     make([]externalfunctions.FunctionAdapter, len(pipeline.Steps)),
+		dependencies: make([][]int, len(pipeline.Steps)),
+		consumers:    map[string]int{},
+	}
+	if len(pipeline.Steps) == 0 {
+		violate("steps", "pipeline has no step")
+	}
+
+	// index the steps and look up their functions
+	stepIndexes := map[string]int{}
+	for i, step := range pipeline.Steps {
+		field := fmt.Sprintf("steps[%d]", i)
+		switch _, exists := stepIndexes[step.ID]; {
+		case step.ID == "" || strings.Contains(step.ID, "."):
+			violate(field+".id", "step id %q must be non-empty and must not contain dots", step.ID)
+		case step.ID == InputsID:
+			violate(field+".id", "step id %q is reserved for the inputs of the pipeline", step.ID)
+		case exists:
+			violate(field+".id", "step id %q is used more than once", step.ID)
+		default:
+			stepIndexes[step.ID] = i
+		}
+
+		adapter, err := lookup(step.Function)
+		if err != nil {
+			violate(field+".function", "function %q not found", step.Function)
+			continue
+		}
+		for _, output := range adapter.Outputs {
+			if output.IsStream() {
+				violate(field+".function", "function %q has a stream output", step.Function)
+			}
+		}
+		plan.adapters[i] = adapter
+	}
+
+	// resolve the references of the step inputs
+	// the inputs of the pipeline can only be passed to the steps
+	resolve := func(field string, ref string, pipelineInputs bool) (reference, bool) {
+		stepID, outputName, found := strings.Cut(ref, ".")
+		if pipelineInputs && stepID == InputsID && slices.Contains(pipeline.Inputs, outputName) {
+			return reference{ref: ref, step: -1, input: outputName}, true
+		}
+		index, exists := stepIndexes[stepID]
+		if !found || !exists {
+			violate(field, "reference %q does not name a step output", ref)
+			return reference{}, false
+		}
+		if plan.adapters[index].Name == "" {
+			return reference{}, false
+		}
+		output := slices.IndexFunc(plan.adapters[index].Outputs, func(output externalfunctions.AdapterParameter) bool {
+			return output.Name == outputName
+		})
+		if output < 0 {
+			violate(field, "function %q of step %q has no output %q", pipeline.Steps[index].Function, stepID, outputName)
+			return reference{}, false
+		}
+		return reference{ref: ref, step: index, output: output}, true
+	}
+	for i, step := range pipeline.Steps {
+		for _, name := range slices.Sorted(maps.Keys(step.Inputs)) {
+			input := step.Inputs[name]
+			field := fmt.Sprintf("steps[%d].inputs.%s", i, name)
+			if plan.adapters[i].Name != "" && !slices.ContainsFunc(plan.adapters[i].Inputs, func(param externalfunctions.AdapterParameter) bool {
+				return param.Name == name
+			}) {
+				violate(field, "function %q has no input %q", step.Function, name)
+			}
+			if (input.Value == nil) == (input.From == "") {
+				violate(field, "input must have either a value or a reference")
+				continue
+			}
+			if input.From == "" {
+				continue
+			}
+			ref, ok := resolve(field+".from", input.From, true)
+			if ok {
+				plan.consumers[ref.ref]++
+			}
+			if ok && ref.step >= 0 && !slices.Contains(plan.dependencies[i], ref.step) {
+				plan.dependencies[i] = append(plan.dependencies[i], ref.step)
+			}
+		}
+	}
+
+	// the requested outputs, or the outputs of the sink steps
+	if len(pipeline.Outputs) > 0 {
+		for i, ref := range pipeline.Outputs {
+			if resolved, ok := resolve(fmt.Sprintf("outputs[%d]", i), ref, false); ok {
+				plan.outputs = append(plan.outputs, resolved)
+				plan.consumers[resolved.ref]++
+			}
+		}
+	} else {
+		for i, step := range pipeline.Steps {
+			if slices.ContainsFunc(plan.dependencies, func(dependencies []int) bool { return slices.Contains(dependencies, i) }) {
+				continue
+			}
+			for j, output := range plan.adapters[i].Outputs {
+				plan.outputs = append(plan.outputs, reference{ref: step.ID + "." + output.Name, step: i, output: j})
+			}
+		}
+	}
+
+	if len(violations) == 0 && plan.cyclic() {
+		violate("steps", "steps depend on each other in a cycle")
+	}
+	if len(violations) > 0 {
+		return nil, externalfunctions.NewInvalidInputsError("", violations)
+	}
+	return plan, nil
+}
+
+// cyclic checks whether steps of the plan depend on each other in a cycle
+//
+// Returns:
+//   - bool: true if the dependencies of the steps are not a DAG
+func (plan *Plan) cyclic() bool {
+	remaining := make([]int, len(plan.dependencies))
+	for i, dependencies := range plan.dependencies {
+		remaining[i] = len(dependencies)
+	}
+
+	// remove the steps without remaining dependencies until none is left
+	removed := make([]bool, len(plan.dependencies))
+	for progress := true; progress; {
+		progress = false
+		for i := range plan.dependencies {
+			if removed[i] || remaining[i] > 0 {
+				continue
+			}
+			removed[i], progress = true, true
+			for j, dependencies := range plan.dependencies {
+				if slices.Contains(dependencies, i) {
+					remaining[j]--
+				}
+			}
+		}
+	}
+	return slices.Contains(removed, false)
+}
+
+// Functions returns the names of the functions called by the plan
+//
+// Returns:
+//   - []string: the function of every step, in the order of the steps
+func (plan *Plan) Functions() []string {
+	functions := make([]string, len(plan.pipeline.Steps))
+	for i, step := range plan.pipeline.Steps {
+		functions[i] = step.Function
+	}
+	return functions
+}
+
+// Authorizer checks whether the client of a request may call a function
+type Authorizer func(ctx context.Context, function string) error
+
+// authorizerContextKey is the context key of the Authorizer of a request
+type authorizerContextKey struct{}
+
+// ContextWithAuthorizer returns a context carrying the Authorizer of the client of a request
+// The functions that run a plan for a client, such as the macro functions, check its steps with it.
+//
+// Parameters:
+//   - ctx: the context of the request
+//   - authorize: the authorizer of the client
+//
+// Returns:
+//   - context.Context: the context carrying the authorizer
+func ContextWithAuthorizer(ctx context.Context, authorize Authorizer) context.Context {
+	return context.WithValue(ctx, authorizerContextKey{}, authorize)
+}
+
+// Authorize checks every function of the plan with the Authorizer of the context
+// Without Authorizer, every function is allowed.
+//
+// Parameters:
+//   - ctx: the context of the request
+//
+// Returns:
+//   - error: the error of the authorizer for the first function that is not allowed
+func (plan *Plan) Authorize(ctx context.Context) error {
+	authorize, ok := ctx.Value(authorizerContextKey{}).(Authorizer)
+	if !ok {
+		return nil
+	}
+	for _, function := range plan.Functions() {
+		err := authorize(ctx, function)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run runs the steps of the plan
+// Every step starts as soon as the steps it depends on have succeeded, and the steps that do not depend
+// on each other run concurrently. The failure of a step does not stop the other steps; the steps that
+// depend on it are skipped.
+//
+// Parameters:
+//   - ctx: the context of the run, passed to the functions
+//   - method: the name of the gRPC method running the pipeline, recorded in the metrics of the steps
+//   - inputs: the decoded inputs of the pipeline by name, missing inputs are not passed to the steps
+//
+// Returns:
+//   - *Result: the outputs and the status of every step
+//   - error: the context error if the run was cancelled or its deadline exceeded
+func (plan *Plan) Run(ctx context.Context, method string, inputs map[string]any) (*Result, error) {
+	steps := plan.pipeline.Steps
+	results := make([][]any, len(steps))
+	stepResults := make([]StepResult, len(steps))
+	done := make([]chan struct{}, len(steps))
+	for i := range steps {
+		done[i] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	for i := range steps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+			stepResults[i] = StepResult{ID: steps[i].ID, Function: steps[i].Function, Status: StatusSkipped}
+
+			// wait for the steps this step depends on; they have ended once their channel is closed
+			for _, dependency := range plan.dependencies[i] {
+				select {
+				case <-done[dependency]:
+				case <-ctx.Done():
+					return
+				}
+				if stepResults[dependency].Status != StatusSucceeded {
+					return
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+
+			stepResults[i].Started = time.Now()
+			outputs, err := plan.runStep(ctx, method, i, inputs, results)
+			stepResults[i].DurationMs = float64(time.Since(stepResults[i].Started).Microseconds()) / 1000
+			if err != nil {
+				stepResults[i].Status = StatusFailed
+				stepResults[i].Error = &Error{Code: status.Code(err).String(), Message: err.Error()}
+				stepResults[i].err = err
+				return
+			}
+			results[i] = outputs
+			stepResults[i].Status = StatusSucceeded
+		}()
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	result := &Result{Steps: stepResults, Outputs: []Value{}}
+	for _, ref := range plan.outputs {
+		if stepResults[ref.step].Status != StatusSucceeded {
+			continue
+		}
+		adapter := plan.adapters[ref.step]
+		result.Outputs = append(result.Outputs, Value{
+			Ref:     ref.ref,
+			GoType:  adapter.Outputs[ref.output].GoType,
+			Value:   results[ref.step][ref.output],
+			adapter: adapter,
+			index:   ref.output,
+		})
+	}
+	return result, nil
+}
+
+// runStep binds the inputs of a step and calls its function
+// The outputs of the upstream steps are passed as is when the function accepts their type and no other
+// consumer shares them, otherwise they are encoded and decoded like the inputs of RunFunction.
+//
+// Parameters:
+//   - ctx: the context of the run
+//   - method: the name of the gRPC method running the pipeline
+//   - index: the index of the step
+//   - pipelineInputs: the inputs of the pipeline
+//   - results: the outputs of the steps that have succeeded
+//
+// Returns:
+//   - []any: the outputs of the function
+//   - error: an error if the inputs are invalid or the function fails
+func (plan *Plan) runStep(ctx context.Context, method string, index int, pipelineInputs map[string]any, results [][]any) (outputs []any, err error) {
+	step := plan.pipeline.Steps[index]
+	adapter := plan.adapters[index]
+
+	start := time.Now()
+	ctx, span := telemetry.Tracer().Start(ctx, method+" "+step.Function,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("flowkit.function", step.Function),
+			attribute.String("flowkit.pipeline.step", step.ID),
+		),
+	)
+	defer func() {
+		telemetry.EndSpan(span, err)
+		telemetry.RecordFunctionCall(ctx, method, step.Function, start, status.Code(err))
+	}()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			if ctx.Err() != nil {
+				err = status.FromContextError(ctx.Err()).Err()
+				return
+			}
+			err = externalfunctions.ErrorFromPanic(r).WithFunction(step.Function)
+		}
+	}()
+
+	inputs := []*aaliflowkitgrpc.FunctionInput{}
+	values := map[string]any{}
+	for _, name := range slices.Sorted(maps.Keys(step.Inputs)) {
+		input := step.Inputs[name]
+		if input.Value != nil {
+			inputs = append(inputs, &aaliflowkitgrpc.FunctionInput{Name: name, Value: *input.Value})
+			continue
+		}
+
+		stepID, outputName, _ := strings.Cut(input.From, ".")
+		if stepID == InputsID {
+			value, given := pipelineInputs[outputName]
+			if !given || value == nil {
+				continue
+			}
+			if adapter.AcceptsValue(name, value) && plan.canShare(input.From, value) {
+				values[name] = value
+				continue
+			}
+			param := adapter.Inputs[slices.IndexFunc(adapter.Inputs, func(param externalfunctions.AdapterParameter) bool { return param.Name == name })]
+			encoded, err := typeconverters.ConvertGivenTypeToString(value, param.GoType)
+			if err != nil {
+				return nil, externalfunctions.NewInvalidInputError(name, "error converting input %s of the pipeline to type '%s': %v", outputName, param.GoType, err)
+			}
+			inputs = append(inputs, &aaliflowkitgrpc.FunctionInput{Name: name, Value: encoded})
+			continue
+		}
+		upstream := slices.IndexFunc(plan.pipeline.Steps, func(step Step) bool { return step.ID == stepID })
+		output := slices.IndexFunc(plan.adapters[upstream].Outputs, func(output externalfunctions.AdapterParameter) bool {
+			return output.Name == outputName
+		})
+		value := results[upstream][output]
+		if adapter.AcceptsValue(name, value) && plan.canShare(input.From, value) {
+			values[name] = value
+			continue
+		}
+		encoded, err := plan.adapters[upstream].EncodeOutput(output, value)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, &aaliflowkitgrpc.FunctionInput{Name: name, Value: encoded})
+	}
+
+	outputs, err = adapter.CallWithValues(ctx, inputs, values)
+	if err != nil {
+		return nil, err
+	}
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return outputs, nil
+}
+
+// canShare checks whether a value can be passed as is to a consumer of a reference
+// The steps run concurrently and functions may change their inputs, so maps, slices, pointers and
+// the values containing them are only passed as is to the single consumer of a reference.
+//
+// Parameters:
+//   - ref: the reference
+//   - value: the value of the reference
+//
+// Returns:
+//   - bool: true if the value is a scalar or the reference has a single consumer
+func (plan *Plan) canShare(ref string, value any) bool {
+	if plan.consumers[ref] <= 1 {
+		return true
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ansys/aali-flowkit/pkg/externalfunctions"
+	"google.golang.org/grpc/codes"
+)
+
+func lookup(name string) (externalfunctions.FunctionAdapter, error) {
+	adapter, ok := externalfunctions.FunctionAdapters[name]
+	if !ok {
+		return adapter, errors.New("not found")
+	}
+	return adapter, nil
+}
+
+func value(s string) Input {
+	return Input{Value: &s}
+}
+
+func TestRun(t *testing.T) {
+	plan, err := Compile(Pipeline{
+		Steps: []Step{
+			{ID: "concat", Function: "StringConcat", Inputs: map[string]Input{"a": value(`{"k":`), "b": value(`[1,2]}`), "separator": value("")}},
+			{ID: "format", Function: "StringFormat", Inputs: map[string]Input{"data": {From: "concat.string"}, "format": value("%q")}},
+			{ID: "field", Function: "ExtractJSONStringField", Inputs: map[string]Input{"jsonStr": {From: "concat.string"}, "keyPath": value("missing")}},
+			{ID: "assign", Function: "AssignStringToString", Inputs: map[string]Input{"inputString": {From: "field.string"}}},
+		},
+	}, lookup)
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	result, err := plan.Run(context.Background(), "RunPipeline", nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// the outputs of the sink steps that succeeded are returned
+	if len(result.Outputs) != 1 || result.Outputs[0].Ref != "format.string" || result.Outputs[0].Value != `"{\"k\":[1,2]}"` {
+		t.Fatalf("outputs = %+v", result.Outputs)
+	}
+	encoded, err := result.Outputs[0].Encode()
+	if err != nil || encoded != `"{\"k\":[1,2]}"` {
+		t.Errorf("Encode() = %q, %v", encoded, err)
+	}
+
+	statuses := []StepStatus{}
+	for _, step := range result.Steps {
+		statuses = append(statuses, step.Status)
+	}
+	want := []StepStatus{StatusSucceeded, StatusSucceeded, StatusFailed, StatusSkipped}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("statuses = %v, want %v", statuses, want)
+	}
+	if result.Steps[2].Error == nil || result.Steps[2].Error.Code != codes.NotFound.String() {
+		t.Errorf("error of the failed step = %+v", result.Steps[2].Error)
+	}
+}
+
+func TestRunCopiesSharedValues(t *testing.T) {
+	mapType := reflect.TypeOf(map[string]string{})
+	makeMap := externalfunctions.NewFunctionAdapter("MakeMap", nil,
+		[]externalfunctions.AdapterParameter{{Name: "m", GoType: "map[string]string", Type: mapType}},
+		func(ctx context.Context, inputs []any) []any {
+			return []any{map[string]string{"k": "v"}}
+		})
+	// setKey changes its input map, like many functions of the catalog
+	setKey := externalfunctions.NewFunctionAdapter("SetKey",
+		[]externalfunctions.AdapterParameter{
+			{Name: "m", GoType: "map[string]string", Type: mapType},
+			{Name: "key", GoType: "string", Type: reflect.TypeOf("")},
+		},
+		[]externalfunctions.AdapterParameter{{Name: "size", GoType: "int", Type: reflect.TypeOf(0)}},
+		func(ctx context.Context, inputs []any) []any {
+			m := inputs[0].(map[string]string)
+			for i := 0; i < 100; i++ {
+				m[inputs[1].(string)] = fmt.Sprint(i)
+			}
+			return []any{len(m)}
+		})
+	adapters := map[string]externalfunctions.FunctionAdapter{"MakeMap": makeMap, "SetKey": setKey}
+
+	plan, err := Compile(Pipeline{
+		Steps: []Step{
+			{ID: "make", Function: "MakeMap"},
+			{ID: "a", Function: "SetKey", Inputs: map[string]Input{"m": {From: "make.m"}, "key": value("a")}},
+			{ID: "b", Function: "SetKey", Inputs: map[string]Input{"m": {From: "make.m"}, "key": value("b")}},
+		},
+		Outputs: []string{"make.m", "a.size", "b.size"},
+	}, func(name string) (externalfunctions.FunctionAdapter, error) { return adapters[name], nil })
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	result, err := plan.Run(context.Background(), "RunPipeline", nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	// every consumer gets its own copy of the map
+	got := []any{}
+	for _, output := range result.Outputs {
+		got = append(got, output.Value)
+	}
+	want := []any{map[string]string{"k": "v"}, 2, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name       string
+		pipeline   Pipeline
+		violations []string
+	}{
+		{
+			name: "invalid steps",
+			pipeline: Pipeline{Steps: []Step{
+				{ID: "a", Function: "AssignStringToString", Inputs: map[string]Input{"inputString": value("x")}},
+				{ID: "a", Function: "Unknown"},
+				{ID: "b.c", Function: "AssignStringToString", Inputs: map[string]Input{"other": value("x"), "inputString": {}}},
+			}},
+			violations: []string{"steps[1].id", "steps[1].function", "steps[2].id", "steps[2].inputs.inputString", "steps[2].inputs.other"},
+		},
+		{
+			name: "invalid references",
+			pipeline: Pipeline{
+				Steps: []Step{
+					{ID: "a", Function: "AssignStringToString", Inputs: map[string]Input{"inputString": {From: "b.outputString"}}},
+					{ID: "c", Function: "AssignStringToString", Inputs: map[string]Input{"inputString": {From: "a.missing"}}},
+				},
+				Outputs: []string{"a"},
+			},
+			violations: []string{"steps[0].inputs.inputString.from", "steps[1].inputs.inputString.from", "outputs[0]"},
+		},
+		{
+			name: "cycle",
+			pipeline: Pipeline{Steps: []Step{
+				{ID: "a", Function: "AssignStringToString", Inputs: map[string]Input{"inputString": {From: "b.outputString"}}},
+				{ID: "b", Function: "AssignStringToString", Inputs: map[string]Input{"inputString": {From: "a.outputString"}}},
+			}},
+			violations: []string{"steps"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.pipeline, lookup)
+			var functionError *externalfunctions.FunctionError
+			if !errors.As(err, &functionError) || functionError.Code != codes.InvalidArgument {
+				t.Fatalf("Compile() error = %v, want an invalid input error", err)
+			}
+			inputs := []string{}
+			for _, violation := range functionError.Violations {
+				inputs = append(inputs, violation.Input)
+			}
+			if !reflect.DeepEqual(inputs, tt.violations) {
+				t.Errorf("violations = %v, want %v", inputs, tt.violations)
+			}
+		})
+	}
+}
@@ -0,0 +1,767 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"nhooyr.io/websocket"
+)
+
+// defaultPoolSize is the number of websocket connections kept per aali-llm endpoint,
+// it can be overwritten with the workflow config variable LLM_CONNECTION_POOL_SIZE
+const defaultPoolSize = 4
+
+// Codes of the error responses delivered by the client
+const (
+	// ErrorCodeRequest is used for requests that failed in aali-llm
+	ErrorCodeRequest = 4
+	// ErrorCodeConnection is used for requests that failed because the connection to aali-llm failed or was closed
+	ErrorCodeConnection = 5
+	// ErrorCodeAborted is used for requests whose context was done before the last response
+	ErrorCodeAborted = 6
+	// ErrorCodeTimeout is used for requests that exceeded the idle or the total timeout
+	ErrorCodeTimeout = 7
+)
+
+// defaultIdleTimeout is the default time a request waits for the next response of aali-llm,
+// it can be overwritten with the workflow config variable LLM_IDLE_TIMEOUT_SECONDS
+const defaultIdleTimeout = 5 * time.Minute
+
+// writeTimeout bounds the write of a request on a connection, the request context cannot be used
+// as a write cancelled mid-way closes the connection shared with other requests
+const writeTimeout = 30 * time.Second
+
+// requestBufferSize is the number of responses buffered per request between the
+// connection reader and the consumer of the response channel
+const requestBufferSize = 1024
+
+// endpointPool holds the long-lived connections to one aali-llm endpoint
+type endpointPool struct {
+	endpoint    string
+	mutex       sync.Mutex
+	connections []*connection
+	next        int
+	isShutdown  bool
+}
+
+// connection is a single websocket connection to aali-llm,
+// responses are routed to the pending requests by their InstructionGuid
+type connection struct {
+	conn      *websocket.Conn
+	mutex     sync.Mutex
+	writeLock chan struct{}
+	pending   map[string]*request
+	closed    bool
+}
+
+// request is a request waiting for responses on a connection
+type request struct {
+	ctx            context.Context
+	guid           string
+	singleResponse bool
+	queue          chan sharedtypes.HandlerResponse
+	failure        *sharedtypes.HandlerResponse
+}
+
+var (
+	poolsMutex   sync.Mutex
+	pools        = map[string]*endpointPool{}
+	isShutdown   bool
+	shutdownOnce sync.Once
+)
+
+// Send sends a request to aali-llm over a pooled connection
+// The responses for the request are delivered on the returned channel, which is closed after the last response.
+// Errors during the request are delivered as a response of type "error".
+// If ctx is done before the last response, the request is abandoned and the response channel is closed,
+// an error response is delivered first if the caller is still reading.
+//
+// Parameters:
+//   - ctx: the request context
+//   - llmHandlerEndpoint: the LLM Handler endpoint
+//   - handlerRequest: the request to send, its InstructionGuid must be unique
+//   - singleResponse: true if the request is answered with a single response (no streaming)
+//
+// Returns:
+//   - chan sharedtypes.HandlerResponse: the response channel
+//   - error: an error if the request could not be sent
+func Send(ctx context.Context, llmHandlerEndpoint string, handlerRequest sharedtypes.HandlerRequest, singleResponse bool) (chan sharedtypes.HandlerResponse, error) {
+	payload, err := json.Marshal(handlerRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request to aali-llm: %v", err)
+	}
+
+	// the span ends with the last response, see forward
+	ctx, span := telemetry.StartSpan(ctx, "aali-llm "+handlerRequest.Adapter,
+		attribute.String("server.address", llmHandlerEndpoint),
+		attribute.String("flowkit.llm.adapter", handlerRequest.Adapter),
+		attribute.String("flowkit.llm.instruction_guid", handlerRequest.InstructionGuid),
+	)
+
+	pool, err := getPool(llmHandlerEndpoint)
+	if err != nil {
+		telemetry.EndSpan(span, err)
+		return nil, err
+	}
+
+	// a connection can be closed between acquiring and registering, retry once on a fresh connection
+	var c *connection
+	var req *request
+	for attempt := 0; attempt < 2 && req == nil; attempt++ {
+		c, err = pool.acquire(ctx)
+		if err != nil {
+			telemetry.EndSpan(span, err)
+			return nil, err
+		}
+		req = c.register(ctx, handlerRequest.InstructionGuid, singleResponse)
+	}
+	if req == nil {
+		err = fmt.Errorf("failed to connect to aali-llm: connection closed")
+		telemetry.EndSpan(span, err)
+		return nil, err
+	}
+
+	responseChannel := make(chan sharedtypes.HandlerResponse)
+	go req.forward(c, responseChannel, span)
+
+	err = c.write(ctx, payload)
+	if err != nil && err != ctx.Err() {
+		// the write itself failed and the connection is broken, fail its pending requests so that it gets replaced
+		c.close(fmt.Sprintf("failed to write message to aali-llm: %v", err))
+	}
+
+	return responseChannel, nil
+}
+
+// Shutdown closes all connections to aali-llm and fails their pending requests
+// It is called by the gRPC server once in-flight calls are drained.
+// Further requests are rejected. Only the first call has an effect.
+func Shutdown() {
+	shutdownOnce.Do(func() {
+		poolsMutex.Lock()
+		defer poolsMutex.Unlock()
+
+		isShutdown = true
+		for _, pool := range pools {
+			pool.mutex.Lock()
+			pool.isShutdown = true
+			for _, c := range pool.connections {
+				if c != nil {
+					c.close("connection to aali-llm closed: shutting down")
+				}
+			}
+			pool.mutex.Unlock()
+		}
+		logging.Log.Debugf(&logging.ContextMap{}, "Closed all connections to aali-llm.")
+	})
+}
+
+// getPool returns the connection pool for the given endpoint, creating it if necessary
+//
+// Parameters:
+//   - llmHandlerEndpoint: the LLM Handler endpoint
+//
+// Returns:
+//   - *endpointPool: the connection pool
+//   - error: an error if the client is shut down
+func getPool(llmHandlerEndpoint string) (*endpointPool, error) {
+	poolsMutex.Lock()
+	defer poolsMutex.Unlock()
+
+	if isShutdown {
+		return nil, fmt.Errorf("failed to connect to aali-llm: client is shut down")
+	}
+
+	pool, exists := pools[llmHandlerEndpoint]
+	if !exists {
+		pool = &endpointPool{
+			endpoint:    llmHandlerEndpoint,
+			connections: make([]*connection, poolSize()),
+		}
+		pools[llmHandlerEndpoint] = pool
+	}
+	return pool, nil
+}
+
+// poolSize returns the number of connections per endpoint
+//
+// Returns:
+//   - int: the pool size
+func poolSize() int {
+	value, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["LLM_CONNECTION_POOL_SIZE"]
+	if !exists {
+		return defaultPoolSize
+	}
+	size, err := strconv.Atoi(value)
+	if err != nil || size < 1 {
+		logging.Log.Warnf(&logging.ContextMap{}, "Invalid LLM_CONNECTION_POOL_SIZE '%v', using %v connections.", value, defaultPoolSize)
+		return defaultPoolSize
+	}
+	return size
+}
+
+// acquire returns the next connection of the pool in round robin order
+// Connections that were closed or never opened are (re)connected. The connection is dialled
+// outside the lock of the pool, so a slow handshake does not block the requests on the other connections.
+//
+// Parameters:
+//   - ctx: the request context, used for the websocket handshake
+//
+// Returns:
+//   - *connection: the connection
+//   - error: an error if the connection could not be established
+func (pool *endpointPool) acquire(ctx context.Context) (*connection, error) {
+	pool.mutex.Lock()
+	slot := pool.next
+	pool.next = (pool.next + 1) % len(pool.connections)
+	c := pool.connections[slot]
+	pool.mutex.Unlock()
+
+	if c != nil && !c.isClosed() {
+		return c, nil
+	}
+	if c != nil {
+		logging.Log.Infof(&logging.ContextMap{}, "Reconnecting to aali-llm at %v.", pool.endpoint)
+	}
+
+	dialed, err := dial(ctx, pool.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if pool.isShutdown {
+		dialed.close("connection to aali-llm closed: shutting down")
+		return nil, fmt.Errorf("failed to connect to aali-llm: client is shut down")
+	}
+
+	// another request may have reconnected the slot while dialling
+	current := pool.connections[slot]
+	if current != c && current != nil && !current.isClosed() {
+		dialed.close("connection to aali-llm closed: slot already reconnected")
+		return current, nil
+	}
+	pool.connections[slot] = dialed
+	return dialed, nil
+}
+
+// dial opens and authenticates a new connection to aali-llm and starts its reader
+//
+// Parameters:
+//   - ctx: the request context, used for the websocket handshake
+//   - llmHandlerEndpoint: the LLM Handler endpoint
+//
+// Returns:
+//   - *connection: the connection
+//   - error: an error if the connection could not be established
+func dial(ctx context.Context, llmHandlerEndpoint string) (*connection, error) {
+	conn, _, err := websocket.Dial(ctx, llmHandlerEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to aali-llm: %v", err)
+	}
+	// Disable the read limit
+	conn.SetReadLimit(-1)
+
+	// Get API key
+	apiKey := config.GlobalConfig.LLM_API_KEY
+
+	// Legacy authentication
+	if apiKey == "" {
+		apiKey = "testkey"
+	}
+
+	// Send apikey for authentication
+	err = conn.Write(ctx, websocket.MessageText, []byte(apiKey))
+	if err != nil {
+		conn.Close(websocket.StatusInternalError, "authentication failed")
+		return nil, fmt.Errorf("failed to send authentication message to aali-llm: %v", err)
+	}
+
+	c := &connection{
+		conn:      conn,
+		writeLock: make(chan struct{}, 1),
+		pending:   map[string]*request{},
+	}
+	go c.read()
+
+	return c, nil
+}
+
+// write writes a request to the connection
+// The request context is only honoured while waiting for the other writes on the connection. The write itself
+// is bounded by writeTimeout, as cancelling it mid-way would close the connection for all its pending requests.
+//
+// Parameters:
+//   - ctx: the request context
+//   - payload: the request
+//
+// Returns:
+//   - error: an error if ctx was done before the write started or if the write failed
+func (c *connection) write(ctx context.Context, payload []byte) error {
+	select {
+	case c.writeLock <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-c.writeLock }()
+
+	writeCtx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+	return c.conn.Write(writeCtx, websocket.MessageBinary, payload)
+}
+
+// register adds a request to the pending requests of the connection
+//
+// Parameters:
+//   - ctx: the request context
+//   - guid: the InstructionGuid of the request
+//   - singleResponse: true if the request is answered with a single response
+//
+// Returns:
+//   - *request: the registered request, nil if the connection is closed
+func (c *connection) register(ctx context.Context, guid string, singleResponse bool) *request {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	req := &request{
+		ctx:            ctx,
+		guid:           guid,
+		singleResponse: singleResponse,
+		queue:          make(chan sharedtypes.HandlerResponse, requestBufferSize),
+	}
+	c.pending[guid] = req
+	return req
+}
+
+// read reads the responses of the connection and dispatches them until the connection fails
+func (c *connection) read() {
+	for {
+		typ, message, err := c.conn.Read(context.Background())
+		if err != nil {
+			c.close(fmt.Sprintf("failed to read message from aali-llm: %v", err))
+			return
+		}
+
+		if typ != websocket.MessageText && typ != websocket.MessageBinary {
+			logging.Log.Warnf(&logging.ContextMap{}, "Response with unsupported message type '%v'received from aali-llm. Ignoring...\n", typ)
+			continue
+		}
+
+		var response sharedtypes.HandlerResponse
+		err = json.Unmarshal(message, &response)
+		if err != nil {
+			// Check if it is the authentication message
+			if string(message) == "authentication successful" {
+				logging.Log.Debugf(&logging.ContextMap{}, "Authentication to LLM was successful.")
+			} else {
+				logging.Log.Errorf(&logging.ContextMap{}, "failed to unmarshal message from aali-llm: %v", err)
+			}
+			continue
+		}
+
+		c.dispatch(response)
+	}
+}
+
+// dispatch routes a response to the pending request with the same InstructionGuid
+// The optional fields of the response may be missing, they are checked as a panic would stop the reader.
+//
+// Parameters:
+//   - response: the response received from aali-llm
+func (c *connection) dispatch(response sharedtypes.HandlerResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	req, exists := c.pending[response.InstructionGuid]
+	if !exists {
+		if response.Type == "error" && response.InstructionGuid == "" {
+			// errors that cannot be attributed to a request concern the whole connection
+			errMessage := fmt.Sprintf("error from aali-llm: %v", errorDetails(response))
+			logging.Log.Error(&logging.ContextMap{}, errMessage)
+			for _, pendingReq := range c.pending {
+				c.finish(pendingReq, errorResponse(pendingReq.guid, ErrorCodeRequest, errMessage))
+			}
+			return
+		}
+		logging.Log.Debugf(&logging.ContextMap{}, "Ignoring response from aali-llm for unknown request %v.", response.InstructionGuid)
+		return
+	}
+
+	switch response.Type {
+	case "error":
+		errMessage := fmt.Sprintf("error in request %v: %v\n", response.InstructionGuid, errorDetails(response))
+		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		c.finish(req, errorResponse(req.guid, ErrorCodeRequest, errMessage))
+		return
+	case "info":
+		if response.InfoMessage != nil {
+			logging.Log.Infof(&logging.ContextMap{}, "Info %v: %v\n", response.InstructionGuid, *response.InfoMessage)
+		}
+		return
+	case "chat":
+		if !c.deliver(req, response) {
+			return
+		}
+		if req.singleResponse || (response.IsLast != nil && *response.IsLast) {
+			logging.Log.Debugf(&logging.ContextMap{}, "Chat response completely received from aali-llm.")
+			c.finish(req, nil)
+		}
+	case "embeddings":
+		logging.Log.Debugf(&logging.ContextMap{}, "Embeddings received from aali-llm.")
+		if c.deliver(req, response) {
+			c.finish(req, nil)
+		}
+	default:
+		logging.Log.Warn(&logging.ContextMap{}, "Response with unsupported value for 'Type' property received from aali-llm. Ignoring...")
+		if c.deliver(req, response) {
+			c.finish(req, nil)
+		}
+	}
+}
+
+// errorDetails describes the error of an error response, which may lack its error details
+//
+// Parameters:
+//   - response: the error response
+//
+// Returns:
+//   - string: the code and the message of the error
+func errorDetails(response sharedtypes.HandlerResponse) string {
+	if response.Error == nil {
+		return "no error details"
+	}
+	return fmt.Sprintf("%v (%v)", response.Error.Code, response.Error.Message)
+}
+
+// deliver queues a response for a request without blocking the reader
+// The connection mutex must be held.
+//
+// Parameters:
+//   - req: the request
+//   - response: the response to queue
+//
+// Returns:
+//   - bool: false if the request buffer was full and the request was failed
+func (c *connection) deliver(req *request, response sharedtypes.HandlerResponse) bool {
+	select {
+	case req.queue <- response:
+		return true
+	default:
+		errMessage := fmt.Sprintf("response buffer of request %v is full, the consumer is too slow", req.guid)
+		logging.Log.Error(&logging.ContextMap{}, errMessage)
+		c.finish(req, errorResponse(req.guid, ErrorCodeRequest, errMessage))
+		return false
+	}
+}
+
+// finish removes a request from the pending requests and closes its queue
+// The connection mutex must be held.
+//
+// Parameters:
+//   - req: the request
+//   - failure: the error response delivered after the queued responses, nil if the request succeeded
+func (c *connection) finish(req *request, failure *sharedtypes.HandlerResponse) {
+	if _, exists := c.pending[req.guid]; !exists {
+		return
+	}
+	delete(c.pending, req.guid)
+	req.failure = failure
+	close(req.queue)
+}
+
+// remove removes a request from the pending requests, used when the consumer gave up
+//
+// Parameters:
+//   - req: the request
+func (c *connection) remove(req *request) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.finish(req, nil)
+}
+
+// close closes the connection and fails all its pending requests
+//
+// Parameters:
+//   - errMessage: the error message delivered to the pending requests
+func (c *connection) close(errMessage string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.closed {
+		return
+	}
+	c.closed = true
+
+	if len(c.pending) > 0 {
+		logging.Log.Error(&logging.ContextMap{}, errMessage)
+	}
+	for _, req := range c.pending {
+		c.finish(req, errorResponse(req.guid, ErrorCodeConnection, errMessage))
+	}
+
+	c.conn.Close(websocket.StatusNormalClosure, "Normal Closure")
+}
+
+// isClosed checks whether the connection was closed
+//
+// Returns:
+//   - bool: true if the connection is closed
+func (c *connection) isClosed() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.closed
+}
+
+// forward passes the queued responses of a request to the response channel and closes it afterwards
+// The span of the request is ended with the last response. The request fails with a timeout error if
+// aali-llm sends no response for the idle timeout, or if it does not end within the total timeout.
+// The timeouts also apply while a response waits for the consumer, so a consumer that stops reading
+// without cancelling its context does not keep the request registered forever.
+//
+// Parameters:
+//   - c: the connection the request is pending on
+//   - responseChannel: the response channel returned to the caller
+//   - span: the span of the request
+func (req *request) forward(c *connection, responseChannel chan sharedtypes.HandlerResponse, span trace.Span) {
+	var failure error
+	defer func() { telemetry.EndSpan(span, failure) }()
+	defer close(responseChannel)
+
+	idleTimeout := timeoutVariable("LLM_IDLE_TIMEOUT_SECONDS", defaultIdleTimeout)
+	idle := newTimeout(idleTimeout)
+	defer idle.stop()
+	totalTimeout := timeoutVariable("LLM_TOTAL_TIMEOUT_SECONDS", 0)
+	total := newTimeout(totalTimeout)
+	defer total.stop()
+
+	for {
+		select {
+		case <-idle.expired():
+			failure = req.timeout(c, responseChannel, fmt.Sprintf("no response from aali-llm for %v", idleTimeout), true)
+			return
+		case <-total.expired():
+			failure = req.timeout(c, responseChannel, fmt.Sprintf("request to aali-llm did not end within %v", totalTimeout), true)
+			return
+		case response, open := <-req.queue:
+			if !open {
+				if req.failure != nil {
+					failure = responseError(*req.failure)
+					select {
+					case responseChannel <- *req.failure:
+					case <-req.ctx.Done():
+					}
+				}
+				return
+			}
+			if err := responseError(response); err != nil {
+				failure = err
+			}
+			select {
+			case responseChannel <- response:
+			case <-idle.expired():
+				failure = req.timeout(c, responseChannel, fmt.Sprintf("response not read for %v", idleTimeout), false)
+				return
+			case <-total.expired():
+				failure = req.timeout(c, responseChannel, fmt.Sprintf("request to aali-llm did not end within %v", totalTimeout), false)
+				return
+			case <-req.ctx.Done():
+				failure = req.ctx.Err()
+				req.abort(c, responseChannel)
+				return
+			}
+			idle.reset(idleTimeout)
+		case <-req.ctx.Done():
+			failure = req.ctx.Err()
+			req.abort(c, responseChannel)
+			return
+		}
+	}
+}
+
+// responseError returns the error carried by an error response
+//
+// Parameters:
+//   - response: the response
+//
+// Returns:
+//   - error: the error, nil if the response is not an error
+func responseError(response sharedtypes.HandlerResponse) error {
+	if response.Type != "error" {
+		return nil
+	}
+	if response.Error == nil {
+		return fmt.Errorf("aali-llm returned an error")
+	}
+	return fmt.Errorf("aali-llm error %d: %s", response.Error.Code, response.Error.Message)
+}
+
+// abort deregisters a request whose context is done and reports it to the consumer
+// The error is only delivered if the consumer is still waiting on the response channel, a consumer whose
+// context is done usually stopped reading. The response channel is closed by forward in both cases.
+//
+// Parameters:
+//   - c: the connection the request is pending on
+//   - responseChannel: the response channel returned to the caller
+func (req *request) abort(c *connection, responseChannel chan sharedtypes.HandlerResponse) {
+	c.remove(req)
+	errMessage := fmt.Sprintf("request to aali-llm aborted: %v", req.ctx.Err())
+	logging.Log.Error(&logging.ContextMap{}, errMessage)
+	select {
+	case responseChannel <- *errorResponse(req.guid, ErrorCodeAborted, errMessage):
+	default:
+	}
+}
+
+// timeout deregisters a request that timed out and reports it to the consumer
+//
+// Parameters:
+//   - c: the connection the request is pending on
+//   - responseChannel: the response channel returned to the caller
+//   - errMessage: the error message
+//   - isReading: false if the consumer stopped reading, the error is then only delivered if it is waiting for it
+//
+// Returns:
+//   - error: the error, for the span of the request
+func (req *request) timeout(c *connection, responseChannel chan sharedtypes.HandlerResponse, errMessage string, isReading bool) error {
+	c.remove(req)
+	logging.Log.Errorf(&logging.ContextMap{}, "Request %v timed out: %v", req.guid, errMessage)
+	response := errorResponse(req.guid, ErrorCodeTimeout, errMessage)
+	if isReading {
+		select {
+		case responseChannel <- *response:
+		case <-req.ctx.Done():
+		}
+	} else {
+		select {
+		case responseChannel <- *response:
+		default:
+		}
+	}
+	return responseError(*response)
+}
+
+// requestTimeout is an optional timer bounding a request
+type requestTimeout struct {
+	timer *time.Timer
+}
+
+// newTimeout starts a timeout
+//
+// Parameters:
+//   - duration: the duration of the timeout, 0 for no timeout
+//
+// Returns:
+//   - requestTimeout: the timeout
+func newTimeout(duration time.Duration) requestTimeout {
+	if duration <= 0 {
+		return requestTimeout{}
+	}
+	return requestTimeout{timer: time.NewTimer(duration)}
+}
+
+// expired returns the channel receiving once the timeout expired
+//
+// Returns:
+//   - <-chan time.Time: the channel, nil without timeout
+func (timeout requestTimeout) expired() <-chan time.Time {
+	if timeout.timer == nil {
+		return nil
+	}
+	return timeout.timer.C
+}
+
+// reset restarts the timeout
+//
+// Parameters:
+//   - duration: the duration of the timeout
+func (timeout requestTimeout) reset(duration time.Duration) {
+	if timeout.timer != nil {
+		timeout.timer.Reset(duration)
+	}
+}
+
+// stop stops the timeout
+func (timeout requestTimeout) stop() {
+	if timeout.timer != nil {
+		timeout.timer.Stop()
+	}
+}
+
+// timeoutVariable returns a timeout set in seconds in a workflow config variable
+//
+// Parameters:
+//   - variable: the name of the variable, fractions of seconds are allowed and 0 disables the timeout
+//   - defaultValue: the timeout if the variable is not set or invalid
+//
+// Returns:
+//   - time.Duration: the timeout, 0 for no timeout
+func timeoutVariable(variable string, defaultValue time.Duration) time.Duration {
+	value, exists := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES[variable]
+	if !exists {
+		return defaultValue
+	}
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || seconds < 0 {
+		logging.Log.Warnf(&logging.ContextMap{}, "Invalid %v '%v', using %v.", variable, value, defaultValue)
+		return defaultValue
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// errorResponse creates an error response for a request
+//
+// Parameters:
+//   - guid: the InstructionGuid of the request
+//   - code: the error code, one of the ErrorCode constants
+//   - errMessage: the error message
+//
+// Returns:
+//   - *sharedtypes.HandlerResponse: the error response
+func errorResponse(guid string, code int, errMessage string) *sharedtypes.HandlerResponse {
+	return &sharedtypes.HandlerResponse{
+		InstructionGuid: guid,
+		Type:            "error",
+		Error: &sharedtypes.ErrorResponse{
+			Code:    code,
+			Message: errMessage,
+		},
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package llmclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
+	"nhooyr.io/websocket"
+)
+
+// newTestServer starts a websocket server that answers every chat request with two chunks,
+// fails every request whose data is "fail", stalls after the first chunk of requests whose data is "stall"
+// and sends frames without their optional fields to requests whose data is "sparse"
+func newTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(testHandler(t))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testHandler is the websocket handler of newTestServer
+func testHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+		ctx := context.Background()
+
+		// api key
+		if _, _, err := conn.Read(ctx); err != nil {
+			return
+		}
+		conn.Write(ctx, websocket.MessageText, []byte("authentication successful"))
+
+		var writeMutex sync.Mutex
+		write := func(response sharedtypes.HandlerResponse) {
+			payload, _ := json.Marshal(response)
+			writeMutex.Lock()
+			defer writeMutex.Unlock()
+			conn.Write(ctx, websocket.MessageText, payload)
+		}
+
+		for {
+			_, message, err := conn.Read(ctx)
+			if err != nil {
+				return
+			}
+			var req sharedtypes.HandlerRequest
+			if err := json.Unmarshal(message, &req); err != nil {
+				t.Errorf("invalid request: %v", err)
+				return
+			}
+			if req.Data == "fail" {
+				write(sharedtypes.HandlerResponse{InstructionGuid: req.InstructionGuid, Type: "error", Error: &sharedtypes.ErrorResponse{Code: 1, Message: "failed"}})
+				continue
+			}
+			if req.Data == "sparse" {
+				chatData := "sparse-0"
+				write(sharedtypes.HandlerResponse{InstructionGuid: req.InstructionGuid, Type: "info"})
+				write(sharedtypes.HandlerResponse{InstructionGuid: req.InstructionGuid, Type: "chat", ChatData: &chatData})
+				write(sharedtypes.HandlerResponse{InstructionGuid: req.InstructionGuid, Type: "error"})
+				continue
+			}
+			if req.Data == "stall" {
+				isLast := false
+				chatData := "thinking"
+				write(sharedtypes.HandlerResponse{InstructionGuid: req.InstructionGuid, Type: "chat", ChatData: &chatData, IsLast: &isLast})
+				continue
+			}
+			go func() {
+				for i, last := range []bool{false, true} {
+					isLast := last
+					chatData := fmt.Sprintf("%v-%v", req.Data, i)
+					write(sharedtypes.HandlerResponse{InstructionGuid: req.InstructionGuid, Type: "chat", ChatData: &chatData, IsLast: &isLast})
+				}
+			}()
+		}
+	})
+}
+
+func TestSendMultiplexesRequests(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"LLM_CONNECTION_POOL_SIZE": "1"}}
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			data := fmt.Sprintf("request%v", i)
+			responseChannel, err := Send(context.Background(), endpoint, sharedtypes.HandlerRequest{InstructionGuid: data, Adapter: "chat", Data: data}, false)
+			if err != nil {
+				t.Errorf("Send() error = %v", err)
+				return
+			}
+			var got []string
+			for response := range responseChannel {
+				if response.InstructionGuid != data || response.ChatData == nil {
+					t.Errorf("unexpected response %+v for %v", response, data)
+					continue
+				}
+				got = append(got, *response.ChatData)
+			}
+			want := []string{data + "-0", data + "-1"}
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("responses for %v = %v, want %v", data, got, want)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if connections := len(pools[endpoint].connections); connections != 1 {
+		t.Errorf("pool has %v connections, want 1", connections)
+	}
+}
+
+func TestSendDialsOutsideThePoolLock(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"LLM_CONNECTION_POOL_SIZE": "2"}}
+
+	// the handshake of the first connection hangs until the second request is answered
+	release := make(chan struct{})
+	var handshakes atomic.Int32
+	handler := testHandler(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if handshakes.Add(1) == 1 {
+			<-release
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	endpoint := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	hanging := make(chan error, 1)
+	go func() {
+		responseChannel, err := Send(context.Background(), endpoint, sharedtypes.HandlerRequest{InstructionGuid: "hanging", Adapter: "chat", Data: "hanging"}, false)
+		if err == nil {
+			for range responseChannel {
+			}
+		}
+		hanging <- err
+	}()
+	for handshakes.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// the second request dials the other connection of the pool while the first one is hanging
+	answered := make(chan int, 1)
+	go func() {
+		responses := 0
+		responseChannel, err := Send(context.Background(), endpoint, sharedtypes.HandlerRequest{InstructionGuid: "other", Adapter: "chat", Data: "other"}, false)
+		if err != nil {
+			t.Errorf("Send() error = %v", err)
+		} else {
+			for response := range responseChannel {
+				if response.Type != "error" {
+					responses++
+				}
+			}
+		}
+		answered <- responses
+	}()
+	select {
+	case responses := <-answered:
+		if responses != 2 {
+			t.Errorf("got %v responses, want 2", responses)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Send() is blocked by the connection being dialled")
+	}
+
+	close(release)
+	if err := <-hanging; err != nil {
+		t.Errorf("Send() of the hanging request error = %v", err)
+	}
+}
+
+func TestSendDeliversErrors(t *testing.T) {
+	config.GlobalConfig = &config.Config{}
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	responseChannel, err := Send(context.Background(), endpoint, sharedtypes.HandlerRequest{InstructionGuid: "guid", Adapter: "chat", Data: "fail"}, false)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	response, ok := <-responseChannel
+	if !ok || response.Type != "error" || response.Error == nil {
+		t.Fatalf("expected an error response, got %+v", response)
+	}
+	if _, ok := <-responseChannel; ok {
+		t.Errorf("expected the response channel to be closed after the error")
+	}
+}
+
+func TestSendToleratesSparseResponses(t *testing.T) {
+	config.GlobalConfig = &config.Config{}
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	responseChannel, err := Send(context.Background(), endpoint, sharedtypes.HandlerRequest{InstructionGuid: "sparse", Adapter: "chat", Data: "sparse"}, false)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	// an info without message, a chunk without isLast and an error without details
+	responses := []sharedtypes.HandlerResponse{}
+	for response := range responseChannel {
+		responses = append(responses, response)
+	}
+	if len(responses) != 2 || *responses[0].ChatData != "sparse-0" || responses[1].Type != "error" || !strings.Contains(responses[1].Error.Message, "no error details") {
+		t.Errorf("got responses %+v, want a chunk and an error", responses)
+	}
+}
+
+func TestSendAbortsOnCancel(t *testing.T) {
+	config.GlobalConfig = &config.Config{}
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	responseChannel, err := Send(ctx, endpoint, sharedtypes.HandlerRequest{InstructionGuid: "guid", Adapter: "chat", Data: "data"}, false)
+	if err != nil {
+		// dialing with a cancelled context fails before the request is sent
+		return
+	}
+	// the abort error is only delivered if the consumer is waiting for it, the channel is closed in any case
+	for response := range responseChannel {
+		if response.Type == "error" && response.Error.Code != ErrorCodeAborted {
+			t.Errorf("got error response %+v, want an abort error", response)
+		}
+	}
+}
+
+func TestSendTimesOutWhileTheConsumerDoesNotRead(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"LLM_IDLE_TIMEOUT_SECONDS": "0.05"}}
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	// the consumer neither reads the response channel nor cancels the request
+	responseChannel, err := Send(context.Background(), endpoint, sharedtypes.HandlerRequest{InstructionGuid: "unread", Adapter: "chat", Data: "unread"}, false)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	time.Sleep(500 * time.Millisecond)
+
+	if response, open := <-responseChannel; open {
+		t.Errorf("got response %+v, want the response channel closed by the idle timeout", response)
+	}
+}
+
+func TestSendKeepsConnectionOnCancel(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"LLM_CONNECTION_POOL_SIZE": "1"}}
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	send := func(ctx context.Context, guid string) []sharedtypes.HandlerResponse {
+		responseChannel, err := Send(ctx, endpoint, sharedtypes.HandlerRequest{InstructionGuid: guid, Adapter: "chat", Data: guid}, false)
+		if err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		responses := []sharedtypes.HandlerResponse{}
+		for response := range responseChannel {
+			responses = append(responses, response)
+		}
+		return responses
+	}
+	send(context.Background(), "first")
+	c := pools[endpoint].connections[0]
+
+	// requests cancelled before their write must not close the connection shared with other requests
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 20; i++ {
+		send(cancelled, fmt.Sprintf("cancelled%v", i))
+	}
+	if c.isClosed() || pools[endpoint].connections[0] != c {
+		t.Fatal("the connection was closed by a cancelled request")
+	}
+	if responses := send(context.Background(), "last"); len(responses) != 2 || responses[1].Type == "error" {
+		t.Errorf("got responses %+v, want two chunks", responses)
+	}
+}
+
+func TestSendReleasesAbandonedRequests(t *testing.T) {
+	config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{"LLM_CONNECTION_POOL_SIZE": "1"}}
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	// the consumer never reads the response channel and cancels the request
+	ctx, cancel := context.WithCancel(context.Background())
+	responseChannel, err := Send(ctx, endpoint, sharedtypes.HandlerRequest{InstructionGuid: "abandoned", Adapter: "chat", Data: "stall"}, false)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	cancel()
+
+	c := pools[endpoint].connections[0]
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c.mutex.Lock()
+		pending := len(c.pending)
+		c.mutex.Unlock()
+		if pending == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the abandoned request is still pending")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// forward must not block on delivering the abort error to nobody
+	time.Sleep(50 * time.Millisecond)
+	if response, open := <-responseChannel; open {
+		t.Errorf("got response %+v, want the response channel closed", response)
+	}
+}
+
+func TestSendTimesOut(t *testing.T) {
+	endpoint := "ws" + strings.TrimPrefix(newTestServer(t).URL, "http")
+
+	for _, variable := range []string{"LLM_IDLE_TIMEOUT_SECONDS", "LLM_TOTAL_TIMEOUT_SECONDS"} {
+		t.Run(variable, func(t *testing.T) {
+			config.GlobalConfig = &config.Config{WORKFLOW_CONFIG_VARIABLES: map[string]string{variable: "0.05"}}
+			responseChannel, err := Send(context.Background(), endpoint, sharedtypes.HandlerRequest{InstructionGuid: "stall-" + variable, Adapter: "chat", Data: "stall"}, false)
+			if err != nil {
+				t.Fatalf("Send() failed: %v", err)
+			}
+
+			responses := []sharedtypes.HandlerResponse{}
+			for response := range responseChannel {
+				responses = append(responses, response)
+			}
+			if len(responses) != 2 || *responses[0].ChatData != "thinking" {
+				t.Fatalf("got responses %+v, want a chunk and an error", responses)
+			}
+			if last := responses[1]; last.Type != "error" || last.Error.Code != ErrorCodeTimeout {
+				t.Errorf("got last response %+v, want a timeout error", last)
+			}
+		})
+	}
+}
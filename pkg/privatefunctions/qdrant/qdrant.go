@@ -27,21 +27,61 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/ansys/aali-flowkit/pkg/telemetry"
 	"github.com/ansys/aali-sharedtypes/pkg/config"
 	"github.com/ansys/aali-sharedtypes/pkg/logging"
 	"github.com/ansys/aali-sharedtypes/pkg/sharedtypes"
 	"github.com/google/uuid"
 	"github.com/qdrant/go-client/qdrant"
+	"google.golang.org/grpc"
 )
 
-// Create a new qdrant client from your config.
+var (
+	clientMutex sync.Mutex
+	client      *qdrant.Client
+	isShutdown  bool
+)
+
+// Get the qdrant client from your config.
+// The client is created on first use and shared by all callers, it must not be closed; see Shutdown.
 func QdrantClient() (*qdrant.Client, error) {
-	return qdrant.NewClient(&qdrant.Config{
-		Host: config.GlobalConfig.QDRANT_HOST,
-		Port: config.GlobalConfig.QDRANT_PORT,
-	})
+	clientMutex.Lock()
+	defer clientMutex.Unlock()
+
+	if isShutdown {
+		return nil, fmt.Errorf("qdrant client is shut down")
+	}
+	if client == nil {
+		newClient, err := qdrant.NewClient(&qdrant.Config{
+			Host:        config.GlobalConfig.QDRANT_HOST,
+			Port:        config.GlobalConfig.QDRANT_PORT,
+			GrpcOptions: []grpc.DialOption{telemetry.GRPCClientOption()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		client = newClient
+	}
+	return client, nil
+}
+
+// Close the shared qdrant client, further calls to QdrantClient fail.
+func Shutdown() {
+	clientMutex.Lock()
+	defer clientMutex.Unlock()
 
+	isShutdown = true
+	if client == nil {
+		return
+	}
+	err := client.Close()
+	if err != nil {
+		logging.Log.Warnf(&logging.ContextMap{}, "error closing qdrant client: %v", err)
+	}
+	client = nil
+	logging.Log.Debugf(&logging.ContextMap{}, "Closed qdrant client.")
 }
 
 func CreateCollectionIfNotExists(ctx context.Context, client *qdrant.Client, collectionName string, vectorsConfig *qdrant.VectorsConfig, sparseVectorsConfig *qdrant.SparseVectorConfig) error {
@@ -141,23 +181,24 @@ func (dbArrFilt dbArrayFilter) AsQdrantFilterConditions(field string) []*qdrant.
 // RetrieveLeafNodes retrieves all leaf nodes from the similarity search result branch (ultimate children containing the original document).
 //
 // Parameters:
-//   - ctx: ContextMap.
+//   - ctx: the request context.
+//   - logCtx: ContextMap.
 //   - client: the qdrant client
 //   - collectionName: Name of the collection in the qdrant database to retrieve the leaves from.
 //   - data: Data to retrieve the leaf nodes for.
 //
 // Returns:
 //   - error: Error if any issue occurs while retrieving the leaves.
-func RetrieveLeafNodes(ctx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
+func RetrieveLeafNodes(ctx context.Context, logCtx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(ctx, "Panic in RetrieveLeafNodes: %v", r)
+			logging.Log.Errorf(logCtx, "Panic in RetrieveLeafNodes: %v", r)
 			funcError = r.(error)
 			return
 		}
 	}()
-	ctx = ctx.Copy()
+	logCtx = logCtx.Copy()
 
 	// for each dbresponse, get all leaf nodes that are in the same document
 	queries := make([]*qdrant.QueryPoints, len(*data))
@@ -174,12 +215,12 @@ func RetrieveLeafNodes(ctx *logging.ContextMap, client *qdrant.Client, collectio
 			WithPayload: qdrant.NewWithPayloadEnable(true),
 		}
 	}
-	batchResults, err := client.QueryBatch(context.TODO(), &qdrant.QueryBatchPoints{
+	batchResults, err := client.QueryBatch(ctx, &qdrant.QueryBatchPoints{
 		CollectionName: collectionName,
 		QueryPoints:    queries,
 	})
 	if err != nil {
-		logging.Log.Errorf(ctx, "error in qdrant batch query: %q", err)
+		logging.Log.Errorf(logCtx, "error in qdrant batch query: %q", err)
 		return err
 	}
 
@@ -189,12 +230,12 @@ func RetrieveLeafNodes(ctx *logging.ContextMap, client *qdrant.Client, collectio
 		for j, point := range batchRes.Result {
 			dbresp, err := QdrantPayloadToType[sharedtypes.DbData](point.Payload)
 			if err != nil {
-				logging.Log.Errorf(ctx, "error converting qdrant payload: %q", err)
+				logging.Log.Errorf(logCtx, "error converting qdrant payload: %q", err)
 				return err
 			}
 			id, err := uuid.Parse(point.Id.GetUuid())
 			if err != nil {
-				logging.Log.Errorf(ctx, "point ID is not parseable as a UUID: %v", err)
+				logging.Log.Errorf(logCtx, "point ID is not parseable as a UUID: %v", err)
 				return err
 			}
 			dbresp.Guid = id
@@ -208,23 +249,24 @@ func RetrieveLeafNodes(ctx *logging.ContextMap, client *qdrant.Client, collectio
 // RetrieveParentNodes retrieves the parent node for each of the documents provided.
 //
 // Parameters:
-//   - ctx: ContextMap.
+//   - ctx: the request context.
+//   - logCtx: ContextMap.
 //   - client: the qdrant client
 //   - collectionName: Name of the collection in the qdrant database to retrieve the parents from.
 //   - data: Data to retrieve the parent nodes for.
 //
 // Returns:
 //   - error: Error if any issue occurs while retrieving the parents.
-func RetrieveParentNodes(ctx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
+func RetrieveParentNodes(ctx context.Context, logCtx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(ctx, "Panic in RetrieveParentNodes: %v", r)
+			logging.Log.Errorf(logCtx, "Panic in RetrieveParentNodes: %v", r)
 			funcError = r.(error)
 			return
 		}
 	}()
-	ctx = ctx.Copy()
+	logCtx = logCtx.Copy()
 
 	// for each dbresponse, get the parent document
 	queries := make([]*qdrant.QueryPoints, len(*data))
@@ -242,12 +284,12 @@ func RetrieveParentNodes(ctx *logging.ContextMap, client *qdrant.Client, collect
 			WithPayload:    qdrant.NewWithPayloadEnable(true),
 		}
 	}
-	batchResults, err := client.QueryBatch(context.TODO(), &qdrant.QueryBatchPoints{
+	batchResults, err := client.QueryBatch(ctx, &qdrant.QueryBatchPoints{
 		CollectionName: collectionName,
 		QueryPoints:    queries,
 	})
 	if err != nil {
-		logging.Log.Errorf(ctx, "error in qdrant batch query: %q", err)
+		logging.Log.Errorf(logCtx, "error in qdrant batch query: %q", err)
 		return err
 	}
 
@@ -259,7 +301,7 @@ func RetrieveParentNodes(ctx *logging.ContextMap, client *qdrant.Client, collect
 		case 1:
 			parent, err := QdrantPayloadToType[sharedtypes.DbData](batchRes.Result[0].Payload)
 			if err != nil {
-				logging.Log.Errorf(ctx, "error converting qdrant payload: %q", err)
+				logging.Log.Errorf(logCtx, "error converting qdrant payload: %q", err)
 				return err
 			}
 			id, err := uuid.Parse(batchRes.Result[0].Id.GetUuid())
@@ -278,23 +320,24 @@ func RetrieveParentNodes(ctx *logging.ContextMap, client *qdrant.Client, collect
 // RetrieveChildNodes retrieves the child nodes for each of the documents provided.
 //
 // Parameters:
-//   - ctx: ContextMap.
+//   - ctx: the request context.
+//   - logCtx: ContextMap.
 //   - client: the qdrant client
 //   - collectionName: Name of the collection in the qdrant database to retrieve the children from.
 //   - data: Data to retrieve the children for.
 //
 // Returns:
 //   - error: Error if any issue occurs while retrieving the children.
-func RetrieveChildNodes(ctx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
+func RetrieveChildNodes(ctx context.Context, logCtx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(ctx, "Panic in RetrieveChildNodes: %v", r)
+			logging.Log.Errorf(logCtx, "Panic in RetrieveChildNodes: %v", r)
 			funcError = r.(error)
 			return
 		}
 	}()
-	ctx = ctx.Copy()
+	logCtx = logCtx.Copy()
 
 	// for each dbresponse, get the parent document
 	queries := make([]*qdrant.QueryPoints, len(*data))
@@ -314,12 +357,12 @@ func RetrieveChildNodes(ctx *logging.ContextMap, client *qdrant.Client, collecti
 			WithPayload: qdrant.NewWithPayloadEnable(true),
 		}
 	}
-	batchResults, err := client.QueryBatch(context.TODO(), &qdrant.QueryBatchPoints{
+	batchResults, err := client.QueryBatch(ctx, &qdrant.QueryBatchPoints{
 		CollectionName: collectionName,
 		QueryPoints:    queries,
 	})
 	if err != nil {
-		logging.Log.Errorf(ctx, "error in qdrant batch query: %q", err)
+		logging.Log.Errorf(logCtx, "error in qdrant batch query: %q", err)
 		return err
 	}
 
@@ -329,12 +372,12 @@ func RetrieveChildNodes(ctx *logging.ContextMap, client *qdrant.Client, collecti
 		for j, point := range batchRes.Result {
 			child, err := QdrantPayloadToType[sharedtypes.DbData](point.Payload)
 			if err != nil {
-				logging.Log.Errorf(ctx, "error converting qdrant payload: %q", err)
+				logging.Log.Errorf(logCtx, "error converting qdrant payload: %q", err)
 				return err
 			}
 			id, err := uuid.Parse(point.Id.GetUuid())
 			if err != nil {
-				logging.Log.Errorf(ctx, "point ID is not parseable as a UUID: %v", err)
+				logging.Log.Errorf(logCtx, "point ID is not parseable as a UUID: %v", err)
 				return err
 			}
 			child.Guid = id
@@ -348,23 +391,24 @@ func RetrieveChildNodes(ctx *logging.ContextMap, client *qdrant.Client, collecti
 // RetrieveDirectSiblingNodes retrieves the nodes associated with the next & previous sibling (if any) for each of the documents provided.
 //
 // Parameters:
-//   - ctx: ContextMap.
+//   - ctx: the request context.
+//   - logCtx: ContextMap.
 //   - client: the qdrant client
 //   - collectionName: Name of the collection in the qdrant database to retrieve the siblings from.
 //   - data: Data to retrieve the siblings for.
 //
 // Returns:
 //   - error: Error if any issue occurs while retrieving the siblings.
-func RetrieveDirectSiblingNodes(ctx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
+func RetrieveDirectSiblingNodes(ctx context.Context, logCtx *logging.ContextMap, client *qdrant.Client, collectionName string, data *[]sharedtypes.DbResponse) (funcError error) {
 	defer func() {
 		r := recover()
 		if r != nil {
-			logging.Log.Errorf(ctx, "Panic in RetrieveDirectSiblingNodes: %v", r)
+			logging.Log.Errorf(logCtx, "Panic in RetrieveDirectSiblingNodes: %v", r)
 			funcError = r.(error)
 			return
 		}
 	}()
-	ctx = ctx.Copy()
+	logCtx = logCtx.Copy()
 
 	// for each dbresponse, get the parent document
 	queries := make([]*qdrant.QueryPoints, len(*data))
@@ -388,12 +432,12 @@ func RetrieveDirectSiblingNodes(ctx *logging.ContextMap, client *qdrant.Client,
 			WithPayload: qdrant.NewWithPayloadEnable(true),
 		}
 	}
-	batchResults, err := client.QueryBatch(context.TODO(), &qdrant.QueryBatchPoints{
+	batchResults, err := client.QueryBatch(ctx, &qdrant.QueryBatchPoints{
 		CollectionName: collectionName,
 		QueryPoints:    queries,
 	})
 	if err != nil {
-		logging.Log.Errorf(ctx, "error in qdrant batch query: %q", err)
+		logging.Log.Errorf(logCtx, "error in qdrant batch query: %q", err)
 		return err
 	}
 
@@ -403,12 +447,12 @@ func RetrieveDirectSiblingNodes(ctx *logging.ContextMap, client *qdrant.Client,
 		for j, point := range batchRes.Result {
 			sibling, err := QdrantPayloadToType[sharedtypes.DbData](point.Payload)
 			if err != nil {
-				logging.Log.Errorf(ctx, "error converting qdrant payload: %q", err)
+				logging.Log.Errorf(logCtx, "error converting qdrant payload: %q", err)
 				return err
 			}
 			id, err := uuid.Parse(point.Id.GetUuid())
 			if err != nil {
-				logging.Log.Errorf(ctx, "point ID is not parseable as a UUID: %v", err)
+				logging.Log.Errorf(logCtx, "point ID is not parseable as a UUID: %v", err)
 				return err
 			}
 			sibling.Guid = id
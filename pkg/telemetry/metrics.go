@@ -0,0 +1,89 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package telemetry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+)
+
+// functionMetrics are the RED metrics of the function calls: rate, errors and duration
+type functionMetrics struct {
+	calls    metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+var (
+	metricsOnce sync.Once
+	metrics     functionMetrics
+)
+
+// getFunctionMetrics creates the instruments of the function metrics on first use
+// The instruments of the global meter follow the provider set later by Init.
+//
+// Returns:
+//   - *functionMetrics: the instruments
+func getFunctionMetrics() *functionMetrics {
+	metricsOnce.Do(func() {
+		meter := otel.Meter(instrumentationName)
+		metrics.calls, _ = meter.Int64Counter("flowkit.function.calls",
+			metric.WithDescription("Number of function calls"),
+			metric.WithUnit("{call}"))
+		metrics.errors, _ = meter.Int64Counter("flowkit.function.errors",
+			metric.WithDescription("Number of function calls that failed"),
+			metric.WithUnit("{call}"))
+		metrics.duration, _ = meter.Float64Histogram("flowkit.function.duration",
+			metric.WithDescription("Duration of function calls"),
+			metric.WithUnit("s"))
+	})
+	return &metrics
+}
+
+// RecordFunctionCall records a function call in the RED metrics
+//
+// Parameters:
+//   - ctx: the context of the call
+//   - method: the gRPC method that called the function, such as "RunFunction"
+//   - function: the name of the function
+//   - start: the start time of the call
+//   - code: the gRPC status code of the call
+func RecordFunctionCall(ctx context.Context, method string, function string, start time.Time, code codes.Code) {
+	m := getFunctionMetrics()
+	attributes := metric.WithAttributes(
+		attribute.String("rpc.method", method),
+		attribute.String("flowkit.function", function),
+		attribute.String("rpc.grpc.status_code", code.String()),
+	)
+	m.calls.Add(ctx, 1, attributes)
+	if code != codes.OK {
+		m.errors.Add(ctx, 1, attributes)
+	}
+	m.duration.Record(ctx, time.Since(start).Seconds(), attributes)
+}
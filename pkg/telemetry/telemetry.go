@@ -0,0 +1,219 @@
+// Copyright (C) 2025 ANSYS, Inc. and/or its affiliates.
+// SPDX-License-Identifier: MIT
+//
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package telemetry sets up the OpenTelemetry tracing and metrics of flowkit.
+//
+// Traces and metrics are exported over OTLP/gRPC when an OTLP endpoint is configured, otherwise the
+// global providers stay no-op. The W3C trace context is always propagated, so that the spans of the
+// callers and of the dependencies of flowkit stay connected.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ansys/aali-flowkit/pkg/internalstates"
+	"github.com/ansys/aali-sharedtypes/pkg/config"
+	"github.com/ansys/aali-sharedtypes/pkg/logging"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// instrumentationName is the name of the tracer and meter of flowkit
+const instrumentationName = "github.com/ansys/aali-flowkit"
+
+// defaultServiceName is the service name of the telemetry if SERVICE_NAME is not set
+const defaultServiceName = "aali-flowkit"
+
+// Init sets up the global tracer and meter providers and the W3C trace context propagator
+// Telemetry is exported over OTLP/gRPC when the workflow config variable FLOWKIT_OTLP_ENDPOINT
+// or the environment variable OTEL_EXPORTER_OTLP_ENDPOINT is set; the other OTEL_EXPORTER_OTLP_*
+// environment variables are honored. FLOWKIT_OTLP_INSECURE: "true" disables TLS to the collector.
+//
+// Parameters:
+//   - ctx: the context of the setup
+//
+// Returns:
+//   - shutdown: flushes and stops the exporters, to call before the process exits
+//   - err: an error if the exporters cannot be created
+func Init(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	endpoint := config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_OTLP_ENDPOINT"]
+	if endpoint == "" && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	insecure := strings.EqualFold(config.GlobalConfig.WORKFLOW_CONFIG_VARIABLES["FLOWKIT_OTLP_INSECURE"], "true")
+
+	traceOptions := []otlptracegrpc.Option{}
+	metricOptions := []otlpmetricgrpc.Option{}
+	if endpoint != "" {
+		traceOptions = append(traceOptions, otlptracegrpc.WithEndpoint(endpoint))
+		metricOptions = append(metricOptions, otlpmetricgrpc.WithEndpoint(endpoint))
+	}
+	if insecure {
+		traceOptions = append(traceOptions, otlptracegrpc.WithInsecure())
+		metricOptions = append(metricOptions, otlpmetricgrpc.WithInsecure())
+	}
+
+	traceExporter, err := otlptracegrpc.New(ctx, traceOptions...)
+	if err != nil {
+		return nil, err
+	}
+	metricExporter, err := otlpmetricgrpc.New(ctx, metricOptions...)
+	if err != nil {
+		return nil, errors.Join(err, traceExporter.Shutdown(ctx))
+	}
+
+	serviceName := config.GlobalConfig.SERVICE_NAME
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	res := resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", internalstates.FlowkitVersion),
+	)
+
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(traceExporter), sdktrace.WithResource(res))
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)), sdkmetric.WithResource(res))
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetMeterProvider(meterProvider)
+
+	// outbound HTTP calls made with the default transport get their own spans
+	http.DefaultTransport = HTTPTransport(http.DefaultTransport)
+
+	logging.Log.Infof(&logging.ContextMap{}, "Exporting traces and metrics over OTLP")
+	return func(ctx context.Context) error {
+		return errors.Join(tracerProvider.Shutdown(ctx), meterProvider.Shutdown(ctx))
+	}, nil
+}
+
+// Tracer returns the tracer of flowkit
+//
+// Returns:
+//   - trace.Tracer: the tracer
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartSpan starts a client span for a call from flowkit to one of its dependencies
+//
+// Parameters:
+//   - ctx: the context of the call, carrying the parent span
+//   - name: the name of the span
+//   - attributes: the attributes of the span
+//
+// Returns:
+//   - context.Context: the context carrying the span
+//   - trace.Span: the span, to end with EndSpan
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+// EndSpan records the error of a call on its span and ends the span
+//
+// Parameters:
+//   - span: the span of the call
+//   - err: the error of the call, nil if it succeeded
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ExtractMetadata returns the context with the remote span context of the incoming gRPC metadata
+// The caller passes its span in the W3C "traceparent" and "tracestate" metadata.
+//
+// Parameters:
+//   - ctx: the context of the gRPC request
+//
+// Returns:
+//   - context.Context: the context carrying the remote span context, if any
+func ExtractMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// HTTPTransport wraps an HTTP transport so that its requests get client spans and carry the trace context
+//
+// Parameters:
+//   - base: the transport to wrap
+//
+// Returns:
+//   - http.RoundTripper: the instrumented transport
+func HTTPTransport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
+
+// GRPCClientOption instruments the calls of a gRPC client, such as the Qdrant client
+//
+// Returns:
+//   - grpc.DialOption: the dial option adding a span per call
+func GRPCClientOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler())
+}
+
+// metadataCarrier adapts gRPC metadata to the OpenTelemetry propagators
+type metadataCarrier metadata.MD
+
+// Get returns the first value of a key
+func (carrier metadataCarrier) Get(key string) string {
+	values := metadata.MD(carrier).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set sets the value of a key
+func (carrier metadataCarrier) Set(key string, value string) {
+	metadata.MD(carrier).Set(key, value)
+}
+
+// Keys returns the keys of the metadata
+func (carrier metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier))
+	for key := range carrier {
+		keys = append(keys, key)
+	}
+	return keys
+}